@@ -4,10 +4,13 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc/mw"
 	"gitlab.ozon.dev/safariproxd/homework/internal/config"
 	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	apiv2 "gitlab.ozon.dev/safariproxd/homework/pkg/api/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -18,12 +21,17 @@ func main() {
 		log.Fatalf("config: %v", err)
 	}
 	ctx := context.Background()
-	mux := runtime.NewServeMux()
-	err = api.RegisterOrdersServiceHandlerFromEndpoint(ctx, mux, cfg.Service.GRPCAddress, []grpc.DialOption{
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher))
+	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	})
+	}
+	err = apiv2.RegisterOrdersServiceHandlerFromEndpoint(ctx, mux, cfg.Service.GRPCAddress, opts)
+	if err != nil {
+		log.Fatalf("RegisterOrdersServiceHandlerFromEndpoint v2 err: %v", err)
+	}
+	err = api.RegisterOrdersServiceHandlerFromEndpoint(ctx, mux, cfg.Service.GRPCAddress, opts)
 	if err != nil {
-		log.Fatalf("RegisterOrdersServiceHandlerFromEndpoint err: %v", err)
+		log.Fatalf("RegisterOrdersServiceHandlerFromEndpoint v1 err: %v", err)
 	}
 
 	log.Printf("http server running on %v", cfg.Service.HTTPAddress)
@@ -31,3 +39,20 @@ func main() {
 		log.Fatalf("http server running err: %v", err)
 	}
 }
+
+// заголовки, которые передаются в gRPC как есть, без префикса grpcgateway-
+var forwardedHeaders = []string{
+	mw.PVZIDMetadataKey,
+	mw.ActorTypeMetadataKey,
+	mw.ActorIDMetadataKey,
+	mw.IdempotencyKeyMetadataKey,
+}
+
+func headerMatcher(key string) (string, bool) {
+	for _, md := range forwardedHeaders {
+		if strings.EqualFold(key, md) {
+			return md, true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
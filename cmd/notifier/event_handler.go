@@ -160,6 +160,30 @@ func (h *EventHandler) logEvent(event *domain.Event, message *sarama.ConsumerMes
 		logger.Warn("📮 Order returned to courier",
 			"message", fmt.Sprintf("Order %d returned to courier (user: %d)", event.Order.ID, event.Order.UserID))
 
+	case domain.EventTypeOrderPaid:
+		logger.Info("💳 Order paid",
+			"message", fmt.Sprintf("Order %d paid by user %d: %s", event.Order.ID, event.Order.UserID, event.Order.Amount))
+
+	case domain.EventTypeOrderRefunded:
+		logger.Info("💸 Order refunded",
+			"message", fmt.Sprintf("Order %d refunded to user %d: %s", event.Order.ID, event.Order.UserID, event.Order.Amount))
+
+	case domain.EventTypeOrderAnnounced:
+		logger.Info("🚚 Order announced",
+			"message", fmt.Sprintf("Order %d announced in shipment %s", event.Order.ID, event.Order.ShipmentID))
+
+	case domain.EventTypeOrderArrived:
+		logger.Info("📦 Order arrived",
+			"message", fmt.Sprintf("Order %d arrived with shipment %s", event.Order.ID, event.Order.ShipmentID))
+
+	case domain.EventTypeOrderMissing:
+		logger.Warn("❗ Order missing from shipment",
+			"message", fmt.Sprintf("Order %d announced in shipment %s did not arrive", event.Order.ID, event.Order.ShipmentID))
+
+	case domain.EventTypeOrderUnexpected:
+		logger.Warn("❓ Unexpected parcel in shipment",
+			"message", fmt.Sprintf("Order %d arrived with shipment %s without announcement", event.Order.ID, event.Order.ShipmentID))
+
 	default:
 		logger.Warn("❓ Unknown event type",
 			"message", fmt.Sprintf("Unknown event type: %s for order %d", event.EventType, event.Order.ID))
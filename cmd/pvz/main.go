@@ -14,8 +14,10 @@ import (
 	"github.com/ulule/limiter/v3/drivers/store/memory"
 	server "gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc"
 	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc/mw"
+	serverv1 "gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc/v1"
 	"gitlab.ozon.dev/safariproxd/homework/internal/app"
 	"gitlab.ozon.dev/safariproxd/homework/internal/config"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/internal/infra"
 	"gitlab.ozon.dev/safariproxd/homework/internal/metrics"
 	"gitlab.ozon.dev/safariproxd/homework/internal/repository/postgres"
@@ -33,6 +35,10 @@ func main() {
 		slog.Error("Config load failed", "error", err)
 		os.Exit(1)
 	}
+	if cfg.Service.PickupCode.Secret == "" {
+		slog.Error("Config load failed", "error", "PICKUP_CODE_SECRET is not set")
+		os.Exit(1)
+	}
 	ctx := context.Background()
 	shutdownTracing := tracing.InitTracing(ctx, cfg.Tracing.Enabled, cfg.Tracing.Endpoint)
 	defer shutdownTracing()
@@ -88,8 +94,68 @@ func main() {
 
 	outboxRepo = postgres.NewOutboxRepository(client)
 	pvzService := app.NewPVZService(orderRepo, outboxRepo, client, time.Now, cfg.Service.WorkerLimit, metricsProvider)
+	pvzService.SetStorageExtensionPolicy(domain.StorageExtensionPolicy{
+		MaxDays:   cfg.Service.StorageExtension.MaxDays,
+		FeePerDay: cfg.Service.StorageExtension.FeePerDay,
+	})
+	pvzService.SetPickupCodePolicy(domain.PickupCodePolicy{
+		MaxAttempts:     cfg.Service.PickupCode.MaxAttempts,
+		LockoutDuration: cfg.Service.PickupCode.LockoutDuration,
+		Secret:          []byte(cfg.Service.PickupCode.Secret),
+	})
+	storageFees := domain.StorageFeePolicy{
+		FreeDays:      cfg.Service.StorageFee.FreeDays,
+		DefaultPerDay: cfg.Service.StorageFee.DefaultPerDay,
+	}
+	for _, r := range cfg.Service.StorageFee.Rates {
+		storageFees.Rates = append(storageFees.Rates, domain.StorageFeeRate{
+			PackageType: r.PackageType,
+			MinWeight:   r.MinWeight,
+			PerDay:      r.PerDay,
+		})
+	}
+	pvzService.SetStorageFeePolicy(storageFees)
+	pvzService.SetIdempotencyTTL(cfg.Service.Idempotency.TTL)
+	pvzService.SetIdempotencyLockTimeout(cfg.Service.Idempotency.LockTimeout)
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if purged, err := pvzService.PurgeIdempotencyKeys(ctx); err != nil {
+				slog.Error("Idempotency keys purge failed", "error", err)
+			} else if purged > 0 {
+				slog.Debug("Idempotency keys purged", "count", purged)
+			}
+		}
+	}()
+
+	if cfg.Service.ReturnSweep.Interval > 0 {
+		go func() {
+			ticker := time.NewTicker(cfg.Service.ReturnSweep.Interval)
+			defer ticker.Stop()
+
+			for range ticker.C {
+				sweepReturnManifests(ctx, pvzService)
+			}
+		}()
+		slog.Info("Return sweep enabled", "interval", cfg.Service.ReturnSweep.Interval)
+	}
 
 	pool := workerpool.New(cfg.Service.WorkerLimit, cfg.Service.QueueSize)
+	importPool := workerpool.New(cfg.Service.ImportJobs.Workers, cfg.Service.ImportJobs.QueueSize)
+	pvzService.SetJobPool(importPool)
+	resumeImportJobs(ctx, pvzService)
+	// задачи, брошенные упавшим экземпляром, забирает любой работающий
+	go func() {
+		ticker := time.NewTicker(domain.ImportJobLeaseTTL)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			resumeImportJobs(ctx, pvzService)
+		}
+	}()
 
 	go func() {
 		ticker := time.NewTicker(10 * time.Second)
@@ -120,16 +186,30 @@ func main() {
 			mw.TimeoutInterceptor(2*time.Second),
 			mw.TracingInterceptor(),
 			mw.LoggingInterceptor(),
+			mw.PVZInterceptor(cfg.Service.DefaultPVZID),
+			mw.ActorInterceptor(),
+			mw.AuditInterceptor(pvzService),
 			mw.ValidationInterceptor(),
 			mw.ErrorMappingInterceptor(),
+			mw.IdempotencyInterceptor(pvzService),
 			mw.MetricsInterceptor(metricsProvider),
 			mw.PoolInterceptor(pool),
 		),
+		grpc.ChainStreamInterceptor(
+			mw.PanicStreamInterceptor(),
+			mw.LoggingStreamInterceptor(),
+			mw.PVZStreamInterceptor(cfg.Service.DefaultPVZID),
+			mw.ActorStreamInterceptor(),
+			mw.AuditStreamInterceptor(pvzService),
+			mw.ErrorMappingStreamInterceptor(),
+		),
 	)
 
 	ordersServer := server.NewOrdersServer(pvzService)
 	reflection.Register(grpcServer)
 	ordersServer.Register(grpcServer)
+	// старые клиенты с float-ценами продолжают работать через замороженный v1
+	serverv1.NewOrdersServer(pvzService).Register(grpcServer)
 
 	lis, err := net.Listen("tcp", cfg.Service.GRPCAddress)
 	if err != nil {
@@ -164,7 +244,40 @@ func main() {
 	infra.Graceful(
 		func(ctx context.Context) { grpcServer.GracefulStop() },
 		admin.Shutdown,
+		// задачи импорта останавливаются между пачками и продолжатся после перезапуска
+		func(ctx context.Context) { pvzService.StopImportJobs() },
+		func(ctx context.Context) { importPool.Close() },
 		func(ctx context.Context) { pool.Close() },
 		func(ctx context.Context) { shutdownTracing() },
 	)
 }
+
+func resumeImportJobs(ctx context.Context, svc *app.PVZService) {
+	if resumed, err := svc.ResumeImportJobs(ctx); err != nil {
+		slog.Error("Import jobs resume failed", "error", err)
+	} else if resumed > 0 {
+		slog.Info("Import jobs resumed", "count", resumed)
+	}
+}
+
+// собирает ведомости возврата по всем пунктам; ошибка одного пункта не мешает остальным
+func sweepReturnManifests(ctx context.Context, svc *app.PVZService) {
+	points, err := svc.ListPickupPoints(ctx)
+	if err != nil {
+		slog.Error("Return sweep: list pickup points failed", "error", err)
+		return
+	}
+	for _, p := range points {
+		manifest, err := svc.SweepExpiredOrders(domain.WithPVZID(ctx, p.ID))
+		if err != nil {
+			slog.Error("Return sweep failed", "pvz_id", p.ID, "error", err)
+			continue
+		}
+		if len(manifest.Items) > 0 {
+			slog.Info("Return manifest created",
+				"pvz_id", p.ID,
+				"manifest_id", manifest.ID,
+				"orders", len(manifest.Items))
+		}
+	}
+}
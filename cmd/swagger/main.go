@@ -17,7 +17,7 @@ func main() {
 	}
 	mux := chi.NewMux()
 	mux.HandleFunc("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
-		b, err := os.ReadFile("./pkg/api/contract.swagger.json")
+		b, err := os.ReadFile("./pkg/api/v2/contract.swagger.json")
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			log.Printf("failed to read swagger.json: %v", err)
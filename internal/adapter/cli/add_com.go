@@ -20,30 +20,48 @@ func (a *CLIAdapter) AddComm(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("flag.GetString: %w", err)
 	}
-	weight, err := cmd.Flags().GetFloat64("weight")
+	weightStr, err := cmd.Flags().GetString("weight")
 	if err != nil {
-		return fmt.Errorf("flag.GetFloat64: %w", err)
+		return fmt.Errorf("flag.GetString: %w", err)
 	}
-	price, err := cmd.Flags().GetFloat64("price")
+	priceStr, err := cmd.Flags().GetString("price")
 	if err != nil {
-		return fmt.Errorf("flag.GetFloat64: %w", err)
+		return fmt.Errorf("flag.GetString: %w", err)
 	}
 	packageType, err := cmd.Flags().GetString("package")
 	if err != nil {
 		return fmt.Errorf("flag.GetString: %w", err)
 	}
+	sellerID, err := cmd.Flags().GetUint64("seller-id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	cashOnDelivery, err := cmd.Flags().GetBool("cod")
+	if err != nil {
+		return fmt.Errorf("flag.GetBool: %w", err)
+	}
 
 	storageUntil, err := MapStringToTime(storageUntilStr)
 	if err != nil {
 		return fmt.Errorf("time.Parse: %w", err)
 	}
+	weight, err := domain.ParseWeight(weightStr)
+	if err != nil {
+		return fmt.Errorf("validation: %w", domain.ValidationFailedError("Invalid value for flag --weight"))
+	}
+	price, err := domain.ParseMoney(priceStr)
+	if err != nil {
+		return fmt.Errorf("validation: %w", domain.ValidationFailedError("Invalid value for flag --price"))
+	}
 	req := domain.AcceptOrderRequest{
-		ReceiverID:   receiverID,
-		OrderID:      orderID,
-		StorageUntil: storageUntil,
-		Weight:       weight,
-		Price:        price,
-		PackageType:  packageType,
+		ReceiverID:     receiverID,
+		OrderID:        orderID,
+		StorageUntil:   storageUntil,
+		Weight:         weight,
+		Price:          price,
+		PackageType:    packageType,
+		SellerID:       sellerID,
+		CashOnDelivery: cashOnDelivery,
 	}
 	totalPrice, err := a.appService.AcceptOrder(req)
 	if err != nil {
@@ -52,6 +70,6 @@ func (a *CLIAdapter) AddComm(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("ORDER_ACCEPTED: %d\n", orderID)
 	fmt.Printf("PACKAGE: %s\n", packageType)
-	fmt.Printf("TOTAL_PRICE: %.2f\n", totalPrice)
+	fmt.Printf("TOTAL_PRICE: %s\n", totalPrice)
 	return nil
 }
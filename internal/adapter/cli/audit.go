@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func (a *CLIAdapter) AuditLogComm(cmd *cobra.Command, args []string) error {
+	verify, err := cmd.Flags().GetBool("verify")
+	if err != nil {
+		return fmt.Errorf("flag.GetBool: %w", err)
+	}
+	if verify {
+		checked, brokenID, err := a.appService.VerifyAuditLog()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("CHECKED: %d\n", checked)
+		if brokenID != 0 {
+			fmt.Printf("BROKEN_AT: %d\n", brokenID)
+			return nil
+		}
+		fmt.Println("CHAIN: OK")
+		return nil
+	}
+
+	f, err := auditFilterFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	records, err := a.appService.SearchAuditLog(f)
+	if err != nil {
+		return err
+	}
+	for _, rec := range records {
+		actor := "-"
+		if rec.Actor.Type != "" {
+			actor = fmt.Sprintf("%s:%d", rec.Actor.Type, rec.Actor.ID)
+		}
+		fmt.Printf("AUDIT: %d %s %s %s %s\n",
+			rec.ID, rec.CreatedAt.Format(time.RFC3339), rec.Method, actor, rec.ResultCode)
+		fmt.Printf("  FROM: %s SENDER: %s ORDERS: %v\n", rec.Peer, rec.Sender, rec.OrderIDs)
+		fmt.Printf("  PAYLOAD: %s\n", rec.Payload)
+		if rec.Error != "" {
+			fmt.Printf("  ERROR: %s\n", rec.Error)
+		}
+	}
+	fmt.Printf("TOTAL: %d\n", len(records))
+	return nil
+}
+
+func auditFilterFromFlags(cmd *cobra.Command) (domain.AuditFilter, error) {
+	var f domain.AuditFilter
+	actorType, err := cmd.Flags().GetString("actor-type")
+	if err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+	if actorType != "" {
+		t, ok := domain.ParseActorType(actorType)
+		if !ok {
+			return f, domain.ValidationFailedError(fmt.Sprintf("unknown actor type %q", actorType))
+		}
+		f.ActorType = t
+	}
+	if f.ActorID, err = cmd.Flags().GetUint64("actor-id"); err != nil {
+		return f, fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	if f.OrderID, err = cmd.Flags().GetUint64("order-id"); err != nil {
+		return f, fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	if f.Limit, err = cmd.Flags().GetUint64("limit"); err != nil {
+		return f, fmt.Errorf("flag.GetUint64: %w", err)
+	}
+
+	from, err := cmd.Flags().GetString("from")
+	if err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+	if from != "" {
+		if f.From, err = MapStringToTime(from); err != nil {
+			return f, fmt.Errorf("time.Parse: %w", err)
+		}
+	}
+	to, err := cmd.Flags().GetString("to")
+	if err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+	if to != "" {
+		day, err := MapStringToTime(to)
+		if err != nil {
+			return f, fmt.Errorf("time.Parse: %w", err)
+		}
+		// дата окончания включается в интервал целиком
+		f.To = day.Add(24 * time.Hour)
+	}
+	return f, nil
+}
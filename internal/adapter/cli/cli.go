@@ -12,15 +12,39 @@ import (
 )
 
 type OrderService interface {
-	AcceptOrder(req domain.AcceptOrderRequest) (float64, error)
+	AcceptOrder(req domain.AcceptOrderRequest) (domain.Money, error)
 	ReturnOrderToDelivery(orderID uint64) error
-	IssueOrdersToClient(receiverID uint64, orderIDs []uint64) error
-	ReturnOrdersFromClient(receiverID uint64, orderIDs []uint64) error
+	IssueOrdersToClient(receiverID uint64, orderIDs []uint64, pickupCode string) ([]domain.OrderResult, error)
+	ReturnOrdersFromClient(receiverID uint64, orderIDs []uint64) ([]domain.OrderResult, error)
+	IssueOrdersToClientAtomic(receiverID uint64, orderIDs []uint64, pickupCode string) ([]domain.OrderResult, error)
+	ReturnOrdersFromClientAtomic(receiverID uint64, orderIDs []uint64) ([]domain.OrderResult, error)
 	GetReceiverOrders(receiverID uint64, inPVZ bool, lastN, page, limit uint64) ([]*domain.Order, uint64, error)
+	GetReceiverOrdersByPhone(phone string, inPVZ bool, lastN, page, limit uint64) ([]*domain.Order, uint64, error)
 	GetReceiverOrdersScroll(receiverID uint64, lastID, limit uint64) ([]*domain.Order, uint64, error)
 	GetReturnedOrders(page, limit uint64) ([]*domain.Order, uint64, error)
 	GetOrderHistory() ([]*domain.Order, error)
-	ImportOrders(orders []domain.OrderToImport) (uint64, error)
+	GetOrderHistoryByID(orderID uint64) ([]domain.OrderHistory, error)
+	ExportOrders(f domain.OrderExportFilter, emit func(domain.Order) error) error
+	ImportOrdersStream(rows <-chan domain.ImportRow, emit func(domain.ImportRowResult) error) error
+	ValidateImportOrdersStream(rows <-chan domain.ImportRow, emit func(domain.ImportRowResult) error) error
+	MoveOrder(orderID uint64, cellCode string) (*domain.Order, error)
+	ConfirmPayment(orderID uint64) (*domain.Order, error)
+	AnnounceOrders(shipmentID string, reqs []domain.AcceptOrderRequest) ([]domain.OrderResult, error)
+	ConfirmArrival(shipmentID string, orderIDs []uint64) (domain.ArrivalReport, error)
+	GetDiscrepancyReport(shipmentID string) ([]domain.ArrivalDiscrepancy, error)
+	SweepExpiredOrders() (domain.ReturnManifest, error)
+	ListReturnManifests() ([]domain.ReturnManifest, error)
+	ExportReturnManifest(id uint64, format string) ([]byte, error)
+	HandOverReturnManifest(id uint64) (domain.ReturnManifest, error)
+	UpsertReceiver(rec domain.Receiver) (domain.Receiver, error)
+	SearchReceivers(query string, limit uint64) ([]domain.Receiver, error)
+	SearchAuditLog(f domain.AuditFilter) ([]domain.AuditRecord, error)
+	VerifyAuditLog() (uint64, uint64, error)
+	ExtendStorage(orderID uint64, days uint32) (*domain.Order, domain.Money, error)
+	CreatePackageType(p domain.PackageType) (domain.PackageType, error)
+	UpdatePackageType(p domain.PackageType) (domain.PackageType, error)
+	DeletePackageType(code string) error
+	ListPackageTypes() ([]domain.PackageType, error)
 }
 
 type CLIAdapter struct {
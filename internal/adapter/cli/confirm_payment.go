@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func (a *CLIAdapter) ConfirmPaymentComm(cmd *cobra.Command, args []string) error {
+	orderID, err := cmd.Flags().GetUint64("order-id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+
+	order, err := a.appService.ConfirmPayment(orderID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("PAYMENT_CONFIRMED: %d\n", order.OrderID)
+	fmt.Printf("AMOUNT: %s\n", order.Price)
+	return nil
+}
@@ -33,6 +33,22 @@ func WeightTooHeavyError(message string) error {
 	return fmt.Errorf("ERROR: WEIGHT_TOO_HEAVY: %s", message)
 }
 
+func CellUnavailableError(message string) error {
+	return fmt.Errorf("ERROR: CELL_UNAVAILABLE: %s", message)
+}
+
+func InvalidPickupCodeError(message string) error {
+	return fmt.Errorf("ERROR: INVALID_PICKUP_CODE: %s", message)
+}
+
+func PickupCodeLockedError(message string) error {
+	return fmt.Errorf("ERROR: PICKUP_CODE_LOCKED: %s", message)
+}
+
+func PaymentRequiredError(message string) error {
+	return fmt.Errorf("ERROR: PAYMENT_REQUIRED: %s", message)
+}
+
 func InternalError(err error) error {
 	return fmt.Errorf("INTERNAL ERROR: %w", err)
 }
@@ -70,24 +86,32 @@ func processSingleError(err error) error {
 			return StorageExpiredError(domainErr.Message)
 		case domain.ErrorCodeValidationFailed:
 			return ValidationFailedError(domainErr.Message)
-		case domain.ErrorCodeAlreadyGiven:
-			return ValidationFailedError(domainErr.Message)
 		case domain.ErrorCodeBelongsToOtherReceiver:
 			return ValidationFailedError(domainErr.Message)
-		case domain.ErrorCodeAlreadyInStorage:
+		case domain.ErrorCodeBelongsToOtherPVZ:
 			return ValidationFailedError(domainErr.Message)
-		case domain.ErrorCodeReturnPeriodExpired:
+		case domain.ErrorCodeReturnPeriodExpired, domain.ErrorCodeNotReturnable, domain.ErrorCodeExtensionLimit:
 			return ValidationFailedError(domainErr.Message)
 		case domain.ErrorCodeStorageNotExpired:
 			return StorageNotExpiredError(domainErr.Message)
-		case domain.ErrorCodeUnavaliableReturned:
+		case domain.ErrorCodeInvalidTransition:
 			return ValidationFailedError(domainErr.Message)
+		case domain.ErrorCodeCellUnavailable:
+			return CellUnavailableError(domainErr.Message)
+		case domain.ErrorCodeInvalidPickupCode:
+			return InvalidPickupCodeError(domainErr.Message)
+		case domain.ErrorCodePickupCodeLocked:
+			return PickupCodeLockedError(domainErr.Message)
+		case domain.ErrorCodePaymentRequired:
+			return PaymentRequiredError(domainErr.Message)
 		case domain.ErrorCodeNilOrder:
 			return ValidationFailedError(domainErr.Message)
 		case domain.ErrorCodeInvalidPackage:
 			return ValidationFailedError(domainErr.Message)
 		case domain.ErrorCodeWeightTooHeavy:
 			return WeightTooHeavyError(domainErr.Message)
+		case domain.ErrorCodeStorageDateInPast:
+			return ValidationFailedError(domainErr.Message)
 		default:
 			return InternalError(err)
 		}
@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+// колонки выгрузки: первые совпадают с колонками import-orders, поэтому файл можно импортировать обратно
+var exportCSVColumns = append(append([]string{}, importCSVColumns...),
+	"status", "accept_time", "cell_code", "payment_status")
+
+// exportOrderRow — заказ в выгрузке JSONL
+type exportOrderRow struct {
+	domain.OrderToImport
+	Status        string    `json:"status"`
+	AcceptTime    time.Time `json:"accept_time"`
+	CellCode      string    `json:"cell_code,omitempty"`
+	PaymentStatus string    `json:"payment_status"`
+}
+
+func (a *CLIAdapter) ExportOrdersComm(cmd *cobra.Command, args []string) error {
+	out, err := cmd.Flags().GetString("out")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	format, err = exportFormat(format, out)
+	if err != nil {
+		return err
+	}
+	f, err := exportFilterFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	var dst io.Writer = os.Stdout
+	if out != "" {
+		file, err := os.Create(out)
+		if err != nil {
+			return fmt.Errorf("os.Create: %w", err)
+		}
+		defer file.Close()
+		dst = file
+	}
+	buf := bufio.NewWriter(dst)
+	w, err := newOrderExportWriter(buf, format)
+	if err != nil {
+		return err
+	}
+
+	var exported uint64
+	err = a.appService.ExportOrders(f, func(order domain.Order) error {
+		exported++
+		return w.write(order)
+	})
+	if err == nil {
+		err = w.flush()
+	}
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		if out != "" {
+			// недописанный файл легко принять за полную выгрузку
+			_ = os.Remove(out)
+		}
+		return fmt.Errorf("appService.ExportOrders: %w", err)
+	}
+
+	if out == "" {
+		// stdout занят выгрузкой, итог печатаем отдельно
+		fmt.Fprintf(os.Stderr, "EXPORTED: %d\n", exported)
+		return nil
+	}
+	fmt.Printf("EXPORTED: %d %s\n", exported, out)
+	return nil
+}
+
+// exportFormat возвращает формат выгрузки: явно заданный, по расширению файла или JSONL для stdout
+func exportFormat(format, out string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(out)), ".")
+		if format == "" || format == "ndjson" {
+			format = ImportFormatJSONL
+		}
+	}
+	switch format {
+	case ImportFormatCSV, ImportFormatJSONL:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown export format %q, use csv or jsonl", format)
+	}
+}
+
+func exportFilterFromFlags(cmd *cobra.Command) (domain.OrderExportFilter, error) {
+	var f domain.OrderExportFilter
+	statuses, err := cmd.Flags().GetString("status")
+	if err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+	for _, code := range strings.Split(statuses, ",") {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			continue
+		}
+		st, ok := domain.ParseOrderStatus(code)
+		if !ok {
+			return f, domain.ValidationFailedError(fmt.Sprintf("unknown order status %q", code))
+		}
+		f.Statuses = append(f.Statuses, st)
+	}
+	if f.ReceiverID, err = cmd.Flags().GetUint64("user-id"); err != nil {
+		return f, fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	if f.Phone, err = cmd.Flags().GetString("phone"); err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+	if f.PackageType, err = cmd.Flags().GetString("package"); err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	from, err := cmd.Flags().GetString("from")
+	if err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+	if from != "" {
+		if f.AcceptedFrom, err = MapStringToTime(from); err != nil {
+			return f, fmt.Errorf("time.Parse: %w", err)
+		}
+	}
+	to, err := cmd.Flags().GetString("to")
+	if err != nil {
+		return f, fmt.Errorf("flag.GetString: %w", err)
+	}
+	if to != "" {
+		day, err := MapStringToTime(to)
+		if err != nil {
+			return f, fmt.Errorf("time.Parse: %w", err)
+		}
+		// дата окончания включается в интервал целиком
+		f.AcceptedTo = day.Add(24 * time.Hour)
+	}
+	return f, nil
+}
+
+// orderExportWriter пишет заказы по одному: CSV с заголовком или JSONL
+type orderExportWriter struct {
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+// newOrderExportWriter сразу пишет заголовок CSV, чтобы и пустая выгрузка была с ним
+func newOrderExportWriter(w io.Writer, format string) (*orderExportWriter, error) {
+	if format != ImportFormatCSV {
+		return &orderExportWriter{json: json.NewEncoder(w)}, nil
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportCSVColumns); err != nil {
+		return nil, fmt.Errorf("csv.Write: %w", err)
+	}
+	return &orderExportWriter{csv: cw}, nil
+}
+
+func (w *orderExportWriter) write(order domain.Order) error {
+	row := mapExportOrderRow(order)
+	if w.json != nil {
+		return w.json.Encode(row)
+	}
+	var sellerID string
+	if row.SellerID != 0 {
+		sellerID = strconv.FormatUint(row.SellerID, 10)
+	}
+	return w.csv.Write([]string{
+		strconv.FormatUint(row.OrderID, 10),
+		strconv.FormatUint(row.ReceiverID, 10),
+		row.StorageUntil,
+		row.PackageType,
+		row.Weight.String(),
+		row.Price.String(),
+		sellerID,
+		strconv.FormatBool(row.CashOnDelivery),
+		row.Status,
+		row.AcceptTime.Format(time.RFC3339),
+		row.CellCode,
+		row.PaymentStatus,
+	})
+}
+
+func (w *orderExportWriter) flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func mapExportOrderRow(order domain.Order) exportOrderRow {
+	return exportOrderRow{
+		OrderToImport: domain.OrderToImport{
+			OrderID:        order.OrderID,
+			ReceiverID:     order.ReceiverID,
+			StorageUntil:   MapTimeToString(order.StorageUntil),
+			PackageType:    order.PackageType,
+			Weight:         order.Weight,
+			Price:          order.Price,
+			SellerID:       order.SellerID,
+			CashOnDelivery: order.CashOnDelivery,
+		},
+		Status:        order.Status.Code(),
+		AcceptTime:    order.AcceptTime,
+		CellCode:      order.CellCode,
+		PaymentStatus: order.PaymentStatus.String(),
+	}
+}
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func (a *CLIAdapter) ExtendStorageComm(cmd *cobra.Command, args []string) error {
+	orderID, err := cmd.Flags().GetUint64("order-id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	days, err := cmd.Flags().GetUint32("days")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint32: %w", err)
+	}
+
+	order, fee, err := a.appService.ExtendStorage(orderID, days)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("STORAGE_EXTENDED: %d\n", order.OrderID)
+	fmt.Printf("STORAGE_UNTIL: %s\n", MapTimeToString(order.StorageUntil))
+	fmt.Printf("FEE: %s\n", fee)
+	return nil
+}
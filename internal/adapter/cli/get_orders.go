@@ -41,6 +41,14 @@ func (a *CLIAdapter) GetReturnedOrders(cmd *cobra.Command, args []string) error
 }
 
 func (a *CLIAdapter) GetOrdersSortedByTime(cmd *cobra.Command, args []string) error {
+	orderID, err := cmd.Flags().GetUint64("order-id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	if orderID != 0 {
+		return a.printOrderHistory(orderID)
+	}
+
 	allOrders, err := a.appService.GetOrderHistory()
 	if err != nil {
 		return err
@@ -60,3 +68,31 @@ func (a *CLIAdapter) GetOrdersSortedByTime(cmd *cobra.Command, args []string) er
 	}
 	return nil
 }
+
+func (a *CLIAdapter) printOrderHistory(orderID uint64) error {
+	history, err := a.appService.GetOrderHistoryByID(orderID)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		fmt.Println("No history for this order.")
+		return nil
+	}
+
+	for _, h := range history {
+		prev := "-"
+		if h.PrevStatus != nil {
+			prev = h.PrevStatus.String()
+		}
+		actor := "-"
+		if h.Actor.Type != "" {
+			actor = fmt.Sprintf("%s:%d", h.Actor.Type, h.Actor.ID)
+		}
+		fmt.Printf("HISTORY: %d %s -> %s %s\n", h.OrderID, prev, h.Status, MapTimeToString(h.ChangedAt))
+		fmt.Printf("  ACTOR: %s REASON: %s\n", actor, h.Reason)
+		if h.Comment != "" {
+			fmt.Printf("  COMMENT: %s\n", h.Comment)
+		}
+	}
+	return nil
+}
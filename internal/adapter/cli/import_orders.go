@@ -4,35 +4,127 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 )
 
+// через сколько строк печатать прогресс импорта
+const importProgressEvery = 1000
+
+// importReportRow — строка отчета проверки импорта (--dry-run), по JSON-объекту на строку файла
+type importReportRow struct {
+	Row       uint64           `json:"row"`
+	OrderID   uint64           `json:"order_id"`
+	OK        bool             `json:"ok"`
+	ErrorCode domain.ErrorCode `json:"error_code,omitempty"`
+	Reason    string           `json:"reason,omitempty"`
+}
+
 func (a *CLIAdapter) ImportOrdersComm(cmd *cobra.Command, args []string) error {
 	filePath, err := cmd.Flags().GetString("file")
 	if err != nil || filePath == "" {
 		return fmt.Errorf("flag.GetString: %w", err)
 	}
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	format, err = importFormat(format, filePath)
+	if err != nil {
+		return err
+	}
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return fmt.Errorf("flag.GetBool: %w", err)
+	}
 
-	data, err := os.ReadFile(filePath)
+	file, err := os.Open(filePath)
 	if err != nil {
-		return fmt.Errorf("os.ReadFile: %w", err)
+		return fmt.Errorf("os.Open: %w", err)
 	}
+	defer file.Close()
 
-	var ordersToImport []domain.OrderToImport
-	if err := json.Unmarshal(data, &ordersToImport); err != nil {
-		return fmt.Errorf("json.Unmarshal: %w", err)
+	// файл читается параллельно с импортом: в памяти только строки, которые сейчас обрабатываются
+	rows := make(chan domain.ImportRow)
+	done := make(chan struct{})
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(rows)
+		readErr <- readImportRows(file, format, func(row domain.ImportRow) bool {
+			select {
+			case rows <- row:
+				return true
+			case <-done:
+				return false
+			}
+		})
+	}()
+
+	importRows := a.appService.ImportOrdersStream
+	if dryRun {
+		importRows = a.appService.ValidateImportOrdersStream
 	}
+	report := json.NewEncoder(os.Stdout)
 
-	importedCount, err := a.appService.ImportOrders(ordersToImport)
-	if err != nil {
-		if importedCount > 0 {
-			fmt.Printf("IMPORTED: %d orders successfully.\n", importedCount)
+	started := time.Now()
+	var total, imported, failed uint64
+	err = importRows(rows, func(res domain.ImportRowResult) error {
+		total++
+		if res.Result.OK() {
+			imported++
+		} else {
+			failed++
 		}
-		return err
+		if dryRun {
+			return report.Encode(mapImportReportRow(res))
+		}
+		if !res.Result.OK() {
+			fmt.Printf("ROW %d: ORDER %d FAILED [%d]: %v\n", res.Row, res.Result.OrderID, res.Result.ErrorCode(), res.Result.Err)
+		}
+		if total%importProgressEvery == 0 {
+			fmt.Fprintf(os.Stderr, "PROGRESS: %d rows, %d imported, %d failed, %s\n",
+				total, imported, failed, time.Since(started).Round(time.Millisecond))
+		}
+		return nil
+	})
+	close(done)
+	if rerr := <-readErr; rerr != nil && err == nil {
+		err = fmt.Errorf("read %s: %w", filePath, rerr)
+	}
+
+	if dryRun {
+		// stdout занят отчетом, итог печатаем отдельно
+		fmt.Fprintf(os.Stderr, "ROWS: %d\n", total)
+		fmt.Fprintf(os.Stderr, "VALID: %d\n", imported)
+		fmt.Fprintf(os.Stderr, "INVALID: %d\n", failed)
+		if err != nil {
+			return fmt.Errorf("appService.ValidateImportOrdersStream: %w", err)
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d rows would not be imported", failed, total)
+		}
+		return nil
 	}
 
-	fmt.Printf("IMPORTED: %d\n", importedCount)
+	fmt.Printf("ROWS: %d\n", total)
+	fmt.Printf("IMPORTED: %d\n", imported)
+	fmt.Printf("FAILED: %d\n", failed)
+	if err != nil {
+		return fmt.Errorf("appService.ImportOrdersStream: %w", err)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d rows were not imported", failed, total)
+	}
 	return nil
 }
+
+func mapImportReportRow(res domain.ImportRowResult) importReportRow {
+	out := importReportRow{Row: res.Row, OrderID: res.Result.OrderID, OK: res.Result.OK()}
+	if !out.OK {
+		out.ErrorCode = res.Result.ErrorCode()
+		out.Reason = res.Result.Err.Error()
+	}
+	return out
+}
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+const (
+	ImportFormatCSV   = "csv"
+	ImportFormatJSON  = "json"
+	ImportFormatJSONL = "jsonl"
+
+	// строка JSONL с одним заказом, длиннее которой файл считаем испорченным
+	maxImportLineSize = 1 << 20
+)
+
+// колонки CSV в порядке по умолчанию; если в файле есть заголовок, порядок берется из него
+var importCSVColumns = []string{
+	"order_id", "receiver_id", "storage_until", "package_type",
+	"weight", "price", "seller_id", "cash_on_delivery",
+}
+
+// importFormat возвращает формат файла: явно заданный или по расширению
+func importFormat(format, filePath string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), ".")
+		if format == "ndjson" {
+			format = ImportFormatJSONL
+		}
+	}
+	switch format {
+	case ImportFormatCSV, ImportFormatJSON, ImportFormatJSONL:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown import format %q, use csv, json or jsonl", format)
+	}
+}
+
+// readImportRows читает файл по одной строке и передает каждую в emit, не загружая файл целиком.
+// Неразобранная строка уходит в emit с ошибкой, чтобы попасть в отчет; ошибка самого чтения
+// (или испорченная структура файла) прекращает чтение
+func readImportRows(r io.Reader, format string, emit func(domain.ImportRow) bool) error {
+	switch format {
+	case ImportFormatCSV:
+		return readImportCSV(r, emit)
+	case ImportFormatJSON:
+		return readImportJSON(r, emit)
+	case ImportFormatJSONL:
+		return readImportJSONL(r, emit)
+	default:
+		return fmt.Errorf("unknown import format %q", format)
+	}
+}
+
+func readImportJSONL(r io.Reader, emit func(domain.ImportRow) bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxImportLineSize)
+
+	var row uint64
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		row++
+		item := domain.ImportRow{Row: row}
+		if err := json.Unmarshal([]byte(line), &item.Order); err != nil {
+			item.Err = importParseError(err)
+		}
+		if !emit(item) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanner.Scan: %w", err)
+	}
+	return nil
+}
+
+func readImportJSON(r io.Reader, emit func(domain.ImportRow) bool) error {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("json.Token: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("json: expected array of orders")
+	}
+
+	var row uint64
+	for dec.More() {
+		row++
+		// сначала только выделяем элемент: ошибка здесь значит, что файл дальше не прочитать,
+		// а ошибка разбора самого заказа касается одной строки
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("json.Decode row %d: %w", row, err)
+		}
+		item := domain.ImportRow{Row: row}
+		if err := json.Unmarshal(raw, &item.Order); err != nil {
+			item.Err = importParseError(err)
+		}
+		if !emit(item) {
+			return nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("json.Token: %w", err)
+	}
+	return nil
+}
+
+func readImportCSV(r io.Reader, emit func(domain.ImportRow) bool) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	columns := make(map[string]int, len(importCSVColumns))
+	for i, name := range importCSVColumns {
+		columns[name] = i
+	}
+
+	var row uint64
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		var parseErr *csv.ParseError
+		if err != nil && !errors.As(err, &parseErr) {
+			return fmt.Errorf("csv.Read: %w", err)
+		}
+		if first && err == nil && len(record) > 0 && strings.TrimSpace(record[0]) == "order_id" {
+			columns = csvHeaderColumns(record)
+			continue
+		}
+
+		row++
+		item := domain.ImportRow{Row: row}
+		if err != nil {
+			item.Err = importParseError(err)
+		} else {
+			item.Order, item.Err = parseImportCSVRecord(record, columns)
+		}
+		if !emit(item) {
+			return nil
+		}
+	}
+}
+
+func csvHeaderColumns(header []string) map[string]int {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	return columns
+}
+
+func parseImportCSVRecord(record []string, columns map[string]int) (domain.OrderToImport, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var (
+		order domain.OrderToImport
+		err   error
+	)
+	if order.OrderID, err = strconv.ParseUint(field("order_id"), 10, 64); err != nil {
+		return order, importParseError(fmt.Errorf("order_id: %w", err))
+	}
+	if order.ReceiverID, err = strconv.ParseUint(field("receiver_id"), 10, 64); err != nil {
+		return order, importParseError(fmt.Errorf("receiver_id: %w", err))
+	}
+	order.StorageUntil = field("storage_until")
+	order.PackageType = field("package_type")
+	if err := order.Weight.UnmarshalText([]byte(field("weight"))); err != nil {
+		return order, importParseError(fmt.Errorf("weight: %w", err))
+	}
+	if err := order.Price.UnmarshalText([]byte(field("price"))); err != nil {
+		return order, importParseError(fmt.Errorf("price: %w", err))
+	}
+	if v := field("seller_id"); v != "" {
+		if order.SellerID, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return order, importParseError(fmt.Errorf("seller_id: %w", err))
+		}
+	}
+	if v := field("cash_on_delivery"); v != "" {
+		if order.CashOnDelivery, err = strconv.ParseBool(v); err != nil {
+			return order, importParseError(fmt.Errorf("cash_on_delivery: %w", err))
+		}
+	}
+	return order, nil
+}
+
+func importParseError(err error) error {
+	return fmt.Errorf("validation: %w", domain.ValidationFailedError(err.Error()))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 )
 
 func (a *CLIAdapter) ListOrdersComm(cmd *cobra.Command, args []string) error {
@@ -11,6 +12,10 @@ func (a *CLIAdapter) ListOrdersComm(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("flag.GetUint64: %w", err)
 	}
+	phone, err := cmd.Flags().GetString("phone")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
 	inPvz, err := cmd.Flags().GetBool("in-pvz")
 	if err != nil {
 		return fmt.Errorf("flag.GetBool: %w", err)
@@ -31,6 +36,9 @@ func (a *CLIAdapter) ListOrdersComm(cmd *cobra.Command, args []string) error {
 	if lastN > 0 && (page > 0 || limit > 0) {
 		return fmt.Errorf("invalid flags combination")
 	}
+	if (receiverID == 0) == (phone == "") {
+		return fmt.Errorf("exactly one of --user-id or --phone is required")
+	}
 
 	if page == 0 {
 		page = 1
@@ -39,7 +47,15 @@ func (a *CLIAdapter) ListOrdersComm(cmd *cobra.Command, args []string) error {
 		limit = 10
 	}
 
-	orders, totalItems, err := a.appService.GetReceiverOrders(receiverID, inPvz, lastN, page, limit)
+	var (
+		orders     []*domain.Order
+		totalItems uint64
+	)
+	if phone != "" {
+		orders, totalItems, err = a.appService.GetReceiverOrdersByPhone(phone, inPvz, lastN, page, limit)
+	} else {
+		orders, totalItems, err = a.appService.GetReceiverOrders(receiverID, inPvz, lastN, page, limit)
+	}
 	if err != nil {
 		return err
 	}
@@ -48,9 +64,11 @@ func (a *CLIAdapter) ListOrdersComm(cmd *cobra.Command, args []string) error {
 		fmt.Println("No orders found for this receiver with the given criteria.")
 	} else {
 		for _, order := range orders {
-			fmt.Printf("Order: %d Receiver: %d Status: %s Storage Limit: %s Package: %s Weight: %.2f Price: %.2f\n",
+			fmt.Printf("Order: %d Receiver: %d PVZ: %d Cell: %s Status: %s Storage Limit: %s Package: %s Weight: %s Price: %s\n",
 				order.OrderID,
 				order.ReceiverID,
+				order.PVZID,
+				MapCellCode(order.CellCode),
 				order.GetStatusString(),
 				MapTimeToString(order.StorageUntil),
 				MapPackageType(order.PackageType),
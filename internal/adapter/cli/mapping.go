@@ -20,3 +20,10 @@ func MapPackageType(packageType string) string {
 	}
 	return packageType
 }
+
+func MapCellCode(cellCode string) string {
+	if cellCode == "" {
+		return "-"
+	}
+	return cellCode
+}
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func (a *CLIAdapter) MoveOrderComm(cmd *cobra.Command, args []string) error {
+	orderID, err := cmd.Flags().GetUint64("order-id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	cellCode, err := cmd.Flags().GetString("cell")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	order, err := a.appService.MoveOrder(orderID, cellCode)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("ORDER_MOVED: %d CELL: %s\n", order.OrderID, MapCellCode(order.CellCode))
+	return nil
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"go.uber.org/multierr"
+)
+
+// printOrderResults печатает итог пакетной операции таблицей, по строке на заказ,
+// и возвращает ошибки необработанных заказов одной multierr
+func printOrderResults(results []domain.OrderResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ORDER_ID\tRESULT\tSTATUS\tCODE\tMESSAGE")
+
+	var errs error
+	for _, r := range results {
+		status := "-"
+		if r.Status != nil {
+			status = r.Status.String()
+		}
+		if r.OK() {
+			fmt.Fprintf(w, "%d\tOK\t%s\t-\t-\n", r.OrderID, status)
+			continue
+		}
+		fmt.Fprintf(w, "%d\tFAILED\t%s\t%d\t%s\n", r.OrderID, status, r.ErrorCode(), r.Err)
+		errs = multierr.Append(errs, r.Err)
+	}
+	_ = w.Flush()
+	return errs
+}
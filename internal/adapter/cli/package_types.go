@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+// общий разбор флагов create-package-type и update-package-type
+func packageTypeFromFlags(cmd *cobra.Command) (domain.PackageType, error) {
+	code, err := cmd.Flags().GetString("code")
+	if err != nil {
+		return domain.PackageType{}, fmt.Errorf("flag.GetString: %w", err)
+	}
+	kindStr, err := cmd.Flags().GetString("kind")
+	if err != nil {
+		return domain.PackageType{}, fmt.Errorf("flag.GetString: %w", err)
+	}
+	maxWeightStr, err := cmd.Flags().GetString("max-weight")
+	if err != nil {
+		return domain.PackageType{}, fmt.Errorf("flag.GetString: %w", err)
+	}
+	priceStr, err := cmd.Flags().GetString("price")
+	if err != nil {
+		return domain.PackageType{}, fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	kind, ok := domain.ParsePackageKind(kindStr)
+	if !ok {
+		return domain.PackageType{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("Invalid value for flag --kind"))
+	}
+	maxWeight, err := domain.ParseWeight(maxWeightStr)
+	if err != nil {
+		return domain.PackageType{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("Invalid value for flag --max-weight"))
+	}
+	price, err := domain.ParseMoney(priceStr)
+	if err != nil {
+		return domain.PackageType{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("Invalid value for flag --price"))
+	}
+	return domain.PackageType{Code: code, Kind: kind, MaxWeight: maxWeight, Price: price}, nil
+}
+
+func (a *CLIAdapter) CreatePackageTypeComm(cmd *cobra.Command, args []string) error {
+	p, err := packageTypeFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	p, err = a.appService.CreatePackageType(p)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("PACKAGE_TYPE_CREATED: %s\n", p.Code)
+	return nil
+}
+
+func (a *CLIAdapter) UpdatePackageTypeComm(cmd *cobra.Command, args []string) error {
+	p, err := packageTypeFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	p, err = a.appService.UpdatePackageType(p)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("PACKAGE_TYPE_UPDATED: %s\n", p.Code)
+	return nil
+}
+
+func (a *CLIAdapter) DeletePackageTypeComm(cmd *cobra.Command, args []string) error {
+	code, err := cmd.Flags().GetString("code")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	if err := a.appService.DeletePackageType(code); err != nil {
+		return err
+	}
+	fmt.Printf("PACKAGE_TYPE_DELETED: %s\n", code)
+	return nil
+}
+
+func (a *CLIAdapter) ListPackageTypesComm(cmd *cobra.Command, args []string) error {
+	types, err := a.appService.ListPackageTypes()
+	if err != nil {
+		return err
+	}
+	for _, p := range types {
+		maxWeight := "unlimited"
+		if p.MaxWeight > 0 {
+			maxWeight = p.MaxWeight.String()
+		}
+		fmt.Printf("Package: %s Kind: %s Max Weight: %s Price: %s\n", p.Code, p.Kind, maxWeight, p.Price)
+	}
+	fmt.Printf("TOTAL: %d\n", len(types))
+	return nil
+}
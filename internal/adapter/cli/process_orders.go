@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 )
 
 func (a *CLIAdapter) ProcessOrders(cmd *cobra.Command, args []string) error {
@@ -21,6 +22,14 @@ func (a *CLIAdapter) ProcessOrders(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("flag.GetString: %w", err)
 	}
+	pickupCode, err := cmd.Flags().GetString("code")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	atomic, err := cmd.Flags().GetBool("atomic")
+	if err != nil {
+		return fmt.Errorf("flag.GetBool: %w", err)
+	}
 
 	if action != "issue" && action != "return" {
 		return fmt.Errorf("invalid action '%s'", action)
@@ -35,18 +44,31 @@ func (a *CLIAdapter) ProcessOrders(cmd *cobra.Command, args []string) error {
 		}
 		orderIDs = append(orderIDs, orderID)
 	}
-	if action == "issue" {
-		err = a.appService.IssueOrdersToClient(receiverID, orderIDs)
-	} else {
-		err = a.appService.ReturnOrdersFromClient(receiverID, orderIDs)
+	var results []domain.OrderResult
+	switch {
+	case action == "issue" && atomic:
+		results, err = a.appService.IssueOrdersToClientAtomic(receiverID, orderIDs, pickupCode)
+	case action == "issue":
+		results, err = a.appService.IssueOrdersToClient(receiverID, orderIDs, pickupCode)
+	case atomic:
+		results, err = a.appService.ReturnOrdersFromClientAtomic(receiverID, orderIDs)
+	default:
+		results, err = a.appService.ReturnOrdersFromClient(receiverID, orderIDs)
 	}
-
 	if err != nil {
 		return err
 	}
 
-	for _, orderID := range orderIDs {
-		fmt.Printf("PROCESSED: %d\n", orderID)
+	failed := printOrderResults(results)
+	var total domain.Money
+	for _, r := range results {
+		if f := r.StorageFee; r.OK() && f.Amount > 0 {
+			fmt.Printf("STORAGE_FEE: %d DAYS: %d AMOUNT: %s\n", f.OrderID, f.PaidDays, f.Amount)
+			total += f.Amount
+		}
+	}
+	if total > 0 {
+		fmt.Printf("TOTAL_STORAGE_FEE: %s\n", total)
 	}
-	return nil
+	return failed
 }
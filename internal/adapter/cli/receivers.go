@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func (a *CLIAdapter) UpsertReceiverComm(cmd *cobra.Command, args []string) error {
+	receiverID, err := cmd.Flags().GetUint64("user-id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	phone, err := cmd.Flags().GetString("phone")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	rec, err := a.appService.UpsertReceiver(domain.Receiver{ID: receiverID, Phone: phone, Name: name})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("RECEIVER: %d %s %s\n", rec.ID, rec.Phone, rec.Name)
+	return nil
+}
+
+func (a *CLIAdapter) SearchReceiversComm(cmd *cobra.Command, args []string) error {
+	query, err := cmd.Flags().GetString("query")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	limit, err := cmd.Flags().GetUint64("limit")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+
+	list, err := a.appService.SearchReceivers(query, limit)
+	if err != nil {
+		return err
+	}
+	for _, rec := range list {
+		fmt.Printf("RECEIVER: %d %s %s\n", rec.ID, rec.Phone, rec.Name)
+	}
+	fmt.Printf("TOTAL: %d\n", len(list))
+	return nil
+}
@@ -11,9 +11,11 @@ func (a *CLIAdapter) registerCommands(rootCmd *cobra.Command) {
 	acceptOrderCmd.Flags().Uint64P("order-id", "", 0, "ID of the order")
 	acceptOrderCmd.Flags().Uint64P("user-id", "", 0, "ID of the receiver")
 	acceptOrderCmd.Flags().StringP("expires", "", "", "Storage expiration date (YYYY-MM-DD)")
-	acceptOrderCmd.Flags().Float64P("weight", "", 0, "Weight of the order in kg")
-	acceptOrderCmd.Flags().Float64P("price", "", 0, "Price of the order in RUB")
-	acceptOrderCmd.Flags().StringP("package", "", "", "Package type: bag, box, film, bag+film, box+film")
+	acceptOrderCmd.Flags().StringP("weight", "", "", "Weight of the order in kg, up to grams (e.g. 1.250)")
+	acceptOrderCmd.Flags().StringP("price", "", "", "Price of the order in RUB, up to kopecks (e.g. 99.90)")
+	acceptOrderCmd.Flags().StringP("package", "", "", "Package code from the catalogue; wrappings combine with '+' (e.g. box+film)")
+	acceptOrderCmd.Flags().Uint64P("seller-id", "", 0, "ID of the seller (selects the return policy)")
+	acceptOrderCmd.Flags().BoolP("cod", "", false, "Cash on delivery: the order is paid at pickup")
 	_ = acceptOrderCmd.MarkFlagRequired("order-id")
 	_ = acceptOrderCmd.MarkFlagRequired("user-id")
 	_ = acceptOrderCmd.MarkFlagRequired("expires")
@@ -30,6 +32,171 @@ func (a *CLIAdapter) registerCommands(rootCmd *cobra.Command) {
 	_ = returnOrderCmd.MarkFlagRequired("order-id")
 	rootCmd.AddCommand(returnOrderCmd)
 
+	extendStorageCmd := &cobra.Command{
+		Use:   "extend-storage",
+		Short: "Extends the storage period of an order.",
+		RunE:  a.ExtendStorageComm,
+	}
+	extendStorageCmd.Flags().Uint64P("order-id", "", 0, "ID of the order")
+	extendStorageCmd.Flags().Uint32P("days", "", 0, "Number of days to extend storage by")
+	_ = extendStorageCmd.MarkFlagRequired("order-id")
+	_ = extendStorageCmd.MarkFlagRequired("days")
+	rootCmd.AddCommand(extendStorageCmd)
+
+	moveOrderCmd := &cobra.Command{
+		Use:   "move-order",
+		Short: "Moves an order to another storage cell.",
+		RunE:  a.MoveOrderComm,
+	}
+	moveOrderCmd.Flags().Uint64P("order-id", "", 0, "ID of the order to move")
+	moveOrderCmd.Flags().StringP("cell", "", "", "Code of the target storage cell")
+	_ = moveOrderCmd.MarkFlagRequired("order-id")
+	_ = moveOrderCmd.MarkFlagRequired("cell")
+	rootCmd.AddCommand(moveOrderCmd)
+
+	confirmPaymentCmd := &cobra.Command{
+		Use:   "confirm-payment",
+		Short: "Confirms payment for a cash-on-delivery order.",
+		RunE:  a.ConfirmPaymentComm,
+	}
+	confirmPaymentCmd.Flags().Uint64P("order-id", "", 0, "ID of the order")
+	_ = confirmPaymentCmd.MarkFlagRequired("order-id")
+	rootCmd.AddCommand(confirmPaymentCmd)
+
+	announceOrdersCmd := &cobra.Command{
+		Use:   "announce-orders",
+		Short: "Announces orders of a shipment before they arrive.",
+		RunE:  a.AnnounceOrdersComm,
+	}
+	announceOrdersCmd.Flags().StringP("shipment", "", "", "ID of the shipment")
+	announceOrdersCmd.Flags().StringP("file", "", "", "Path to the JSON file with orders")
+	_ = announceOrdersCmd.MarkFlagRequired("shipment")
+	_ = announceOrdersCmd.MarkFlagRequired("file")
+	rootCmd.AddCommand(announceOrdersCmd)
+
+	confirmArrivalCmd := &cobra.Command{
+		Use:   "confirm-arrival",
+		Short: "Confirms which announced orders of a shipment have arrived.",
+		RunE:  a.ConfirmArrivalComm,
+	}
+	confirmArrivalCmd.Flags().StringP("shipment", "", "", "ID of the shipment")
+	confirmArrivalCmd.Flags().StringP("order-ids", "", "", "Comma-separated list of arrived order IDs")
+	_ = confirmArrivalCmd.MarkFlagRequired("shipment")
+	rootCmd.AddCommand(confirmArrivalCmd)
+
+	discrepancyReportCmd := &cobra.Command{
+		Use:   "discrepancy-report",
+		Short: "Lists missing and unexpected parcels of shipments.",
+		RunE:  a.DiscrepancyReportComm,
+	}
+	discrepancyReportCmd.Flags().StringP("shipment", "", "", "ID of the shipment, all shipments if empty")
+	rootCmd.AddCommand(discrepancyReportCmd)
+
+	sweepReturnsCmd := &cobra.Command{
+		Use:   "sweep-returns",
+		Short: "Collects expired and client-returned orders into a courier return manifest.",
+		RunE:  a.SweepReturnsComm,
+	}
+	rootCmd.AddCommand(sweepReturnsCmd)
+
+	listManifestsCmd := &cobra.Command{
+		Use:   "list-manifests",
+		Short: "Lists courier return manifests.",
+		RunE:  a.ListManifestsComm,
+	}
+	rootCmd.AddCommand(listManifestsCmd)
+
+	exportManifestCmd := &cobra.Command{
+		Use:   "export-manifest",
+		Short: "Exports a return manifest as CSV or JSON.",
+		RunE:  a.ExportManifestComm,
+	}
+	exportManifestCmd.Flags().Uint64P("id", "", 0, "ID of the manifest")
+	exportManifestCmd.Flags().StringP("format", "", "csv", "Export format: csv or json")
+	exportManifestCmd.Flags().StringP("out", "", "", "Output file, stdout if empty")
+	_ = exportManifestCmd.MarkFlagRequired("id")
+	rootCmd.AddCommand(exportManifestCmd)
+
+	handOverManifestCmd := &cobra.Command{
+		Use:   "handover-manifest",
+		Short: "Hands all orders of a return manifest over to the courier.",
+		RunE:  a.HandOverManifestComm,
+	}
+	handOverManifestCmd.Flags().Uint64P("id", "", 0, "ID of the manifest")
+	_ = handOverManifestCmd.MarkFlagRequired("id")
+	rootCmd.AddCommand(handOverManifestCmd)
+
+	upsertReceiverCmd := &cobra.Command{
+		Use:   "upsert-receiver",
+		Short: "Creates or updates a receiver in the directory.",
+		RunE:  a.UpsertReceiverComm,
+	}
+	upsertReceiverCmd.Flags().Uint64P("user-id", "", 0, "ID of the receiver")
+	upsertReceiverCmd.Flags().StringP("phone", "", "", "Phone number")
+	upsertReceiverCmd.Flags().StringP("name", "", "", "Full name")
+	_ = upsertReceiverCmd.MarkFlagRequired("user-id")
+	_ = upsertReceiverCmd.MarkFlagRequired("phone")
+	rootCmd.AddCommand(upsertReceiverCmd)
+
+	searchReceiversCmd := &cobra.Command{
+		Use:   "search-receivers",
+		Short: "Finds receivers by part of phone or name.",
+		RunE:  a.SearchReceiversComm,
+	}
+	searchReceiversCmd.Flags().StringP("query", "", "", "Part of phone number or name")
+	searchReceiversCmd.Flags().Uint64P("limit", "", 20, "Maximum number of receivers")
+	_ = searchReceiversCmd.MarkFlagRequired("query")
+	rootCmd.AddCommand(searchReceiversCmd)
+
+	auditLogCmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Searches the audit log of mutating API calls or verifies its hash chain.",
+		RunE:  a.AuditLogComm,
+	}
+	auditLogCmd.Flags().StringP("actor-type", "", "", "Actor type: courier, client, operator or system")
+	auditLogCmd.Flags().Uint64P("actor-id", "", 0, "Actor ID")
+	auditLogCmd.Flags().Uint64P("order-id", "", 0, "Only calls touching this order")
+	auditLogCmd.Flags().StringP("from", "", "", "Start date (YYYY-MM-DD)")
+	auditLogCmd.Flags().StringP("to", "", "", "End date inclusive (YYYY-MM-DD)")
+	auditLogCmd.Flags().Uint64P("limit", "", 100, "Maximum number of records")
+	auditLogCmd.Flags().BoolP("verify", "", false, "Verify the hash chain instead of searching")
+	rootCmd.AddCommand(auditLogCmd)
+
+	createPackageTypeCmd := &cobra.Command{
+		Use:   "create-package-type",
+		Short: "Adds a package type to the catalogue.",
+		RunE:  a.CreatePackageTypeComm,
+	}
+	updatePackageTypeCmd := &cobra.Command{
+		Use:   "update-package-type",
+		Short: "Replaces kind, weight limit and price of a package type.",
+		RunE:  a.UpdatePackageTypeComm,
+	}
+	for _, cmd := range []*cobra.Command{createPackageTypeCmd, updatePackageTypeCmd} {
+		cmd.Flags().StringP("code", "", "", "Package code (lowercase, without '+')")
+		cmd.Flags().StringP("kind", "", "container", "Package kind: container or wrapping")
+		cmd.Flags().StringP("max-weight", "", "0", "Maximum order weight in kg, 0 for unlimited")
+		cmd.Flags().StringP("price", "", "0", "Package price in RUB")
+		_ = cmd.MarkFlagRequired("code")
+		rootCmd.AddCommand(cmd)
+	}
+
+	deletePackageTypeCmd := &cobra.Command{
+		Use:   "delete-package-type",
+		Short: "Removes a package type from the catalogue.",
+		RunE:  a.DeletePackageTypeComm,
+	}
+	deletePackageTypeCmd.Flags().StringP("code", "", "", "Package code")
+	_ = deletePackageTypeCmd.MarkFlagRequired("code")
+	rootCmd.AddCommand(deletePackageTypeCmd)
+
+	listPackageTypesCmd := &cobra.Command{
+		Use:   "list-package-types",
+		Short: "Lists the package type catalogue.",
+		RunE:  a.ListPackageTypesComm,
+	}
+	rootCmd.AddCommand(listPackageTypesCmd)
+
 	processOrdersCmd := &cobra.Command{
 		Use:   "process-orders",
 		Short: "Issues orders to a client or accepts returns from a client.",
@@ -38,6 +205,8 @@ func (a *CLIAdapter) registerCommands(rootCmd *cobra.Command) {
 	processOrdersCmd.Flags().Uint64P("user-id", "", 0, "ID of the receiver")
 	processOrdersCmd.Flags().StringP("action", "", "", "Action to perform: 'issue' or 'return'")
 	processOrdersCmd.Flags().StringP("order-ids", "", "", "Comma-separated list of order IDs")
+	processOrdersCmd.Flags().StringP("code", "", "", "Pickup code from the receiver's notification (required for 'issue')")
+	processOrdersCmd.Flags().BoolP("atomic", "", false, "Process all orders in one transaction: if any order fails, none is processed")
 	_ = processOrdersCmd.MarkFlagRequired("user-id")
 	_ = processOrdersCmd.MarkFlagRequired("action")
 	_ = processOrdersCmd.MarkFlagRequired("order-ids")
@@ -49,11 +218,11 @@ func (a *CLIAdapter) registerCommands(rootCmd *cobra.Command) {
 		RunE:  a.ListOrdersComm,
 	}
 	listOrdersCmd.Flags().Uint64P("user-id", "", 0, "ID of the receiver")
+	listOrdersCmd.Flags().StringP("phone", "", "", "Phone of the receiver instead of --user-id")
 	listOrdersCmd.Flags().BoolP("in-pvz", "", false, "Filter for orders currently in PVZ storage")
 	listOrdersCmd.Flags().Uint64P("last", "", 0, "Show last N orders")
 	listOrdersCmd.Flags().Uint64P("page", "", 0, "Page number for pagination")
 	listOrdersCmd.Flags().Uint64P("limit", "", 0, "Items per page for pagination")
-	_ = listOrdersCmd.MarkFlagRequired("user-id")
 	rootCmd.AddCommand(listOrdersCmd)
 
 	listReturnsCmd := &cobra.Command{
@@ -70,17 +239,35 @@ func (a *CLIAdapter) registerCommands(rootCmd *cobra.Command) {
 		Short: "Shows the history of all order status changes (sorted by last update time).",
 		RunE:  a.GetOrdersSortedByTime,
 	}
+	orderHistoryCmd.Flags().Uint64P("order-id", "", 0, "Show detailed transitions of a single order")
 	rootCmd.AddCommand(orderHistoryCmd)
 
 	importOrdersCmd := &cobra.Command{
 		Use:   "import-orders",
-		Short: "Imports orders from a JSON file.",
+		Short: "Imports orders from a CSV, JSON or JSONL file row by row.",
 		RunE:  a.ImportOrdersComm,
 	}
-	importOrdersCmd.Flags().StringP("file", "", "", "Path to the JSON file with orders")
+	importOrdersCmd.Flags().StringP("file", "", "", "Path to the file with orders")
+	importOrdersCmd.Flags().StringP("format", "", "", "File format: csv, json or jsonl (by extension if omitted)")
+	importOrdersCmd.Flags().BoolP("dry-run", "", false, "Only validate the rows and print a JSONL report, nothing is saved")
 	_ = importOrdersCmd.MarkFlagRequired("file")
 	rootCmd.AddCommand(importOrdersCmd)
 
+	exportOrdersCmd := &cobra.Command{
+		Use:   "export-orders",
+		Short: "Exports orders matching the filters to a CSV or JSONL file.",
+		RunE:  a.ExportOrdersComm,
+	}
+	exportOrdersCmd.Flags().StringP("out", "", "", "Path to the output file (stdout if omitted)")
+	exportOrdersCmd.Flags().StringP("format", "", "", "File format: csv or jsonl (by extension if omitted, jsonl for stdout)")
+	exportOrdersCmd.Flags().StringP("status", "", "", "Comma-separated statuses: in_storage, given_to_client, returned_from_client, returned_without_client, given_to_courier, expected")
+	exportOrdersCmd.Flags().Uint64P("user-id", "", 0, "ID of the receiver")
+	exportOrdersCmd.Flags().StringP("phone", "", "", "Phone of the receiver instead of --user-id")
+	exportOrdersCmd.Flags().StringP("from", "", "", "Accepted on or after this date (YYYY-MM-DD)")
+	exportOrdersCmd.Flags().StringP("to", "", "", "Accepted on or before this date (YYYY-MM-DD)")
+	exportOrdersCmd.Flags().StringP("package", "", "", "Package code from the catalogue (e.g. box+film)")
+	rootCmd.AddCommand(exportOrdersCmd)
+
 	scrollOrdersCmd := &cobra.Command{
 		Use:   "scroll-orders",
 		Short: "Infinite orders scroll.",
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func (a *CLIAdapter) SweepReturnsComm(cmd *cobra.Command, args []string) error {
+	m, err := a.appService.SweepExpiredOrders()
+	if err != nil {
+		return err
+	}
+	if len(m.Items) == 0 {
+		fmt.Println("NOTHING_TO_RETURN")
+		return nil
+	}
+	printManifest(m)
+	return nil
+}
+
+func (a *CLIAdapter) ListManifestsComm(cmd *cobra.Command, args []string) error {
+	list, err := a.appService.ListReturnManifests()
+	if err != nil {
+		return err
+	}
+	for _, m := range list {
+		fmt.Printf("MANIFEST: %d %s %s ORDERS: %d\n",
+			m.ID, m.Status, MapTimeToString(m.CreatedAt), len(m.Items))
+	}
+	fmt.Printf("TOTAL: %d\n", len(list))
+	return nil
+}
+
+func (a *CLIAdapter) ExportManifestComm(cmd *cobra.Command, args []string) error {
+	id, err := cmd.Flags().GetUint64("id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	out, err := cmd.Flags().GetString("out")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	data, err := a.appService.ExportReturnManifest(id, format)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+	if err := os.WriteFile(out, data, 0o644); err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+	fmt.Printf("EXPORTED: %s\n", out)
+	return nil
+}
+
+func (a *CLIAdapter) HandOverManifestComm(cmd *cobra.Command, args []string) error {
+	id, err := cmd.Flags().GetUint64("id")
+	if err != nil {
+		return fmt.Errorf("flag.GetUint64: %w", err)
+	}
+
+	m, err := a.appService.HandOverReturnManifest(id)
+	if err != nil {
+		return err
+	}
+	printManifest(m)
+	return nil
+}
+
+func printManifest(m domain.ReturnManifest) {
+	fmt.Printf("MANIFEST: %d %s\n", m.ID, m.Status)
+	for _, it := range m.Items {
+		fmt.Printf("ORDER: %d RECEIVER: %d CELL: %s REASON: %s\n",
+			it.OrderID, it.ReceiverID, MapCellCode(it.CellCode), it.Reason())
+	}
+	fmt.Printf("TOTAL: %d\n", len(m.Items))
+}
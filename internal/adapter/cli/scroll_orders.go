@@ -85,9 +85,10 @@ func (a *CLIAdapter) printScrollOrders(orders []*domain.Order, nextLastID uint64
 			if packageType == "" {
 				packageType = "none"
 			}
-			fmt.Printf("ORDER: %d Receiver: %d Status: %s Storage Limit: %s Package: %s Weight: %.2f Price: %.2f\n",
+			fmt.Printf("ORDER: %d Receiver: %d Cell: %s Status: %s Storage Limit: %s Package: %s Weight: %s Price: %s\n",
 				order.OrderID,
 				order.ReceiverID,
+				MapCellCode(order.CellCode),
 				order.GetStatusString(),
 				MapTimeToString(order.StorageUntil),
 				packageType,
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func (a *CLIAdapter) AnnounceOrdersComm(cmd *cobra.Command, args []string) error {
+	shipmentID, err := cmd.Flags().GetString("shipment")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	filePath, err := cmd.Flags().GetString("file")
+	if err != nil || filePath == "" {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	// формат файла тот же, что у import-orders
+	var raw []domain.OrderToImport
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	reqs := make([]domain.AcceptOrderRequest, len(raw))
+	for i, o := range raw {
+		storageUntil, err := MapStringToTime(o.StorageUntil)
+		if err != nil {
+			return fmt.Errorf("time.Parse: %w", err)
+		}
+		reqs[i] = domain.AcceptOrderRequest{
+			ReceiverID:     o.ReceiverID,
+			OrderID:        o.OrderID,
+			StorageUntil:   storageUntil,
+			Weight:         o.Weight,
+			Price:          o.Price,
+			PackageType:    o.PackageType,
+			SellerID:       o.SellerID,
+			CashOnDelivery: o.CashOnDelivery,
+		}
+	}
+
+	results, err := a.appService.AnnounceOrders(shipmentID, reqs)
+	if err != nil {
+		return err
+	}
+
+	failed := printOrderResults(results)
+	fmt.Printf("ANNOUNCED: %d\n", len(domain.SucceededOrders(results)))
+	return failed
+}
+
+func (a *CLIAdapter) ConfirmArrivalComm(cmd *cobra.Command, args []string) error {
+	shipmentID, err := cmd.Flags().GetString("shipment")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+	orderIDsStr, err := cmd.Flags().GetString("order-ids")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	var orderIDs []uint64
+	for _, s := range strings.Split(orderIDsStr, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		orderID, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("strconv.ParseUint: %w", err)
+		}
+		orderIDs = append(orderIDs, orderID)
+	}
+
+	report, err := a.appService.ConfirmArrival(shipmentID, orderIDs)
+	if err != nil {
+		return err
+	}
+	failed := printOrderResults(report.Results)
+	for _, id := range report.Confirmed {
+		fmt.Printf("ARRIVED: %d\n", id)
+	}
+	printDiscrepancies(report.Discrepancies)
+	return failed
+}
+
+func (a *CLIAdapter) DiscrepancyReportComm(cmd *cobra.Command, args []string) error {
+	shipmentID, err := cmd.Flags().GetString("shipment")
+	if err != nil {
+		return fmt.Errorf("flag.GetString: %w", err)
+	}
+
+	list, err := a.appService.GetDiscrepancyReport(shipmentID)
+	if err != nil {
+		return err
+	}
+	printDiscrepancies(list)
+	fmt.Printf("TOTAL: %d\n", len(list))
+	return nil
+}
+
+func printDiscrepancies(list []domain.ArrivalDiscrepancy) {
+	for _, d := range list {
+		fmt.Printf("DISCREPANCY: %s %d %s %s\n",
+			d.ShipmentID, d.OrderID, strings.ToUpper(d.Kind.String()), MapTimeToString(d.DetectedAt))
+	}
+}
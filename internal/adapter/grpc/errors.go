@@ -2,11 +2,8 @@ package server
 
 import (
 	"errors"
-	"fmt"
-	"strings"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
-	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
 	"go.uber.org/multierr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,52 +17,33 @@ func MapErrorToGRPCStatus(err error) error {
 			return status.Error(codes.NotFound, domainErr.Message)
 		case domain.ErrorCodeAlreadyExists:
 			return status.Error(codes.AlreadyExists, domainErr.Message)
-		case domain.ErrorCodeStorageExpired, domain.ErrorCodeStorageNotExpired:
+		case domain.ErrorCodeStorageExpired, domain.ErrorCodeStorageNotExpired, domain.ErrorCodeInvalidTransition,
+			domain.ErrorCodeReturnPeriodExpired, domain.ErrorCodeNotReturnable, domain.ErrorCodeExtensionLimit,
+			domain.ErrorCodePaymentRequired:
 			return status.Error(codes.FailedPrecondition, domainErr.Message)
-		case domain.ErrorCodeValidationFailed, domain.ErrorCodeInvalidPackage, domain.ErrorCodeWeightTooHeavy:
+		case domain.ErrorCodeValidationFailed, domain.ErrorCodeInvalidPackage, domain.ErrorCodeWeightTooHeavy,
+			domain.ErrorCodeStorageDateInPast:
 			return status.Error(codes.InvalidArgument, domainErr.Message)
+		case domain.ErrorCodeCellUnavailable:
+			return status.Error(codes.FailedPrecondition, domainErr.Message)
+		case domain.ErrorCodeBelongsToOtherPVZ, domain.ErrorCodeInvalidPickupCode:
+			return status.Error(codes.PermissionDenied, domainErr.Message)
+		case domain.ErrorCodePickupCodeLocked:
+			return status.Error(codes.ResourceExhausted, domainErr.Message)
+		case domain.ErrorCodeIdempotencyKeyReused:
+			return status.Error(codes.FailedPrecondition, domainErr.Message)
+		case domain.ErrorCodeIdempotencyInProgress, domain.ErrorCodeConcurrentModification:
+			return status.Error(codes.Aborted, domainErr.Message)
 		default:
 			return status.Error(codes.Internal, domainErr.Message)
 		}
 	}
+	// статус уже выставлен раньше, например сохраненный ответ на повтор с ключом идемпотентности
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
 	if multierr.Errors(err) != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 	return status.Error(codes.Internal, err.Error())
 }
-
-func processErrors(err error, orderIDs []uint64) (*api.ProcessResult, error) {
-	var processed, errors []uint64
-	multiErrs := multierr.Errors(err)
-	if len(multiErrs) == 0 {
-		return &api.ProcessResult{Processed: orderIDs}, nil
-	}
-	for _, orderID := range orderIDs {
-		found := false
-		for _, e := range multiErrs {
-			if strings.Contains(e.Error(), fmt.Sprintf("Order %d", orderID)) {
-				errors = append(errors, orderID)
-				found = true
-				break
-			}
-		}
-		if !found {
-			processed = append(processed, orderID)
-		}
-	}
-	return &api.ProcessResult{Processed: processed, Errors: errors}, status.Error(codes.InvalidArgument, err.Error())
-}
-
-func processImportErrors(err error, orders []domain.OrderToImport) *api.ImportResult {
-	var errors []uint64
-	multiErrs := multierr.Errors(err)
-	for _, order := range orders {
-		for _, e := range multiErrs {
-			if strings.Contains(e.Error(), fmt.Sprintf("Order %d", order.OrderID)) {
-				errors = append(errors, order.OrderID)
-				break
-			}
-		}
-	}
-	return &api.ImportResult{Imported: int32(len(orders) - len(errors)), Errors: errors}
-}
@@ -2,28 +2,18 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
 
-	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
-	"gitlab.ozon.dev/safariproxd/homework/internal/app"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
-	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api/v2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *OrdersServer) AcceptOrder(ctx context.Context, req *api.AcceptOrderRequest) (*api.OrderResponse, error) {
-	var packageType string
-	if req.Package != nil {
-		packageType = mapPackageTypeToString(*req.Package)
-	}
-	acceptReq := domain.AcceptOrderRequest{
-		OrderID:      req.OrderId,
-		ReceiverID:   req.UserId,
-		StorageUntil: req.ExpiresAt.AsTime(),
-		Weight:       float64(req.Weight),
-		Price:        float64(req.Price),
-		PackageType:  packageType,
-	}
-	_, err := s.service.AcceptOrder(ctx, acceptReq)
+	_, err := s.service.AcceptOrder(ctx, mapProtoAcceptRequest(req))
 	if err != nil {
 		return nil, err
 	}
@@ -45,85 +35,92 @@ func (s *OrdersServer) ReturnOrder(ctx context.Context, req *api.OrderIdRequest)
 }
 
 func (s *OrdersServer) ProcessOrders(ctx context.Context, req *api.ProcessOrdersRequest) (*api.ProcessResult, error) {
-	var err error
-	if req.Action == api.ActionType_ACTION_TYPE_ISSUE {
-		err = s.service.IssueOrdersToClient(ctx, req.UserId, req.OrderIds)
-	} else {
-		err = s.service.ReturnOrdersFromClient(ctx, req.UserId, req.OrderIds)
+	var (
+		results []domain.OrderResult
+		err     error
+	)
+	switch {
+	case req.Action == api.ActionType_ACTION_TYPE_ISSUE && req.Atomic:
+		results, err = s.service.IssueOrdersToClientAtomic(ctx, req.UserId, req.OrderIds, req.GetPickupCode())
+	case req.Action == api.ActionType_ACTION_TYPE_ISSUE:
+		results, err = s.service.IssueOrdersToClient(ctx, req.UserId, req.OrderIds, req.GetPickupCode())
+	case req.Atomic:
+		results, err = s.service.ReturnOrdersFromClientAtomic(ctx, req.UserId, req.OrderIds)
+	default:
+		results, err = s.service.ReturnOrdersFromClient(ctx, req.UserId, req.OrderIds)
 	}
+	// ошибка всего вызова (неверный код выдачи, сбой записи атомарной пачки); ошибки заказов — в results
 	if err != nil {
-		return processErrors(err, req.OrderIds)
+		return nil, err
 	}
-	return &api.ProcessResult{Processed: req.OrderIds}, nil
+	return mapDomainProcessResults(results), nil
 }
 
 func (s *OrdersServer) ListOrders(ctx context.Context, req *api.ListOrdersRequest) (*api.OrdersList, error) {
-	var page, limit, lastN uint64
-	if req.Pagination != nil {
-		page = uint64(req.Pagination.Page)
-		limit = uint64(req.Pagination.CountOnPage)
-	}
+	var lastN uint64
 	if req.LastN != nil {
 		lastN = uint64(*req.LastN)
 	}
+	pageReq := mapProtoPageRequest(req.Pagination, req.PageToken, req.PageSize)
 	ordersReq := domain.ReceiverOrdersRequest{
 		ReceiverID: req.UserId,
+		Phone:      req.GetPhone(),
 		InPVZ:      req.InPvz,
 		LastN:      lastN,
-		Page:       page,
-		Limit:      limit,
+		Page:       pageReq.Page,
+		Limit:      pageReq.Limit,
+		PageToken:  pageReq.Token,
 	}
-	orders, total, err := s.service.GetReceiverOrders(ctx, ordersReq)
+	page, err := s.service.GetReceiverOrders(ctx, ordersReq)
 	if err != nil {
 		return nil, err
 	}
-	protoOrders := make([]*api.Order, len(orders))
-	for i, order := range orders {
+	protoOrders := make([]*api.Order, len(page.Orders))
+	for i, order := range page.Orders {
 		protoOrders[i] = mapDomainOrderToProto(order)
 	}
 	return &api.OrdersList{
-		Orders: protoOrders,
-		Total:  int32(total),
+		Orders:        protoOrders,
+		Total:         int32(page.Total),
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
 func (s *OrdersServer) ListReturns(ctx context.Context, req *api.ListReturnsRequest) (*api.ReturnsList, error) {
-	var page, limit uint64
-	if req.Pagination != nil {
-		page = uint64(req.Pagination.Page)
-		limit = uint64(req.Pagination.CountOnPage)
-	}
-	orders, _, err := s.service.GetReturnedOrders(ctx, page, limit)
+	page, err := s.service.GetReturnedOrders(ctx, mapProtoPageRequest(req.Pagination, req.PageToken, req.PageSize))
 	if err != nil {
 		return nil, err
 	}
-	protoOrders := make([]*api.Order, len(orders))
-	for i, order := range orders {
+	protoOrders := make([]*api.Order, len(page.Orders))
+	for i, order := range page.Orders {
 		protoOrders[i] = mapDomainOrderToProto(order)
 	}
-	return &api.ReturnsList{Returns: protoOrders}, nil
+	return &api.ReturnsList{
+		Returns:       protoOrders,
+		Total:         int32(page.Total),
+		NextPageToken: page.NextPageToken,
+	}, nil
 }
 
 func (s *OrdersServer) GetHistory(ctx context.Context, req *api.GetHistoryRequest) (*api.OrderHistoryList, error) {
-	var page, limit uint64
-	if req.Pagination != nil {
-		page = uint64(req.Pagination.Page)
-		limit = uint64(req.Pagination.CountOnPage)
-	}
-	orders, err := s.service.GetOrderHistory(ctx)
+	page, err := s.service.GetOrderHistory(ctx, mapProtoPageRequest(req.Pagination, req.PageToken, req.PageSize))
 	if err != nil {
 		return nil, err
 	}
-	paginated := app.Paginate(orders, page, limit)
-	history := make([]*api.OrderHistory, len(paginated))
-	for i, order := range paginated {
+	history := make([]*api.OrderHistory, len(page.Orders))
+	for i, order := range page.Orders {
 		history[i] = &api.OrderHistory{
 			OrderId:   order.OrderID,
+			PvzId:     order.PVZID,
 			Status:    mapDomainStatusToProto(order.Status),
 			CreatedAt: timestamppb.New(order.LastUpdateTime),
 		}
 	}
-	return &api.OrderHistoryList{History: history}, nil
+	return &api.OrderHistoryList{
+		History:       history,
+		Total:         int32(page.Total),
+		NextPageToken: page.NextPageToken,
+	}, nil
 }
 
 func (s *OrdersServer) GetOrderHistory(ctx context.Context, req *api.OrderHistoryRequest) (*api.OrderHistoryResponse, error) {
@@ -133,34 +130,441 @@ func (s *OrdersServer) GetOrderHistory(ctx context.Context, req *api.OrderHistor
 	}
 	protoHistory := make([]*api.OrderHistory, len(history))
 	for i, record := range history {
-		protoHistory[i] = &api.OrderHistory{
-			OrderId:   record.OrderID,
-			Status:    mapDomainStatusToProto(record.Status),
-			CreatedAt: timestamppb.New(record.ChangedAt),
-		}
+		protoHistory[i] = mapDomainHistoryToProto(record)
 	}
 	return &api.OrderHistoryResponse{History: protoHistory}, nil
 }
 
+// ExportOrders отдает заказы по фильтру потоком, по сообщению на заказ. ValidationInterceptor работает
+// только с унарными вызовами, поэтому запрос проверяем здесь
+func (s *OrdersServer) ExportOrders(req *api.ExportOrdersRequest, stream api.OrdersService_ExportOrdersServer) error {
+	if err := req.ValidateAll(); err != nil {
+		return fmt.Errorf("validation: %w", domain.ValidationFailedError(err.Error()))
+	}
+	f := domain.OrderExportFilter{
+		ReceiverID:  req.UserId,
+		Phone:       req.GetPhone(),
+		PackageType: req.GetPackageCode(),
+	}
+	for _, st := range req.Statuses {
+		f.Statuses = append(f.Statuses, mapProtoStatusToDomain(st)...)
+	}
+	if req.AcceptedFrom != nil {
+		f.AcceptedFrom = req.AcceptedFrom.AsTime()
+	}
+	if req.AcceptedTo != nil {
+		f.AcceptedTo = req.AcceptedTo.AsTime()
+	}
+
+	return s.service.ExportOrders(stream.Context(), f, func(order domain.Order) error {
+		return stream.Send(mapDomainOrderToProto(order))
+	})
+}
+
 func (s *OrdersServer) ImportOrders(ctx context.Context, req *api.ImportOrdersRequest) (*api.ImportResult, error) {
 	orders := make([]domain.OrderToImport, len(req.Orders))
 	for i, order := range req.Orders {
-		var packageType string
-		if order.Package != nil {
-			packageType = mapPackageTypeToString(*order.Package)
-		}
-		orders[i] = domain.OrderToImport{
-			OrderID:      order.OrderId,
-			ReceiverID:   order.UserId,
-			StorageUntil: order.ExpiresAt.AsTime().Format(cli.TimeFormat),
-			PackageType:  packageType,
-			Weight:       float64(order.Weight),
-			Price:        float64(order.Price),
+		orders[i] = mapProtoImportOrder(order)
+	}
+	if req.DryRun {
+		out := mapDomainImportResults(s.service.ValidateImportOrders(ctx, orders))
+		out.DryRun = true
+		return out, nil
+	}
+	return mapDomainImportResults(s.service.ImportOrders(ctx, orders)), nil
+}
+
+// ImportOrdersStream принимает строки импорта по одной и отвечает результатом каждой по мере обработки.
+// Режим проверки без записи берется из первого сообщения
+func (s *OrdersServer) ImportOrdersStream(stream api.OrdersService_ImportOrdersStreamServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	importRows := s.service.ImportOrdersStream
+	if first.DryRun {
+		importRows = s.service.ValidateImportOrdersStream
+	}
+
+	rows := make(chan domain.ImportRow)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(rows)
+		req := first
+		for {
+			select {
+			case rows <- mapProtoImportRow(req):
+			case <-ctx.Done():
+				return
+			}
+
+			var err error
+			req, err = stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					recvErr <- err
+					cancel()
+				}
+				return
+			}
 		}
+	}()
+
+	err = importRows(ctx, rows, func(res domain.ImportRowResult) error {
+		return stream.Send(&api.ImportRowResult{Row: res.Row, Result: mapDomainOrderResultToProto(res.Result)})
+	})
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+	}
+	return err
+}
+
+func (s *OrdersServer) StartImport(ctx context.Context, req *api.StartImportRequest) (*api.ImportJob, error) {
+	orders := make([]domain.OrderToImport, len(req.Orders))
+	for i, order := range req.Orders {
+		orders[i] = mapProtoImportOrder(order)
+	}
+	job, err := s.service.StartImport(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainImportJobToProto(job), nil
+}
+
+func (s *OrdersServer) GetImportJob(ctx context.Context, req *api.ImportJobRequest) (*api.ImportJob, error) {
+	job, err := s.service.GetImportJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainImportJobToProto(job), nil
+}
+
+func (s *OrdersServer) CancelImportJob(ctx context.Context, req *api.ImportJobRequest) (*api.ImportJob, error) {
+	job, err := s.service.CancelImportJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainImportJobToProto(job), nil
+}
+
+// mapProtoImportRow переводит сообщение потока в строку импорта. ValidationInterceptor работает только
+// с унарными вызовами, строки потока проверяем здесь; невалидная строка не обрывает поток, а получает ошибку в результате
+func mapProtoImportRow(req *api.ImportOrdersStreamRequest) domain.ImportRow {
+	row := domain.ImportRow{Row: req.Row}
+	if err := req.ValidateAll(); err != nil {
+		row.Order.OrderID = req.GetOrder().GetOrderId()
+		row.Err = fmt.Errorf("validation: %w", domain.ValidationFailedError(err.Error()))
+		return row
+	}
+	row.Order = mapProtoImportOrder(req.Order)
+	return row
+}
+
+func (s *OrdersServer) GetAllowedActions(ctx context.Context, req *api.GetAllowedActionsRequest) (*api.AllowedActionsResponse, error) {
+	order, actions, err := s.service.GetAllowedActions(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	protoActions := make([]api.OrderAction, len(actions))
+	for i, action := range actions {
+		protoActions[i] = mapDomainActionToProto(action)
+	}
+	return &api.AllowedActionsResponse{
+		OrderId: order.OrderID,
+		Status:  mapDomainStatusToProto(order.Status),
+		Actions: protoActions,
+	}, nil
+}
+
+func (s *OrdersServer) ExtendStorage(ctx context.Context, req *api.ExtendStorageRequest) (*api.ExtendStorageResponse, error) {
+	order, fee, err := s.service.ExtendStorage(ctx, req.OrderId, req.Days)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ExtendStorageResponse{
+		Order:      mapDomainOrderToProto(order),
+		FeeKopecks: int64(fee),
+	}, nil
+}
+
+func (s *OrdersServer) MoveOrder(ctx context.Context, req *api.MoveOrderRequest) (*api.Order, error) {
+	order, err := s.service.MoveOrder(ctx, req.OrderId, req.CellCode)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainOrderToProto(order), nil
+}
+
+func (s *OrdersServer) ConfirmPayment(ctx context.Context, req *api.ConfirmPaymentRequest) (*api.Order, error) {
+	order, err := s.service.ConfirmPayment(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainOrderToProto(order), nil
+}
+
+func (s *OrdersServer) AnnounceOrders(ctx context.Context, req *api.AnnounceOrdersRequest) (*api.AnnounceOrdersResponse, error) {
+	orders := make([]domain.AcceptOrderRequest, len(req.Orders))
+	for i, order := range req.Orders {
+		orders[i] = mapProtoAcceptRequest(order)
+	}
+	results, err := s.service.AnnounceOrders(ctx, req.ShipmentId, orders)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainAnnounceResults(results), nil
+}
+
+func (s *OrdersServer) ConfirmArrival(ctx context.Context, req *api.ConfirmArrivalRequest) (*api.ArrivalReport, error) {
+	report, err := s.service.ConfirmArrival(ctx, req.ShipmentId, req.OrderIds)
+	if err != nil {
+		return nil, err
+	}
+	out := &api.ArrivalReport{
+		ShipmentId:    report.ShipmentID,
+		Confirmed:     report.Confirmed,
+		Discrepancies: mapDomainDiscrepanciesToProto(report.Discrepancies),
+		Results:       make([]*api.OrderResult, len(report.Results)),
+	}
+	for i, r := range report.Results {
+		out.Results[i] = mapDomainOrderResultToProto(r)
+	}
+	return out, nil
+}
+
+func (s *OrdersServer) GetDiscrepancyReport(ctx context.Context, req *api.GetDiscrepancyReportRequest) (*api.DiscrepancyReport, error) {
+	list, err := s.service.GetDiscrepancyReport(ctx, req.GetShipmentId())
+	if err != nil {
+		return nil, err
+	}
+	return &api.DiscrepancyReport{Discrepancies: mapDomainDiscrepanciesToProto(list)}, nil
+}
+
+func (s *OrdersServer) SweepExpiredOrders(ctx context.Context, req *api.SweepExpiredOrdersRequest) (*api.ReturnManifest, error) {
+	m, err := s.service.SweepExpiredOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainManifestToProto(m), nil
+}
+
+func (s *OrdersServer) ListReturnManifests(ctx context.Context, req *api.ListReturnManifestsRequest) (*api.ReturnManifestsList, error) {
+	list, err := s.service.ListReturnManifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoManifests := make([]*api.ReturnManifest, len(list))
+	for i, m := range list {
+		protoManifests[i] = mapDomainManifestToProto(m)
+	}
+	return &api.ReturnManifestsList{Manifests: protoManifests}, nil
+}
+
+func (s *OrdersServer) GetReturnManifest(ctx context.Context, req *api.ReturnManifestRequest) (*api.ReturnManifest, error) {
+	m, err := s.service.GetReturnManifest(ctx, req.ManifestId)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainManifestToProto(m), nil
+}
+
+func (s *OrdersServer) ExportReturnManifest(ctx context.Context, req *api.ExportReturnManifestRequest) (*api.ExportReturnManifestResponse, error) {
+	data, err := s.service.ExportReturnManifest(ctx, req.ManifestId, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	contentType := "text/csv"
+	if req.Format == domain.ManifestFormatJSON {
+		contentType = "application/json"
+	}
+	return &api.ExportReturnManifestResponse{ContentType: contentType, Content: data}, nil
+}
+
+func (s *OrdersServer) HandOverReturnManifest(ctx context.Context, req *api.ReturnManifestRequest) (*api.ReturnManifest, error) {
+	m, err := s.service.HandOverReturnManifest(ctx, req.ManifestId)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainManifestToProto(m), nil
+}
+
+func (s *OrdersServer) UpsertReceiver(ctx context.Context, req *api.UpsertReceiverRequest) (*api.Receiver, error) {
+	rec, err := s.service.UpsertReceiver(ctx, domain.Receiver{
+		ID:    req.UserId,
+		Phone: req.Phone,
+		Name:  req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainReceiverToProto(rec), nil
+}
+
+func (s *OrdersServer) SearchReceivers(ctx context.Context, req *api.SearchReceiversRequest) (*api.ReceiversList, error) {
+	list, err := s.service.SearchReceivers(ctx, req.Query, uint64(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	protoReceivers := make([]*api.Receiver, len(list))
+	for i, rec := range list {
+		protoReceivers[i] = mapDomainReceiverToProto(rec)
 	}
-	imported, err := s.service.ImportOrders(ctx, orders)
+	return &api.ReceiversList{Receivers: protoReceivers}, nil
+}
+
+func (s *OrdersServer) SearchAuditLog(ctx context.Context, req *api.SearchAuditLogRequest) (*api.AuditLog, error) {
+	f := domain.AuditFilter{
+		ActorType: domain.ActorType(req.GetActorType()),
+		ActorID:   req.ActorId,
+		OrderID:   req.OrderId,
+		Limit:     uint64(req.Limit),
+	}
+	if req.From != nil {
+		f.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		f.To = req.To.AsTime()
+	}
+
+	records, err := s.service.SearchAuditLog(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	protoRecords := make([]*api.AuditRecord, len(records))
+	for i, rec := range records {
+		protoRecords[i] = mapDomainAuditRecordToProto(rec)
+	}
+	return &api.AuditLog{Records: protoRecords}, nil
+}
+
+func (s *OrdersServer) VerifyAuditLog(ctx context.Context, req *api.VerifyAuditLogRequest) (*api.VerifyAuditLogResponse, error) {
+	checked, brokenID, err := s.service.VerifyAuditLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.VerifyAuditLogResponse{
+		Valid:          brokenID == 0,
+		Checked:        checked,
+		BrokenRecordId: brokenID,
+	}, nil
+}
+
+func (s *OrdersServer) CreateStorageCell(ctx context.Context, req *api.CreateStorageCellRequest) (*api.StorageCell, error) {
+	cell, err := s.service.CreateStorageCell(ctx, req.Code, mapProtoCellSizeToDomain(req.Size), req.Capacity)
 	if err != nil {
-		return processImportErrors(err, orders), nil
+		return nil, err
+	}
+	return mapDomainStorageCellToProto(cell), nil
+}
+
+func (s *OrdersServer) ListStorageCells(ctx context.Context, req *api.ListStorageCellsRequest) (*api.StorageCellsList, error) {
+	cells, err := s.service.ListStorageCells(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoCells := make([]*api.StorageCell, len(cells))
+	for i, cell := range cells {
+		protoCells[i] = mapDomainStorageCellToProto(cell)
+	}
+	return &api.StorageCellsList{Cells: protoCells}, nil
+}
+
+func (s *OrdersServer) SetReturnPolicy(ctx context.Context, req *api.SetReturnPolicyRequest) (*api.ReturnPolicy, error) {
+	packageType := mapRequestPackage(req.Package, req.PackageCode)
+	policy, err := s.service.SetReturnPolicy(ctx, domain.ReturnPolicy{
+		Name:        req.Name,
+		PackageType: packageType,
+		SellerID:    req.SellerId,
+		Window:      time.Duration(req.WindowHours) * time.Hour,
+		Returnable:  req.Returnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainReturnPolicyToProto(policy), nil
+}
+
+func (s *OrdersServer) ListReturnPolicies(ctx context.Context, req *api.ListReturnPoliciesRequest) (*api.ReturnPoliciesList, error) {
+	policies, err := s.service.ListReturnPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoPolicies := make([]*api.ReturnPolicy, len(policies))
+	for i, policy := range policies {
+		protoPolicies[i] = mapDomainReturnPolicyToProto(policy)
+	}
+	return &api.ReturnPoliciesList{Policies: protoPolicies}, nil
+}
+
+func (s *OrdersServer) CreatePickupPoint(ctx context.Context, req *api.CreatePickupPointRequest) (*api.PickupPoint, error) {
+	point, err := s.service.CreatePickupPoint(ctx, req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainPickupPointToProto(point), nil
+}
+
+func (s *OrdersServer) ListPickupPoints(ctx context.Context, req *api.ListPickupPointsRequest) (*api.PickupPointsList, error) {
+	points, err := s.service.ListPickupPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoPoints := make([]*api.PickupPoint, len(points))
+	for i, point := range points {
+		protoPoints[i] = mapDomainPickupPointToProto(point)
+	}
+	return &api.PickupPointsList{Points: protoPoints}, nil
+}
+
+func (s *OrdersServer) CreatePackageType(ctx context.Context, req *api.CreatePackageTypeRequest) (*api.PackageTypeDefinition, error) {
+	p, err := s.service.CreatePackageType(ctx, domain.PackageType{
+		Code:      req.Code,
+		Kind:      mapProtoPackageKindToDomain(req.Kind),
+		MaxWeight: domain.Weight(req.MaxWeightGrams),
+		Price:     domain.Money(req.PriceKopecks),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainPackageTypeToProto(p), nil
+}
+
+func (s *OrdersServer) UpdatePackageType(ctx context.Context, req *api.UpdatePackageTypeRequest) (*api.PackageTypeDefinition, error) {
+	p, err := s.service.UpdatePackageType(ctx, domain.PackageType{
+		Code:      req.Code,
+		Kind:      mapProtoPackageKindToDomain(req.Kind),
+		MaxWeight: domain.Weight(req.MaxWeightGrams),
+		Price:     domain.Money(req.PriceKopecks),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainPackageTypeToProto(p), nil
+}
+
+func (s *OrdersServer) DeletePackageType(ctx context.Context, req *api.DeletePackageTypeRequest) (*api.DeletePackageTypeResponse, error) {
+	if err := s.service.DeletePackageType(ctx, req.Code); err != nil {
+		return nil, err
+	}
+	return &api.DeletePackageTypeResponse{}, nil
+}
+
+func (s *OrdersServer) ListPackageTypes(ctx context.Context, req *api.ListPackageTypesRequest) (*api.PackageTypesList, error) {
+	types, err := s.service.ListPackageTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoTypes := make([]*api.PackageTypeDefinition, len(types))
+	for i, p := range types {
+		protoTypes[i] = mapDomainPackageTypeToProto(p)
 	}
-	return &api.ImportResult{Imported: int32(imported)}, nil
+	return &api.PackageTypesList{PackageTypes: protoTypes}, nil
 }
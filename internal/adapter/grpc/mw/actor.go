@@ -0,0 +1,67 @@
+package mw
+
+import (
+	"context"
+	"strconv"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ActorTypeMetadataKey = "x-actor-type"
+	ActorIDMetadataKey   = "x-actor-id"
+)
+
+// ActorInterceptor берет сотрудника или курьера, выполняющего запрос, из метаданных.
+// Без метаданных исполнителя выбирает сама операция
+func ActorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := withActor(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func ActorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withActor(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func withActor(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	rawType, rawID := firstValue(md, ActorTypeMetadataKey), firstValue(md, ActorIDMetadataKey)
+	if rawType == "" && rawID == "" {
+		return ctx, nil
+	}
+
+	actorType, ok := domain.ParseActorType(rawType)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %q", ActorTypeMetadataKey, rawType)
+	}
+	actorID, err := strconv.ParseUint(rawID, 10, 64)
+	if err != nil || (actorID == 0 && actorType != domain.ActorTypeSystem) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %q", ActorIDMetadataKey, rawID)
+	}
+	return domain.WithActor(ctx, domain.Actor{Type: actorType, ID: actorID}), nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
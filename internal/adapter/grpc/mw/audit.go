@@ -0,0 +1,209 @@
+package mw
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"sync"
+	"time"
+
+	server "gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type AuditRecorder interface {
+	RecordAudit(ctx context.Context, rec domain.AuditRecord) error
+}
+
+// AuditedMethods — изменяющие методы, вызовы которых попадают в журнал аудита (v1 и v2)
+var AuditedMethods = []string{"AcceptOrder", "ReturnOrder", "ProcessOrders", "ImportOrders", "StartImport", "CancelImportJob"}
+
+// поля запроса, которые не пишем в журнал
+var auditRedactedFields = map[protoreflect.Name]struct{}{
+	"pickup_code": {},
+}
+
+const auditWriteTimeout = 2 * time.Second
+
+// AuditInterceptor пишет в журнал аудита, кто, откуда и с каким телом вызвал изменяющий метод
+// и чем вызов закончился. Ошибка записи журнала не отменяет уже выполненную операцию
+func AuditInterceptor(recorder AuditRecorder) grpc.UnaryServerInterceptor {
+	audited := make(map[string]struct{}, len(AuditedMethods))
+	for _, m := range AuditedMethods {
+		audited[m] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := audited[path.Base(info.FullMethod)]; !ok {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+
+		rec := domain.AuditRecord{
+			Method:     info.FullMethod,
+			PVZID:      domain.PVZIDFromContext(ctx),
+			ResultCode: codes.OK.String(),
+		}
+		rec.Actor, _ = domain.ActorFromContext(ctx)
+		rec.Sender, rec.Peer = auditOrigin(ctx)
+		if msg, ok := req.(proto.Message); ok {
+			rec.Payload, rec.OrderIDs = auditPayload(msg)
+		}
+		if err != nil {
+			// ошибки проверки запроса уже в gRPC-статусе, доменные переводим так же, как ErrorMappingInterceptor
+			st, ok := status.FromError(err)
+			if !ok {
+				st, _ = status.FromError(server.MapErrorToGRPCStatus(err))
+			}
+			rec.ResultCode, rec.Error = st.Code().String(), st.Message()
+		}
+
+		// запрос мог уже истечь по таймауту, а запись журнала должна дойти
+		auditCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditWriteTimeout)
+		defer cancel()
+		if auditErr := recorder.RecordAudit(auditCtx, rec); auditErr != nil {
+			slog.Error("Audit record failed", "method", info.FullMethod, "error", auditErr)
+		}
+		return resp, err
+	}
+}
+
+// AuditStreamInterceptor пишет в журнал аудита каждый поток: кто и откуда его открыл и чем он закончился.
+// Потоки изменяют заказы пачками или выгружают их целиком, поэтому журналируются все. В теле записи —
+// первое сообщение клиента (для выгрузки это весь запрос), в заказах — order_id из всех его сообщений
+func AuditStreamInterceptor(recorder AuditRecorder) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := &auditStream{ServerStream: ss}
+		err := handler(srv, stream)
+
+		ctx := ss.Context()
+		rec := domain.AuditRecord{
+			Method:     info.FullMethod,
+			PVZID:      domain.PVZIDFromContext(ctx),
+			ResultCode: codes.OK.String(),
+		}
+		rec.Actor, _ = domain.ActorFromContext(ctx)
+		rec.Sender, rec.Peer = auditOrigin(ctx)
+		rec.Payload, rec.OrderIDs = stream.collected()
+		if err != nil {
+			st, ok := status.FromError(err)
+			if !ok {
+				st, _ = status.FromError(server.MapErrorToGRPCStatus(err))
+			}
+			rec.ResultCode, rec.Error = st.Code().String(), st.Message()
+		}
+
+		auditCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditWriteTimeout)
+		defer cancel()
+		if auditErr := recorder.RecordAudit(auditCtx, rec); auditErr != nil {
+			slog.Error("Audit record failed", "method", info.FullMethod, "error", auditErr)
+		}
+		return err
+	}
+}
+
+// auditStream собирает для журнала принятые от клиента сообщения. Обработчик может читать поток
+// из отдельной горутины, которая переживает его самого, поэтому доступ под мьютексом
+type auditStream struct {
+	grpc.ServerStream
+
+	mu       sync.Mutex
+	payload  []byte
+	orderIDs []uint64
+}
+
+func (s *auditStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	payload, orderIDs := auditPayload(msg)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.payload == nil {
+		s.payload = payload
+	}
+	s.orderIDs = append(s.orderIDs, orderIDs...)
+	return nil
+}
+
+func (s *auditStream) collected() ([]byte, []uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.payload == nil {
+		return []byte("{}"), s.orderIDs
+	}
+	return s.payload, s.orderIDs
+}
+
+// auditOrigin возвращает отправителя из метаданных и адрес клиента;
+// за шлюзом настоящий адрес приходит в x-forwarded-for
+func auditOrigin(ctx context.Context) (string, string) {
+	var sender, addr string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		sender = firstValue(md, "sender")
+		addr = firstValue(md, "x-forwarded-for")
+	}
+	if addr == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			addr = p.Addr.String()
+		}
+	}
+	return sender, addr
+}
+
+// auditPayload сериализует запрос без секретных полей и собирает из него все order_id
+func auditPayload(msg proto.Message) ([]byte, []uint64) {
+	clone := proto.Clone(msg)
+	var orderIDs []uint64
+	walkAuditFields(clone.ProtoReflect(), &orderIDs)
+
+	payload, err := protojson.Marshal(clone)
+	if err != nil {
+		payload = []byte("{}")
+	}
+	return payload, orderIDs
+}
+
+func walkAuditFields(m protoreflect.Message, orderIDs *[]uint64) {
+	var redacted []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if _, ok := auditRedactedFields[fd.Name()]; ok {
+			redacted = append(redacted, fd)
+			return true
+		}
+		switch {
+		case fd.Kind() == protoreflect.Uint64Kind && (fd.Name() == "order_id" || fd.Name() == "order_ids"):
+			if fd.IsList() {
+				for i := 0; i < v.List().Len(); i++ {
+					*orderIDs = append(*orderIDs, v.List().Get(i).Uint())
+				}
+			} else {
+				*orderIDs = append(*orderIDs, v.Uint())
+			}
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				walkAuditFields(v.List().Get(i).Message(), orderIDs)
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			walkAuditFields(v.Message(), orderIDs)
+		}
+		return true
+	})
+	for _, fd := range redacted {
+		m.Clear(fd)
+	}
+}
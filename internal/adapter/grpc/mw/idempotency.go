@@ -0,0 +1,157 @@
+package mw
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"path"
+	"time"
+
+	server "gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	IdempotencyKeyMetadataKey = "idempotency-key"
+	// заголовок ответа, по которому клиент видит, что ответ взят из сохраненного
+	IdempotentReplayMetadataKey = "idempotent-replayed"
+
+	maxIdempotencyKeyLen = 255
+)
+
+// retryableCodes — сбои, после которых ключ освобождается: повтор запроса может пройти
+var retryableCodes = map[codes.Code]bool{
+	codes.Canceled:          true,
+	codes.Unknown:           true,
+	codes.DeadlineExceeded:  true,
+	codes.Aborted:           true,
+	codes.ResourceExhausted: true,
+	codes.Internal:          true,
+	codes.Unavailable:       true,
+	codes.DataLoss:          true,
+}
+
+// IdempotentMethods — методы, для которых учитывается ключ идемпотентности (v1 и v2)
+var IdempotentMethods = []string{"AcceptOrder", "ProcessOrders", "ImportOrders", "StartImport"}
+
+type IdempotencyStore interface {
+	BeginIdempotent(ctx context.Context, pvzID uint64, key, method, requestHash string) (*domain.IdempotencyRecord, time.Time, error)
+	CompleteIdempotent(ctx context.Context, pvzID uint64, key, method string, claimedAt time.Time,
+		responseType string, response []byte) error
+	AbortIdempotent(ctx context.Context, pvzID uint64, key, method string, claimedAt time.Time) error
+}
+
+// IdempotencyInterceptor выполняет запрос с ключом идемпотентности один раз: повтор с тем же
+// ключом и телом получает исходный ответ, повтор с другим телом отклоняется. Ключи разных пунктов независимы.
+// Отказ по существу запроса (проверка, частичная обработка) сохраняется как ответ. Ключ освобождается
+// только после сбоя, который повтор может не застать: таймаута, недоступности, внутренней ошибки
+func IdempotencyInterceptor(store IdempotencyStore) grpc.UnaryServerInterceptor {
+	methods := make(map[string]struct{}, len(IdempotentMethods))
+	for _, m := range IdempotentMethods {
+		methods[m] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := methods[path.Base(info.FullMethod)]; !ok {
+			return handler(ctx, req)
+		}
+		var key string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			key = firstValue(md, IdempotencyKeyMetadataKey)
+		}
+		msg, ok := req.(proto.Message)
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is longer than %d characters",
+				IdempotencyKeyMetadataKey, maxIdempotencyKeyLen)
+		}
+
+		requestHash, err := idempotencyRequestHash(msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "hash request: %v", err)
+		}
+		pvzID := domain.PVZIDFromContext(ctx)
+		saved, claimedAt, err := store.BeginIdempotent(ctx, pvzID, key, info.FullMethod, requestHash)
+		if err != nil {
+			return nil, err
+		}
+		if saved != nil {
+			return replayIdempotent(ctx, saved)
+		}
+
+		resp, err := handler(ctx, req)
+		// ключ освобождаем и сохраняем даже после таймаута запроса, иначе он зависнет занятым
+		storeCtx := context.WithoutCancel(ctx)
+		respMsg, ok := resp.(proto.Message)
+		if err != nil {
+			// доменные ошибки переводим так же, как ErrorMappingInterceptor
+			st, isStatus := status.FromError(err)
+			if !isStatus {
+				st, _ = status.FromError(server.MapErrorToGRPCStatus(err))
+			}
+			if retryableCodes[st.Code()] {
+				if abortErr := store.AbortIdempotent(storeCtx, pvzID, key, info.FullMethod, claimedAt); abortErr != nil {
+					slog.Error("Idempotency key release failed", "method", info.FullMethod, "error", abortErr)
+				}
+				return resp, err
+			}
+			// клиент получает только статус, даже если обработчик вернул и частичный результат
+			respMsg, ok = st.Proto(), true
+		}
+		if !ok {
+			return resp, err
+		}
+
+		data, saveErr := proto.Marshal(respMsg)
+		if saveErr == nil {
+			saveErr = store.CompleteIdempotent(storeCtx, pvzID, key, info.FullMethod, claimedAt,
+				string(proto.MessageName(respMsg)), data)
+		}
+		if saveErr != nil {
+			slog.Error("Idempotent response save failed", "method", info.FullMethod, "error", saveErr)
+		}
+		return resp, err
+	}
+}
+
+// хеш тела запроса; пункт выдачи входит в сам ключ
+func idempotencyRequestHash(msg proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func replayIdempotent(ctx context.Context, saved *domain.IdempotencyRecord) (any, error) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayMetadataKey, "true"))
+	if saved.ResponseType == string(proto.MessageName(&spb.Status{})) {
+		st := &spb.Status{}
+		if err := proto.Unmarshal(saved.Response, st); err != nil {
+			return nil, status.Errorf(codes.Internal, "decode saved status: %v", err)
+		}
+		return nil, status.ErrorProto(st)
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(saved.ResponseType))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unknown saved response type %q", saved.ResponseType)
+	}
+	resp := mt.New().Interface()
+	if err := proto.Unmarshal(saved.Response, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "decode saved response: %v", err)
+	}
+	return resp, nil
+}
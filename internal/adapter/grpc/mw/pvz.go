@@ -0,0 +1,48 @@
+package mw
+
+import (
+	"context"
+	"strconv"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const PVZIDMetadataKey = "x-pvz-id"
+
+func PVZInterceptor(defaultPVZID uint64) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := withPVZID(ctx, defaultPVZID)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func PVZStreamInterceptor(defaultPVZID uint64) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withPVZID(ss.Context(), defaultPVZID)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func withPVZID(ctx context.Context, defaultPVZID uint64) (context.Context, error) {
+	pvzID := defaultPVZID
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(PVZIDMetadataKey); len(v) > 0 && v[0] != "" {
+			parsed, err := strconv.ParseUint(v[0], 10, 64)
+			if err != nil || parsed == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %q", PVZIDMetadataKey, v[0])
+			}
+			pvzID = parsed
+		}
+	}
+	return domain.WithPVZID(ctx, pvzID), nil
+}
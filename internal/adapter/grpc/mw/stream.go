@@ -0,0 +1,72 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	server "gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// contextStream подменяет контекст потока, чтобы перехватчики могли дописать в него значения
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func PanicStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("gRPC stream handler panic recovered",
+					"panic", fmt.Sprintf("%v", r),
+					"method", info.FullMethod,
+					"stack_trace", string(debug.Stack()),
+				)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func LoggingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		slog.Info("Stream", "method", info.FullMethod, "metadata", md)
+		err := handler(srv, ss)
+		if err != nil {
+			slog.Error("Stream closed", "method", info.FullMethod, "error", err)
+		} else {
+			slog.Info("Stream closed", "method", info.FullMethod)
+		}
+		return err
+	}
+}
+
+func ErrorMappingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err == nil {
+			return nil
+		}
+		// ошибки самого потока (обрыв, отмена клиентом) уже несут свой статус
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return status.FromContextError(err).Err()
+		}
+		return server.MapErrorToGRPCStatus(err)
+	}
+}
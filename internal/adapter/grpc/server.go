@@ -4,20 +4,55 @@ import (
 	"context"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
-	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api/v2"
 	"google.golang.org/grpc"
 )
 
 type IOrderService interface {
-	AcceptOrder(ctx context.Context, req domain.AcceptOrderRequest) (float64, error)
+	AcceptOrder(ctx context.Context, req domain.AcceptOrderRequest) (domain.Money, error)
 	ReturnOrderToDelivery(ctx context.Context, orderID uint64) error
-	IssueOrdersToClient(ctx context.Context, receiverID uint64, orderIDs []uint64) error
-	ReturnOrdersFromClient(ctx context.Context, receiverID uint64, orderIDs []uint64) error
-	GetReceiverOrders(ctx context.Context, req domain.ReceiverOrdersRequest) ([]domain.Order, uint64, error)
-	GetReturnedOrders(ctx context.Context, page, limit uint64) ([]domain.Order, uint64, error)
-	GetOrderHistory(ctx context.Context) ([]domain.Order, error)
+	IssueOrdersToClient(ctx context.Context, receiverID uint64, orderIDs []uint64, pickupCode string) ([]domain.OrderResult, error)
+	ReturnOrdersFromClient(ctx context.Context, receiverID uint64, orderIDs []uint64) ([]domain.OrderResult, error)
+	IssueOrdersToClientAtomic(ctx context.Context, receiverID uint64, orderIDs []uint64, pickupCode string) ([]domain.OrderResult, error)
+	ReturnOrdersFromClientAtomic(ctx context.Context, receiverID uint64, orderIDs []uint64) ([]domain.OrderResult, error)
+	GetReceiverOrders(ctx context.Context, req domain.ReceiverOrdersRequest) (domain.OrderPage, error)
+	GetReturnedOrders(ctx context.Context, req domain.PageRequest) (domain.OrderPage, error)
+	GetOrderHistory(ctx context.Context, req domain.PageRequest) (domain.OrderPage, error)
 	GetOrderHistoryByID(ctx context.Context, orderID uint64) ([]domain.OrderHistory, error)
-	ImportOrders(ctx context.Context, orders []domain.OrderToImport) (uint64, error)
+	ExportOrders(ctx context.Context, f domain.OrderExportFilter, emit func(domain.Order) error) error
+	ImportOrders(ctx context.Context, orders []domain.OrderToImport) []domain.OrderResult
+	ImportOrdersStream(ctx context.Context, rows <-chan domain.ImportRow, emit func(domain.ImportRowResult) error) error
+	ValidateImportOrders(ctx context.Context, orders []domain.OrderToImport) []domain.OrderResult
+	ValidateImportOrdersStream(ctx context.Context, rows <-chan domain.ImportRow, emit func(domain.ImportRowResult) error) error
+	StartImport(ctx context.Context, orders []domain.OrderToImport) (domain.ImportJob, error)
+	GetImportJob(ctx context.Context, id uint64) (domain.ImportJob, error)
+	CancelImportJob(ctx context.Context, id uint64) (domain.ImportJob, error)
+	GetAllowedActions(ctx context.Context, orderID uint64) (domain.Order, []domain.OrderAction, error)
+	ExtendStorage(ctx context.Context, orderID uint64, days uint32) (domain.Order, domain.Money, error)
+	MoveOrder(ctx context.Context, orderID uint64, cellCode string) (domain.Order, error)
+	ConfirmPayment(ctx context.Context, orderID uint64) (domain.Order, error)
+	AnnounceOrders(ctx context.Context, shipmentID string, reqs []domain.AcceptOrderRequest) ([]domain.OrderResult, error)
+	ConfirmArrival(ctx context.Context, shipmentID string, arrivedIDs []uint64) (domain.ArrivalReport, error)
+	GetDiscrepancyReport(ctx context.Context, shipmentID string) ([]domain.ArrivalDiscrepancy, error)
+	SweepExpiredOrders(ctx context.Context) (domain.ReturnManifest, error)
+	ListReturnManifests(ctx context.Context) ([]domain.ReturnManifest, error)
+	GetReturnManifest(ctx context.Context, id uint64) (domain.ReturnManifest, error)
+	ExportReturnManifest(ctx context.Context, id uint64, format string) ([]byte, error)
+	HandOverReturnManifest(ctx context.Context, id uint64) (domain.ReturnManifest, error)
+	UpsertReceiver(ctx context.Context, rec domain.Receiver) (domain.Receiver, error)
+	SearchReceivers(ctx context.Context, query string, limit uint64) ([]domain.Receiver, error)
+	SearchAuditLog(ctx context.Context, f domain.AuditFilter) ([]domain.AuditRecord, error)
+	VerifyAuditLog(ctx context.Context) (uint64, uint64, error)
+	CreateStorageCell(ctx context.Context, code string, size domain.CellSize, capacity uint32) (domain.StorageCell, error)
+	ListStorageCells(ctx context.Context) ([]domain.StorageCell, error)
+	SetReturnPolicy(ctx context.Context, policy domain.ReturnPolicy) (domain.ReturnPolicy, error)
+	ListReturnPolicies(ctx context.Context) ([]domain.ReturnPolicy, error)
+	CreatePickupPoint(ctx context.Context, name, address string) (domain.PickupPoint, error)
+	ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error)
+	CreatePackageType(ctx context.Context, p domain.PackageType) (domain.PackageType, error)
+	UpdatePackageType(ctx context.Context, p domain.PackageType) (domain.PackageType, error)
+	DeletePackageType(ctx context.Context, code string) error
+	ListPackageTypes(ctx context.Context) ([]domain.PackageType, error)
 }
 
 type OrdersServer struct {
@@ -1,8 +1,11 @@
 package server
 
 import (
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
-	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api/v2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,6 +26,9 @@ func mapPackageTypeToString(pt api.PackageType) string {
 	}
 }
 
+// mapDomainStatusToProto переводит статус домена в клиентский статус контракта. Названия в контракте —
+// со стороны получателя: EXPECTS — заказ на хранении и ждет получателя (StatusInStorage),
+// ACCEPTED — получатель забрал заказ, ANNOUNCED — заказ анонсирован, но в пункт еще не прибыл (StatusExpected)
 func mapDomainStatusToProto(status domain.OrderStatus) api.OrderStatus {
 	switch status {
 	case domain.StatusInStorage:
@@ -33,21 +39,171 @@ func mapDomainStatusToProto(status domain.OrderStatus) api.OrderStatus {
 		return api.OrderStatus_ORDER_STATUS_RETURNED
 	case domain.StatusReturnedWithoutClient, domain.StatusGivenToCourier:
 		return api.OrderStatus_ORDER_STATUS_DELETED
+	case domain.StatusExpected:
+		return api.OrderStatus_ORDER_STATUS_ANNOUNCED
 	default:
 		return api.OrderStatus_ORDER_STATUS_UNSPECIFIED
 	}
 }
 
+// mapProtoStatusToDomain обратна mapDomainStatusToProto; ORDER_STATUS_DELETED объединяет два статуса домена
+func mapProtoStatusToDomain(status api.OrderStatus) []domain.OrderStatus {
+	switch status {
+	case api.OrderStatus_ORDER_STATUS_EXPECTS:
+		return []domain.OrderStatus{domain.StatusInStorage}
+	case api.OrderStatus_ORDER_STATUS_ACCEPTED:
+		return []domain.OrderStatus{domain.StatusGivenToClient}
+	case api.OrderStatus_ORDER_STATUS_RETURNED:
+		return []domain.OrderStatus{domain.StatusReturnedFromClient}
+	case api.OrderStatus_ORDER_STATUS_DELETED:
+		return []domain.OrderStatus{domain.StatusReturnedWithoutClient, domain.StatusGivenToCourier}
+	case api.OrderStatus_ORDER_STATUS_ANNOUNCED:
+		return []domain.OrderStatus{domain.StatusExpected}
+	default:
+		return nil
+	}
+}
+
+// mapProtoPageRequest собирает страницу из пагинации по номеру и токена; page_size приоритетнее count_on_page
+func mapProtoPageRequest(p *api.Pagination, token string, size uint32) domain.PageRequest {
+	req := domain.PageRequest{Token: token, Limit: uint64(size)}
+	if p != nil {
+		req.Page = uint64(p.Page)
+		if req.Limit == 0 {
+			req.Limit = uint64(p.CountOnPage)
+		}
+	}
+	return req
+}
+
+func mapDomainActionToProto(action domain.OrderAction) api.OrderAction {
+	switch action {
+	case domain.ActionIssue:
+		return api.OrderAction_ORDER_ACTION_ISSUE
+	case domain.ActionReturnFromClient:
+		return api.OrderAction_ORDER_ACTION_RETURN_FROM_CLIENT
+	case domain.ActionReturnToCourier:
+		return api.OrderAction_ORDER_ACTION_RETURN_TO_COURIER
+	case domain.ActionExtendStorage:
+		return api.OrderAction_ORDER_ACTION_EXTEND_STORAGE
+	case domain.ActionConfirmArrival:
+		return api.OrderAction_ORDER_ACTION_CONFIRM_ARRIVAL
+	default:
+		return api.OrderAction_ORDER_ACTION_UNSPECIFIED
+	}
+}
+
 func mapDomainOrderToProto(order domain.Order) *api.Order {
 	pkgType := mapStringToPackageType(order.PackageType)
 	return &api.Order{
-		OrderId:    order.OrderID,
-		UserId:     order.ReceiverID,
-		Status:     mapDomainStatusToProto(order.Status),
-		ExpiresAt:  timestamppb.New(order.StorageUntil),
-		Weight:     float32(order.Weight),
-		TotalPrice: float32(order.Price),
-		Package:    &pkgType,
+		OrderId:           order.OrderID,
+		UserId:            order.ReceiverID,
+		PvzId:             order.PVZID,
+		Status:            mapDomainStatusToProto(order.Status),
+		ExpiresAt:         timestamppb.New(order.StorageUntil),
+		WeightGrams:       int64(order.Weight),
+		TotalPriceKopecks: int64(order.Price),
+		Package:           &pkgType,
+		CellCode:          order.CellCode,
+		SellerId:          order.SellerID,
+		PackageCode:       order.PackageType,
+		CashOnDelivery:    order.CashOnDelivery,
+		PaymentStatus:     mapDomainPaymentStatusToProto(order.PaymentStatus),
+		ShipmentId:        order.ShipmentID,
+	}
+}
+
+func mapProtoAcceptRequest(req *api.AcceptOrderRequest) domain.AcceptOrderRequest {
+	return domain.AcceptOrderRequest{
+		OrderID:        req.OrderId,
+		ReceiverID:     req.UserId,
+		StorageUntil:   req.ExpiresAt.AsTime(),
+		Weight:         domain.Weight(req.WeightGrams),
+		Price:          domain.Money(req.PriceKopecks),
+		PackageType:    mapRequestPackage(req.Package, req.PackageCode),
+		SellerID:       req.GetSellerId(),
+		CashOnDelivery: req.CashOnDelivery,
+	}
+}
+
+func mapDomainDiscrepanciesToProto(list []domain.ArrivalDiscrepancy) []*api.Discrepancy {
+	out := make([]*api.Discrepancy, len(list))
+	for i, d := range list {
+		kind := api.DiscrepancyKind_DISCREPANCY_KIND_MISSING
+		if d.Kind == domain.DiscrepancyUnexpected {
+			kind = api.DiscrepancyKind_DISCREPANCY_KIND_UNEXPECTED
+		}
+		out[i] = &api.Discrepancy{
+			ShipmentId: d.ShipmentID,
+			OrderId:    d.OrderID,
+			Kind:       kind,
+			DetectedAt: timestamppb.New(d.DetectedAt),
+			PvzId:      d.PVZID,
+		}
+	}
+	return out
+}
+
+func mapDomainPaymentStatusToProto(st domain.PaymentStatus) api.PaymentStatus {
+	switch st {
+	case domain.PaymentStatusUnpaid:
+		return api.PaymentStatus_PAYMENT_STATUS_UNPAID
+	case domain.PaymentStatusPaid:
+		return api.PaymentStatus_PAYMENT_STATUS_PAID
+	case domain.PaymentStatusRefunded:
+		return api.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	default:
+		return api.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	}
+}
+
+func mapDomainReturnPolicyToProto(p domain.ReturnPolicy) *api.ReturnPolicy {
+	policy := &api.ReturnPolicy{
+		Id:          p.ID,
+		Name:        p.Name,
+		SellerId:    p.SellerID,
+		WindowHours: uint32(p.Window / time.Hour),
+		Returnable:  p.Returnable,
+		PackageCode: p.PackageType,
+	}
+	if p.PackageType != "" {
+		pkgType := mapStringToPackageType(p.PackageType)
+		policy.Package = &pkgType
+	}
+	return policy
+}
+
+func mapProtoCellSizeToDomain(size api.CellSize) domain.CellSize {
+	switch size {
+	case api.CellSize_CELL_SIZE_MEDIUM:
+		return domain.CellSizeMedium
+	case api.CellSize_CELL_SIZE_LARGE:
+		return domain.CellSizeLarge
+	default:
+		return domain.CellSizeSmall
+	}
+}
+
+func mapDomainCellSizeToProto(size domain.CellSize) api.CellSize {
+	switch size {
+	case domain.CellSizeSmall:
+		return api.CellSize_CELL_SIZE_SMALL
+	case domain.CellSizeMedium:
+		return api.CellSize_CELL_SIZE_MEDIUM
+	case domain.CellSizeLarge:
+		return api.CellSize_CELL_SIZE_LARGE
+	default:
+		return api.CellSize_CELL_SIZE_UNSPECIFIED
+	}
+}
+
+func mapDomainStorageCellToProto(c domain.StorageCell) *api.StorageCell {
+	return &api.StorageCell{
+		Id:       c.ID,
+		Code:     c.Code,
+		Size:     mapDomainCellSizeToProto(c.Size),
+		Capacity: c.Capacity,
+		Occupied: c.Occupied,
 	}
 }
 
@@ -67,3 +223,237 @@ func mapStringToPackageType(pt string) api.PackageType {
 		return api.PackageType_PACKAGE_TYPE_UNSPECIFIED
 	}
 }
+
+func mapDomainPickupPointToProto(p domain.PickupPoint) *api.PickupPoint {
+	return &api.PickupPoint{
+		Id:        p.ID,
+		Name:      p.Name,
+		Address:   p.Address,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+}
+
+// код из справочника приоритетнее enum: enum знает только исходные упаковки
+func mapProtoImportOrder(order *api.AcceptOrderRequest) domain.OrderToImport {
+	return domain.OrderToImport{
+		OrderID:        order.OrderId,
+		ReceiverID:     order.UserId,
+		StorageUntil:   order.ExpiresAt.AsTime().Format(cli.TimeFormat),
+		PackageType:    mapRequestPackage(order.Package, order.PackageCode),
+		Weight:         domain.Weight(order.WeightGrams),
+		Price:          domain.Money(order.PriceKopecks),
+		SellerID:       order.GetSellerId(),
+		CashOnDelivery: order.CashOnDelivery,
+	}
+}
+
+func mapRequestPackage(pkg *api.PackageType, code *string) string {
+	if code != nil && *code != "" {
+		return *code
+	}
+	if pkg != nil {
+		return mapPackageTypeToString(*pkg)
+	}
+	return ""
+}
+
+func mapProtoPackageKindToDomain(kind api.PackageKind) domain.PackageKind {
+	if kind == api.PackageKind_PACKAGE_KIND_WRAPPING {
+		return domain.PackageKindWrapping
+	}
+	return domain.PackageKindContainer
+}
+
+func mapDomainPackageKindToProto(kind domain.PackageKind) api.PackageKind {
+	switch kind {
+	case domain.PackageKindContainer:
+		return api.PackageKind_PACKAGE_KIND_CONTAINER
+	case domain.PackageKindWrapping:
+		return api.PackageKind_PACKAGE_KIND_WRAPPING
+	default:
+		return api.PackageKind_PACKAGE_KIND_UNSPECIFIED
+	}
+}
+
+func mapDomainPackageTypeToProto(p domain.PackageType) *api.PackageTypeDefinition {
+	return &api.PackageTypeDefinition{
+		Code:           p.Code,
+		Kind:           mapDomainPackageKindToProto(p.Kind),
+		MaxWeightGrams: int64(p.MaxWeight),
+		PriceKopecks:   int64(p.Price),
+	}
+}
+
+func mapDomainOrderResultToProto(r domain.OrderResult) *api.OrderResult {
+	res := &api.OrderResult{
+		OrderId:   r.OrderID,
+		ErrorCode: int64(r.ErrorCode()),
+	}
+	if r.Err != nil {
+		res.Message = r.Err.Error()
+	}
+	if r.Status != nil {
+		status := mapDomainStatusToProto(*r.Status)
+		res.Status = &status
+	}
+	return res
+}
+
+func mapDomainProcessResults(results []domain.OrderResult) *api.ProcessResult {
+	out := &api.ProcessResult{
+		Processed: domain.SucceededOrders(results),
+		Errors:    domain.FailedOrders(results),
+		Results:   make([]*api.OrderResult, len(results)),
+	}
+	for i, r := range results {
+		out.Results[i] = mapDomainOrderResultToProto(r)
+		if f := r.StorageFee; r.OK() && f.Amount > 0 {
+			out.StorageFees = append(out.StorageFees, &api.StorageFee{
+				OrderId:          f.OrderID,
+				PaidDays:         f.PaidDays,
+				DailyRateKopecks: int64(f.DailyRate),
+				AmountKopecks:    int64(f.Amount),
+			})
+			out.TotalStorageFeeKopecks += int64(f.Amount)
+		}
+	}
+	return out
+}
+
+func mapDomainImportResults(results []domain.OrderResult) *api.ImportResult {
+	out := &api.ImportResult{
+		Imported: int32(len(domain.SucceededOrders(results))),
+		Errors:   domain.FailedOrders(results),
+		Results:  make([]*api.OrderResult, len(results)),
+	}
+	for i, r := range results {
+		out.Results[i] = mapDomainOrderResultToProto(r)
+	}
+	return out
+}
+
+func mapDomainAnnounceResults(results []domain.OrderResult) *api.AnnounceOrdersResponse {
+	out := &api.AnnounceOrdersResponse{
+		Announced: int32(len(domain.SucceededOrders(results))),
+		Errors:    domain.FailedOrders(results),
+		Results:   make([]*api.OrderResult, len(results)),
+	}
+	for i, r := range results {
+		out.Results[i] = mapDomainOrderResultToProto(r)
+	}
+	return out
+}
+
+var importJobStatusToProto = map[domain.ImportJobStatus]api.ImportJobStatus{
+	domain.ImportJobPending:   api.ImportJobStatus_IMPORT_JOB_STATUS_PENDING,
+	domain.ImportJobRunning:   api.ImportJobStatus_IMPORT_JOB_STATUS_RUNNING,
+	domain.ImportJobCompleted: api.ImportJobStatus_IMPORT_JOB_STATUS_COMPLETED,
+	domain.ImportJobCancelled: api.ImportJobStatus_IMPORT_JOB_STATUS_CANCELLED,
+	domain.ImportJobFailed:    api.ImportJobStatus_IMPORT_JOB_STATUS_FAILED,
+}
+
+func mapDomainImportJobToProto(job domain.ImportJob) *api.ImportJob {
+	failures := make([]*api.ImportJobFailure, len(job.Failures))
+	for i, f := range job.Failures {
+		failures[i] = &api.ImportJobFailure{
+			Row:       f.Row,
+			OrderId:   f.OrderID,
+			ErrorCode: int64(f.ErrorCode),
+			Message:   f.Message,
+		}
+	}
+	return &api.ImportJob{
+		JobId:     job.ID,
+		Status:    importJobStatusToProto[job.Status],
+		Total:     job.Total,
+		Processed: job.LastRow,
+		Imported:  job.Imported,
+		Failed:    job.Failed,
+		Failures:  failures,
+		Error:     job.Error,
+		CreatedAt: timestamppb.New(job.CreatedAt),
+		UpdatedAt: timestamppb.New(job.UpdatedAt),
+	}
+}
+
+func mapDomainManifestToProto(m domain.ReturnManifest) *api.ReturnManifest {
+	status := api.ManifestStatus_MANIFEST_STATUS_OPEN
+	if m.Status == domain.ManifestHandedOver {
+		status = api.ManifestStatus_MANIFEST_STATUS_HANDED_OVER
+	}
+	items := make([]*api.ReturnManifestItem, len(m.Items))
+	for i, it := range m.Items {
+		items[i] = &api.ReturnManifestItem{
+			OrderId:     it.OrderID,
+			UserId:      it.ReceiverID,
+			CellCode:    it.CellCode,
+			WeightGrams: int64(it.Weight),
+			ExpiresAt:   timestamppb.New(it.StorageUntil),
+			Reason:      it.Reason(),
+		}
+	}
+	out := &api.ReturnManifest{
+		ManifestId: m.ID,
+		PvzId:      m.PVZID,
+		Status:     status,
+		Items:      items,
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+	}
+	if !m.HandedOverAt.IsZero() {
+		out.HandedOverAt = timestamppb.New(m.HandedOverAt)
+	}
+	return out
+}
+
+func mapDomainReceiverToProto(rec domain.Receiver) *api.Receiver {
+	return &api.Receiver{
+		UserId:    rec.ID,
+		Phone:     rec.Phone,
+		Name:      rec.Name,
+		CreatedAt: timestamppb.New(rec.CreatedAt),
+		UpdatedAt: timestamppb.New(rec.UpdatedAt),
+	}
+}
+
+func mapDomainHistoryToProto(h domain.OrderHistory) *api.OrderHistory {
+	out := &api.OrderHistory{
+		OrderId:   h.OrderID,
+		PvzId:     h.PVZID,
+		Status:    mapDomainStatusToProto(h.Status),
+		CreatedAt: timestamppb.New(h.ChangedAt),
+		Actor:     mapDomainActorToProto(h.Actor),
+		Reason:    string(h.Reason),
+		Comment:   h.Comment,
+	}
+	if h.PrevStatus != nil {
+		prev := mapDomainStatusToProto(*h.PrevStatus)
+		out.PrevStatus = &prev
+	}
+	return out
+}
+
+func mapDomainAuditRecordToProto(rec domain.AuditRecord) *api.AuditRecord {
+	return &api.AuditRecord{
+		Id:         rec.ID,
+		Method:     rec.Method,
+		PvzId:      rec.PVZID,
+		Sender:     rec.Sender,
+		Peer:       rec.Peer,
+		Payload:    string(rec.Payload),
+		OrderIds:   rec.OrderIDs,
+		ResultCode: rec.ResultCode,
+		Error:      rec.Error,
+		CreatedAt:  timestamppb.New(rec.CreatedAt),
+		PrevHash:   rec.PrevHash,
+		Hash:       rec.Hash,
+		Actor:      mapDomainActorToProto(rec.Actor),
+	}
+}
+
+// неизвестного исполнителя (старые записи) не передаем
+func mapDomainActorToProto(actor domain.Actor) *api.Actor {
+	if actor.Type == "" {
+		return nil
+	}
+	return &api.Actor{Type: string(actor.Type), Id: actor.ID}
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// v1 по-прежнему отвечает ошибкой InvalidArgument, если хотя бы один заказ не обработан
+func processResults(results []domain.OrderResult) (*api.ProcessResult, error) {
+	var errs error
+	for _, r := range results {
+		if !r.OK() {
+			errs = multierr.Append(errs, r.Err)
+		}
+	}
+	result := &api.ProcessResult{
+		Processed: domain.SucceededOrders(results),
+		Errors:    domain.FailedOrders(results),
+	}
+	if errs != nil {
+		return result, status.Error(codes.InvalidArgument, errs.Error())
+	}
+	return result, nil
+}
+
+func processImportResults(results []domain.OrderResult) *api.ImportResult {
+	return &api.ImportResult{
+		Imported: int32(len(domain.SucceededOrders(results))),
+		Errors:   domain.FailedOrders(results),
+	}
+}
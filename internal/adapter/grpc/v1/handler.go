@@ -0,0 +1,274 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrdersServer) AcceptOrder(ctx context.Context, req *api.AcceptOrderRequest) (*api.OrderResponse, error) {
+	var packageType string
+	if req.Package != nil {
+		packageType = mapPackageTypeToString(*req.Package)
+	}
+	acceptReq := domain.AcceptOrderRequest{
+		OrderID:      req.OrderId,
+		ReceiverID:   req.UserId,
+		StorageUntil: req.ExpiresAt.AsTime(),
+		Weight:       domain.WeightFromFloat(float64(req.Weight)),
+		Price:        domain.MoneyFromFloat(float64(req.Price)),
+		PackageType:  packageType,
+		SellerID:     req.GetSellerId(),
+	}
+	_, err := s.service.AcceptOrder(ctx, acceptReq)
+	if err != nil {
+		return nil, err
+	}
+	return &api.OrderResponse{
+		Status:  api.OrderStatus_ORDER_STATUS_EXPECTS,
+		OrderId: req.OrderId,
+	}, nil
+}
+
+func (s *OrdersServer) ReturnOrder(ctx context.Context, req *api.OrderIdRequest) (*api.OrderResponse, error) {
+	err := s.service.ReturnOrderToDelivery(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.OrderResponse{
+		Status:  api.OrderStatus_ORDER_STATUS_DELETED,
+		OrderId: req.OrderId,
+	}, nil
+}
+
+func (s *OrdersServer) ProcessOrders(ctx context.Context, req *api.ProcessOrdersRequest) (*api.ProcessResult, error) {
+	var (
+		results []domain.OrderResult
+		err     error
+	)
+	if req.Action == api.ActionType_ACTION_TYPE_ISSUE {
+		// v1 не знает о плате за хранение, сумма видна только в v2
+		results, err = s.service.IssueOrdersToClient(ctx, req.UserId, req.OrderIds, req.GetPickupCode())
+	} else {
+		results, err = s.service.ReturnOrdersFromClient(ctx, req.UserId, req.OrderIds)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return processResults(results)
+}
+
+func (s *OrdersServer) ListOrders(ctx context.Context, req *api.ListOrdersRequest) (*api.OrdersList, error) {
+	var page, limit, lastN uint64
+	if req.Pagination != nil {
+		page = uint64(req.Pagination.Page)
+		limit = uint64(req.Pagination.CountOnPage)
+	}
+	if req.LastN != nil {
+		lastN = uint64(*req.LastN)
+	}
+	ordersReq := domain.ReceiverOrdersRequest{
+		ReceiverID: req.UserId,
+		InPVZ:      req.InPvz,
+		LastN:      lastN,
+		Page:       page,
+		Limit:      limit,
+	}
+	ordersPage, err := s.service.GetReceiverOrders(ctx, ordersReq)
+	if err != nil {
+		return nil, err
+	}
+	protoOrders := make([]*api.Order, len(ordersPage.Orders))
+	for i, order := range ordersPage.Orders {
+		protoOrders[i] = mapDomainOrderToProto(order)
+	}
+	return &api.OrdersList{
+		Orders: protoOrders,
+		Total:  int32(ordersPage.Total),
+	}, nil
+}
+
+func (s *OrdersServer) ListReturns(ctx context.Context, req *api.ListReturnsRequest) (*api.ReturnsList, error) {
+	var page, limit uint64
+	if req.Pagination != nil {
+		page = uint64(req.Pagination.Page)
+		limit = uint64(req.Pagination.CountOnPage)
+	}
+	returns, err := s.service.GetReturnedOrders(ctx, domain.PageRequest{Page: page, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	protoOrders := make([]*api.Order, len(returns.Orders))
+	for i, order := range returns.Orders {
+		protoOrders[i] = mapDomainOrderToProto(order)
+	}
+	return &api.ReturnsList{Returns: protoOrders}, nil
+}
+
+func (s *OrdersServer) GetHistory(ctx context.Context, req *api.GetHistoryRequest) (*api.OrderHistoryList, error) {
+	var page, limit uint64
+	if req.Pagination != nil {
+		page = uint64(req.Pagination.Page)
+		limit = uint64(req.Pagination.CountOnPage)
+	}
+	orders, err := s.service.GetOrderHistory(ctx, domain.PageRequest{Page: page, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	history := make([]*api.OrderHistory, len(orders.Orders))
+	for i, order := range orders.Orders {
+		history[i] = &api.OrderHistory{
+			OrderId:   order.OrderID,
+			PvzId:     order.PVZID,
+			Status:    mapDomainStatusToProto(order.Status),
+			CreatedAt: timestamppb.New(order.LastUpdateTime),
+		}
+	}
+	return &api.OrderHistoryList{History: history}, nil
+}
+
+func (s *OrdersServer) GetOrderHistory(ctx context.Context, req *api.OrderHistoryRequest) (*api.OrderHistoryResponse, error) {
+	history, err := s.service.GetOrderHistoryByID(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	protoHistory := make([]*api.OrderHistory, len(history))
+	for i, record := range history {
+		protoHistory[i] = &api.OrderHistory{
+			OrderId:   record.OrderID,
+			PvzId:     record.PVZID,
+			Status:    mapDomainStatusToProto(record.Status),
+			CreatedAt: timestamppb.New(record.ChangedAt),
+		}
+	}
+	return &api.OrderHistoryResponse{History: protoHistory}, nil
+}
+
+func (s *OrdersServer) ImportOrders(ctx context.Context, req *api.ImportOrdersRequest) (*api.ImportResult, error) {
+	orders := make([]domain.OrderToImport, len(req.Orders))
+	for i, order := range req.Orders {
+		var packageType string
+		if order.Package != nil {
+			packageType = mapPackageTypeToString(*order.Package)
+		}
+		orders[i] = domain.OrderToImport{
+			OrderID:      order.OrderId,
+			ReceiverID:   order.UserId,
+			StorageUntil: order.ExpiresAt.AsTime().Format(cli.TimeFormat),
+			PackageType:  packageType,
+			Weight:       domain.WeightFromFloat(float64(order.Weight)),
+			Price:        domain.MoneyFromFloat(float64(order.Price)),
+			SellerID:     order.GetSellerId(),
+		}
+	}
+	return processImportResults(s.service.ImportOrders(ctx, orders)), nil
+}
+
+func (s *OrdersServer) GetAllowedActions(ctx context.Context, req *api.GetAllowedActionsRequest) (*api.AllowedActionsResponse, error) {
+	order, actions, err := s.service.GetAllowedActions(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	protoActions := make([]api.OrderAction, len(actions))
+	for i, action := range actions {
+		protoActions[i] = mapDomainActionToProto(action)
+	}
+	return &api.AllowedActionsResponse{
+		OrderId: order.OrderID,
+		Status:  mapDomainStatusToProto(order.Status),
+		Actions: protoActions,
+	}, nil
+}
+
+func (s *OrdersServer) ExtendStorage(ctx context.Context, req *api.ExtendStorageRequest) (*api.ExtendStorageResponse, error) {
+	order, fee, err := s.service.ExtendStorage(ctx, req.OrderId, req.Days)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ExtendStorageResponse{
+		Order: mapDomainOrderToProto(order),
+		Fee:   float32(fee.Float()),
+	}, nil
+}
+
+func (s *OrdersServer) MoveOrder(ctx context.Context, req *api.MoveOrderRequest) (*api.Order, error) {
+	order, err := s.service.MoveOrder(ctx, req.OrderId, req.CellCode)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainOrderToProto(order), nil
+}
+
+func (s *OrdersServer) CreateStorageCell(ctx context.Context, req *api.CreateStorageCellRequest) (*api.StorageCell, error) {
+	cell, err := s.service.CreateStorageCell(ctx, req.Code, mapProtoCellSizeToDomain(req.Size), req.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainStorageCellToProto(cell), nil
+}
+
+func (s *OrdersServer) ListStorageCells(ctx context.Context, req *api.ListStorageCellsRequest) (*api.StorageCellsList, error) {
+	cells, err := s.service.ListStorageCells(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoCells := make([]*api.StorageCell, len(cells))
+	for i, cell := range cells {
+		protoCells[i] = mapDomainStorageCellToProto(cell)
+	}
+	return &api.StorageCellsList{Cells: protoCells}, nil
+}
+
+func (s *OrdersServer) SetReturnPolicy(ctx context.Context, req *api.SetReturnPolicyRequest) (*api.ReturnPolicy, error) {
+	var packageType string
+	if req.Package != nil {
+		packageType = mapPackageTypeToString(*req.Package)
+	}
+	policy, err := s.service.SetReturnPolicy(ctx, domain.ReturnPolicy{
+		Name:        req.Name,
+		PackageType: packageType,
+		SellerID:    req.SellerId,
+		Window:      time.Duration(req.WindowHours) * time.Hour,
+		Returnable:  req.Returnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainReturnPolicyToProto(policy), nil
+}
+
+func (s *OrdersServer) ListReturnPolicies(ctx context.Context, req *api.ListReturnPoliciesRequest) (*api.ReturnPoliciesList, error) {
+	policies, err := s.service.ListReturnPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoPolicies := make([]*api.ReturnPolicy, len(policies))
+	for i, policy := range policies {
+		protoPolicies[i] = mapDomainReturnPolicyToProto(policy)
+	}
+	return &api.ReturnPoliciesList{Policies: protoPolicies}, nil
+}
+
+func (s *OrdersServer) CreatePickupPoint(ctx context.Context, req *api.CreatePickupPointRequest) (*api.PickupPoint, error) {
+	point, err := s.service.CreatePickupPoint(ctx, req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return mapDomainPickupPointToProto(point), nil
+}
+
+func (s *OrdersServer) ListPickupPoints(ctx context.Context, req *api.ListPickupPointsRequest) (*api.PickupPointsList, error) {
+	points, err := s.service.ListPickupPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoPoints := make([]*api.PickupPoint, len(points))
+	for i, point := range points {
+		protoPoints[i] = mapDomainPickupPointToProto(point)
+	}
+	return &api.PickupPointsList{Points: protoPoints}, nil
+}
@@ -0,0 +1,25 @@
+// Package v1 — первая версия контракта, где цена и вес передаются как float.
+// Контракт заморожен: новые методы добавляются только в v2, а здесь значения
+// переводятся в копейки и граммы на входе и обратно во float на выходе.
+package v1
+
+import (
+	server "gitlab.ozon.dev/safariproxd/homework/internal/adapter/grpc"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"google.golang.org/grpc"
+)
+
+type OrdersServer struct {
+	api.UnimplementedOrdersServiceServer
+	service server.IOrderService
+}
+
+func NewOrdersServer(service server.IOrderService) *OrdersServer {
+	return &OrdersServer{
+		service: service,
+	}
+}
+
+func (s *OrdersServer) Register(grpcServer *grpc.Server) {
+	api.RegisterOrdersServiceServer(grpcServer, s)
+}
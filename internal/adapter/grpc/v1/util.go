@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapPackageTypeToString(pt api.PackageType) string {
+	switch pt {
+	case api.PackageType_PACKAGE_TYPE_BAG:
+		return "bag"
+	case api.PackageType_PACKAGE_TYPE_BOX:
+		return "box"
+	case api.PackageType_PACKAGE_TYPE_TAPE:
+		return "film"
+	case api.PackageType_PACKAGE_TYPE_BAG_TAPE:
+		return "bag+film"
+	case api.PackageType_PACKAGE_TYPE_BOX_TAPE:
+		return "box+film"
+	default:
+		return ""
+	}
+}
+
+// mapDomainStatusToProto переводит статус домена в клиентский статус контракта; соответствие то же, что в v2:
+// EXPECTS — заказ на хранении и ждет получателя, ANNOUNCED — анонсирован, но в пункт еще не прибыл
+func mapDomainStatusToProto(status domain.OrderStatus) api.OrderStatus {
+	switch status {
+	case domain.StatusInStorage:
+		return api.OrderStatus_ORDER_STATUS_EXPECTS
+	case domain.StatusGivenToClient:
+		return api.OrderStatus_ORDER_STATUS_ACCEPTED
+	case domain.StatusReturnedFromClient:
+		return api.OrderStatus_ORDER_STATUS_RETURNED
+	case domain.StatusReturnedWithoutClient, domain.StatusGivenToCourier:
+		return api.OrderStatus_ORDER_STATUS_DELETED
+	case domain.StatusExpected:
+		return api.OrderStatus_ORDER_STATUS_ANNOUNCED
+	default:
+		return api.OrderStatus_ORDER_STATUS_UNSPECIFIED
+	}
+}
+
+func mapDomainActionToProto(action domain.OrderAction) api.OrderAction {
+	switch action {
+	case domain.ActionIssue:
+		return api.OrderAction_ORDER_ACTION_ISSUE
+	case domain.ActionReturnFromClient:
+		return api.OrderAction_ORDER_ACTION_RETURN_FROM_CLIENT
+	case domain.ActionReturnToCourier:
+		return api.OrderAction_ORDER_ACTION_RETURN_TO_COURIER
+	case domain.ActionExtendStorage:
+		return api.OrderAction_ORDER_ACTION_EXTEND_STORAGE
+	default:
+		return api.OrderAction_ORDER_ACTION_UNSPECIFIED
+	}
+}
+
+func mapDomainOrderToProto(order domain.Order) *api.Order {
+	pkgType := mapStringToPackageType(order.PackageType)
+	return &api.Order{
+		OrderId:    order.OrderID,
+		UserId:     order.ReceiverID,
+		PvzId:      order.PVZID,
+		Status:     mapDomainStatusToProto(order.Status),
+		ExpiresAt:  timestamppb.New(order.StorageUntil),
+		Weight:     float32(order.Weight.Float()),
+		TotalPrice: float32(order.Price.Float()),
+		Package:    &pkgType,
+		CellCode:   order.CellCode,
+		SellerId:   order.SellerID,
+	}
+}
+
+func mapDomainReturnPolicyToProto(p domain.ReturnPolicy) *api.ReturnPolicy {
+	policy := &api.ReturnPolicy{
+		Id:          p.ID,
+		Name:        p.Name,
+		SellerId:    p.SellerID,
+		WindowHours: uint32(p.Window / time.Hour),
+		Returnable:  p.Returnable,
+	}
+	if p.PackageType != "" {
+		pkgType := mapStringToPackageType(p.PackageType)
+		policy.Package = &pkgType
+	}
+	return policy
+}
+
+func mapProtoCellSizeToDomain(size api.CellSize) domain.CellSize {
+	switch size {
+	case api.CellSize_CELL_SIZE_MEDIUM:
+		return domain.CellSizeMedium
+	case api.CellSize_CELL_SIZE_LARGE:
+		return domain.CellSizeLarge
+	default:
+		return domain.CellSizeSmall
+	}
+}
+
+func mapDomainCellSizeToProto(size domain.CellSize) api.CellSize {
+	switch size {
+	case domain.CellSizeSmall:
+		return api.CellSize_CELL_SIZE_SMALL
+	case domain.CellSizeMedium:
+		return api.CellSize_CELL_SIZE_MEDIUM
+	case domain.CellSizeLarge:
+		return api.CellSize_CELL_SIZE_LARGE
+	default:
+		return api.CellSize_CELL_SIZE_UNSPECIFIED
+	}
+}
+
+func mapDomainStorageCellToProto(c domain.StorageCell) *api.StorageCell {
+	return &api.StorageCell{
+		Id:       c.ID,
+		Code:     c.Code,
+		Size:     mapDomainCellSizeToProto(c.Size),
+		Capacity: c.Capacity,
+		Occupied: c.Occupied,
+	}
+}
+
+func mapStringToPackageType(pt string) api.PackageType {
+	switch pt {
+	case "bag":
+		return api.PackageType_PACKAGE_TYPE_BAG
+	case "box":
+		return api.PackageType_PACKAGE_TYPE_BOX
+	case "film":
+		return api.PackageType_PACKAGE_TYPE_TAPE
+	case "bag+film":
+		return api.PackageType_PACKAGE_TYPE_BAG_TAPE
+	case "box+film":
+		return api.PackageType_PACKAGE_TYPE_BOX_TAPE
+	default:
+		return api.PackageType_PACKAGE_TYPE_UNSPECIFIED
+	}
+}
+
+func mapDomainPickupPointToProto(p domain.PickupPoint) *api.PickupPoint {
+	return &api.PickupPoint{
+		Id:        p.ID,
+		Name:      p.Name,
+		Address:   p.Address,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+}
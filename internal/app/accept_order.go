@@ -4,60 +4,90 @@ import (
 	"context"
 	"fmt"
 
+	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
-func (s *PVZService) AcceptOrder(ctx context.Context, req domain.AcceptOrderRequest) (float64, error) {
+// buildOrder проверяет срок хранения, пункт, уникальность заказа и упаковку и собирает заказ в заданном статусе
+func (s *PVZService) buildOrder(ctx context.Context, req domain.AcceptOrderRequest, status domain.OrderStatus) (domain.Order, error) {
 	currentTime := s.nowFn()
+	pvzID := domain.PVZIDFromContext(ctx)
+
+	if !req.StorageUntil.After(currentTime) {
+		return domain.Order{}, fmt.Errorf("validation: %w",
+			domain.StorageDateInPastError(req.OrderID, cli.MapTimeToString(req.StorageUntil)))
+	}
+
+	if _, err := s.orderRepo.GetPickupPoint(ctx, pvzID); err != nil {
+		return domain.Order{}, fmt.Errorf("repo.GetPickupPoint: %w", err)
+	}
 
 	if _, err := s.orderRepo.GetByID(ctx, req.OrderID); err == nil {
-		return 0, fmt.Errorf("repo.GetByID: %w",
+		return domain.Order{}, fmt.Errorf("repo.GetByID: %w",
 			domain.OrderAlreadyExistsError(req.OrderID))
 	}
 
-	var rules []domain.PackageRules
+	totalPrice := req.Price
 	if req.PackageType != "" {
-		var err error
-		rules, err = s.orderRepo.GetPackageRules(ctx, req.PackageType)
+		rules, err := s.orderRepo.GetPackageRules(ctx, req.PackageType)
 		if err != nil {
-			return 0, fmt.Errorf("validation: %w", err)
+			return domain.Order{}, fmt.Errorf("validation: %w", err)
 		}
-	}
-
-	totalPrice := req.Price
-	for _, r := range rules {
-		if r.MaxWeight > 0 && req.Weight > r.MaxWeight {
-			return 0, fmt.Errorf("validation: %w",
-				domain.WeightTooHeavyError(req.PackageType, req.Weight, r.MaxWeight))
+		if rules.MaxWeight > 0 && req.Weight > rules.MaxWeight {
+			return domain.Order{}, fmt.Errorf("validation: %w",
+				domain.WeightTooHeavyError(req.PackageType, req.Weight, rules.MaxWeight))
 		}
-		totalPrice += r.Price
+		totalPrice += rules.Price
 	}
 
-	order := domain.Order{
+	return domain.Order{
 		OrderID:        req.OrderID,
 		ReceiverID:     req.ReceiverID,
+		PVZID:          pvzID,
+		SellerID:       req.SellerID,
 		StorageUntil:   req.StorageUntil,
-		Status:         domain.StatusInStorage,
+		Status:         status,
 		AcceptTime:     currentTime,
 		LastUpdateTime: currentTime,
 		PackageType:    req.PackageType,
 		Weight:         req.Weight,
 		Price:          totalPrice,
+		CashOnDelivery: req.CashOnDelivery,
+		PaymentStatus:  domain.InitialPaymentStatus(req.CashOnDelivery),
+	}, nil
+}
+
+func (s *PVZService) AcceptOrder(ctx context.Context, req domain.AcceptOrderRequest) (domain.Money, error) {
+	order, err := s.buildOrder(ctx, req, domain.StatusInStorage)
+	if err != nil {
+		return 0, err
 	}
+	currentTime := order.AcceptTime
+	pvzID := order.PVZID
+	totalPrice := order.Price
 
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeCourier, ID: 1})
 	history := domain.OrderHistory{
 		OrderID:   req.OrderID,
+		PVZID:     pvzID,
 		Status:    domain.StatusInStorage,
 		ChangedAt: currentTime,
+		Actor:     actor,
+		Reason:    domain.ReasonAccepted,
+		Comment:   req.Comment,
+	}
+
+	code, pickupCode, err := s.newPickupCode(pvzID, req.ReceiverID, currentTime)
+	if err != nil {
+		return 0, fmt.Errorf("newPickupCode: %w", err)
 	}
 
+	// код попадает в событие, только если он стал действующим; иначе получатель забирает заказ прежним кодом
 	event := domain.NewEvent(
 		domain.EventTypeOrderAccepted,
-		domain.Actor{
-			Type: domain.ActorTypeCourier,
-			ID:   1,
-		},
+		pvzID,
+		actor,
 		domain.OrderInfo{
 			ID:     req.OrderID,
 			UserID: req.ReceiverID,
@@ -65,8 +95,23 @@ func (s *PVZService) AcceptOrder(ctx context.Context, req domain.AcceptOrderRequ
 		},
 	)
 
+	cellSize := domain.CellSizeForWeight(req.Weight)
+
 	if s.dbClient == nil {
+		cell, err := s.orderRepo.OccupyCell(ctx, pvzID, cellSize)
+		if err != nil {
+			return 0, fmt.Errorf("repo.OccupyCell: %w", err)
+		}
+		order.CellID, order.CellCode = cell.ID, cell.Code
+
+		// код сохраняем раньше заказа: прежние заказы получателя на хранении оставляют ему прежний код
+		if _, err := s.orderRepo.SavePickupCode(ctx, pickupCode); err != nil {
+			_ = s.orderRepo.ReleaseCell(ctx, cell.ID)
+			return 0, fmt.Errorf("repo.SavePickupCode: %w", err)
+		}
+
 		if err := s.orderRepo.Save(ctx, order); err != nil {
+			_ = s.orderRepo.ReleaseCell(ctx, cell.ID)
 			return 0, fmt.Errorf("repo.Save: %w", err)
 		}
 
@@ -75,12 +120,26 @@ func (s *PVZService) AcceptOrder(ctx context.Context, req domain.AcceptOrderRequ
 		}
 
 		s.metricsProvider.OrderAccepted()
-		s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo)
+		s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, pvzID)
 
 		return totalPrice, nil
 	}
 
-	err := s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+	err = s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+		cell, err := s.orderRepo.OccupyCellInTx(ctx, tx, pvzID, cellSize)
+		if err != nil {
+			return fmt.Errorf("occupy cell: %w", err)
+		}
+		order.CellID, order.CellCode = cell.ID, cell.Code
+
+		issued, err := s.orderRepo.SavePickupCodeInTx(ctx, tx, pickupCode)
+		if err != nil {
+			return fmt.Errorf("save pickup code: %w", err)
+		}
+		if issued {
+			event.Order.PickupCode = code
+		}
+
 		if err := s.orderRepo.SaveOrderInTx(ctx, tx, order); err != nil {
 			return fmt.Errorf("save order: %w", err)
 		}
@@ -89,7 +148,7 @@ func (s *PVZService) AcceptOrder(ctx context.Context, req domain.AcceptOrderRequ
 			return fmt.Errorf("save history: %w", err)
 		}
 
-		if err := s.outboxRepo.Save(ctx, tx, event); err != nil {
+		if err := s.saveEvent(ctx, tx, event); err != nil {
 			return fmt.Errorf("save event: %w", err)
 		}
 
@@ -101,7 +160,7 @@ func (s *PVZService) AcceptOrder(ctx context.Context, req domain.AcceptOrderRequ
 	}
 
 	s.metricsProvider.OrderAccepted()
-	s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo)
+	s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, pvzID)
 
 	return totalPrice, nil
 }
@@ -20,7 +20,7 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 		ctx          context.Context
 		defaultReq   domain.AcceptOrderRequest
 		fixedTime    time.Time
-		packageRules []domain.PackageRules
+		packageRules domain.PackageRules
 	}
 
 	fixture := testFixture{
@@ -29,17 +29,16 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 			OrderID:      1,
 			ReceiverID:   someRecieverID,
 			StorageUntil: someConstTime.Add(24 * time.Hour),
-			Weight:       5.0,
-			Price:        100.0,
+			Weight:       5 * domain.Kilogram,
+			Price:        100 * domain.Ruble,
 			PackageType:  "bag",
 		},
-		fixedTime: someConstTime,
-		packageRules: []domain.PackageRules{
-			{MaxWeight: 10, Price: 5},
-		},
+		fixedTime:    someConstTime,
+		packageRules: domain.PackageRules{MaxWeight: 10 * domain.Kilogram, Price: 5 * domain.Ruble},
 	}
 
 	expectOrderNotFound := func(repo *mock.OrderRepositoryMock, ctx context.Context, orderID uint64) {
+		repo.GetPickupPointMock.Expect(ctx, domain.DefaultPVZID).Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
 		repo.GetByIDMock.Expect(ctx, orderID).Return(
 			domain.Order{},
 			domain.EntityNotFoundError("order", fmt.Sprintf("%d", orderID)),
@@ -47,20 +46,32 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 	}
 
 	expectOrderExists := func(repo *mock.OrderRepositoryMock, ctx context.Context, orderID uint64) {
+		repo.GetPickupPointMock.Expect(ctx, domain.DefaultPVZID).Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
 		repo.GetByIDMock.Expect(ctx, orderID).Return(
 			domain.Order{OrderID: orderID},
 			nil,
 		)
 	}
 
-	expectPackageRules := func(repo *mock.OrderRepositoryMock, ctx context.Context, packageType string, rules []domain.PackageRules, err error) {
+	testCell := domain.StorageCell{ID: 11, PVZID: domain.DefaultPVZID, Code: "S-01", Size: domain.CellSizeSmall, Capacity: 10}
+
+	expectOccupyCell := func(repo *mock.OrderRepositoryMock, ctx context.Context, size domain.CellSize, err error) {
+		if err != nil {
+			repo.OccupyCellMock.Expect(ctx, domain.DefaultPVZID, size).Return(domain.StorageCell{}, err)
+			return
+		}
+		repo.OccupyCellMock.Expect(ctx, domain.DefaultPVZID, size).Return(testCell, nil)
+	}
+
+	expectPackageRules := func(repo *mock.OrderRepositoryMock, ctx context.Context, packageType string, rules domain.PackageRules, err error) {
 		repo.GetPackageRulesMock.Expect(ctx, packageType).Return(rules, err)
 	}
 
-	buildExpectedOrder := func(req domain.AcceptOrderRequest, totalPrice float64, tm time.Time) domain.Order {
+	buildExpectedOrder := func(req domain.AcceptOrderRequest, totalPrice domain.Money, tm time.Time) domain.Order {
 		return domain.Order{
 			OrderID:        req.OrderID,
 			ReceiverID:     req.ReceiverID,
+			PVZID:          domain.DefaultPVZID,
 			StorageUntil:   req.StorageUntil,
 			Status:         domain.StatusInStorage,
 			AcceptTime:     tm,
@@ -68,14 +79,21 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 			PackageType:    req.PackageType,
 			Weight:         req.Weight,
 			Price:          totalPrice,
+			CellID:         testCell.ID,
+			CellCode:       testCell.Code,
+			CashOnDelivery: req.CashOnDelivery,
+			PaymentStatus:  domain.InitialPaymentStatus(req.CashOnDelivery),
 		}
 	}
 
 	buildExpectedHistory := func(orderID uint64, tm time.Time) domain.OrderHistory {
 		return domain.OrderHistory{
 			OrderID:   orderID,
+			PVZID:     domain.DefaultPVZID,
 			Status:    domain.StatusInStorage,
 			ChangedAt: tm,
+			Actor:     domain.Actor{Type: domain.ActorTypeCourier, ID: 1},
+			Reason:    domain.ReasonAccepted,
 		}
 	}
 
@@ -87,6 +105,17 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 		repo.SaveHistoryMock.Expect(ctx, history).Return(err)
 	}
 
+	// сам код случайный и в репозиторий не попадает, поэтому проверяем только хэш
+	expectSavePickupCode := func(t *testing.T, repo *mock.OrderRepositoryMock, req domain.AcceptOrderRequest) {
+		repo.SavePickupCodeMock.Set(func(_ context.Context, code domain.PickupCode) (bool, error) {
+			assert.Equal(t, domain.DefaultPVZID, code.PVZID)
+			assert.Equal(t, req.ReceiverID, code.ReceiverID)
+			assert.Len(t, code.CodeHash, 64)
+			assert.Equal(t, fixture.fixedTime, code.CreatedAt)
+			return true, nil
+		})
+	}
+
 	modifyRequest := func(base domain.AcceptOrderRequest, modifiers ...func(*domain.AcceptOrderRequest)) domain.AcceptOrderRequest {
 		req := base
 		for _, m := range modifiers {
@@ -95,7 +124,7 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 		return req
 	}
 
-	withWeight := func(w float64) func(*domain.AcceptOrderRequest) {
+	withWeight := func(w domain.Weight) func(*domain.AcceptOrderRequest) {
 		return func(r *domain.AcceptOrderRequest) { r.Weight = w }
 	}
 
@@ -107,22 +136,24 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 		name      string
 		req       domain.AcceptOrderRequest
 		prepare   func(*testing.T, *mock.OrderRepositoryMock, domain.AcceptOrderRequest)
-		wantTotal float64
+		wantTotal domain.Money
 		wantErr   assert.ErrorAssertionFunc
 	}{
 		{
 			name: "Success_AcceptOrder_WithPackageRules",
 			req:  fixture.defaultReq,
 			prepare: func(t *testing.T, repo *mock.OrderRepositoryMock, req domain.AcceptOrderRequest) {
-				expectedOrder := buildExpectedOrder(req, req.Price+5, fixture.fixedTime)
+				expectedOrder := buildExpectedOrder(req, req.Price+5*domain.Ruble, fixture.fixedTime)
 				expectedHistory := buildExpectedHistory(req.OrderID, fixture.fixedTime)
 
 				expectOrderNotFound(repo, fixture.ctx, req.OrderID)
 				expectPackageRules(repo, fixture.ctx, req.PackageType, fixture.packageRules, nil)
+				expectOccupyCell(repo, fixture.ctx, domain.CellSizeSmall, nil)
 				expectSaveOrder(repo, fixture.ctx, expectedOrder, nil)
 				expectSaveHistory(repo, fixture.ctx, expectedHistory, nil)
+				expectSavePickupCode(t, repo, req)
 			},
-			wantTotal: 105.0,
+			wantTotal: 105 * domain.Ruble,
 			wantErr:   assert.NoError,
 		},
 		{
@@ -134,25 +165,37 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 			wantTotal: 0,
 			wantErr:   errIs(domain.OrderAlreadyExistsError(fixture.defaultReq.OrderID)),
 		},
+		{
+			name: "Fail_UnknownPickupPoint",
+			req:  fixture.defaultReq,
+			prepare: func(t *testing.T, repo *mock.OrderRepositoryMock, req domain.AcceptOrderRequest) {
+				repo.GetPickupPointMock.Expect(fixture.ctx, domain.DefaultPVZID).Return(
+					domain.PickupPoint{},
+					domain.EntityNotFoundError("PickupPoint", "1"),
+				)
+			},
+			wantTotal: 0,
+			wantErr:   errIs(domain.EntityNotFoundError("PickupPoint", "1")),
+		},
 		{
 			name: "Fail_InvalidPackageType",
 			req:  fixture.defaultReq,
 			prepare: func(t *testing.T, repo *mock.OrderRepositoryMock, req domain.AcceptOrderRequest) {
 				expectOrderNotFound(repo, fixture.ctx, req.OrderID)
-				expectPackageRules(repo, fixture.ctx, req.PackageType, nil, domain.InvalidPackageError(req.PackageType))
+				expectPackageRules(repo, fixture.ctx, req.PackageType, domain.PackageRules{}, domain.InvalidPackageError(req.PackageType))
 			},
 			wantTotal: 0,
 			wantErr:   errIs(domain.InvalidPackageError(fixture.defaultReq.PackageType)),
 		},
 		{
 			name: "Fail_WeightTooHeavy",
-			req:  modifyRequest(fixture.defaultReq, withWeight(15.0)),
+			req:  modifyRequest(fixture.defaultReq, withWeight(15*domain.Kilogram)),
 			prepare: func(t *testing.T, repo *mock.OrderRepositoryMock, req domain.AcceptOrderRequest) {
 				expectOrderNotFound(repo, fixture.ctx, req.OrderID)
 				expectPackageRules(repo, fixture.ctx, req.PackageType, fixture.packageRules, nil)
 			},
 			wantTotal: 0,
-			wantErr:   errIs(domain.WeightTooHeavyError(fixture.defaultReq.PackageType, 15.0, fixture.packageRules[0].MaxWeight)),
+			wantErr:   errIs(domain.WeightTooHeavyError(fixture.defaultReq.PackageType, 15*domain.Kilogram, fixture.packageRules.MaxWeight)),
 		},
 		{
 			name: "Success_AcceptOrder_WithoutPackageType",
@@ -162,20 +205,35 @@ func TestPVZService_AcceptOrder(t *testing.T) {
 				expectedHistory := buildExpectedHistory(req.OrderID, fixture.fixedTime)
 
 				expectOrderNotFound(repo, fixture.ctx, req.OrderID)
+				expectOccupyCell(repo, fixture.ctx, domain.CellSizeSmall, nil)
 				expectSaveOrder(repo, fixture.ctx, expectedOrder, nil)
 				expectSaveHistory(repo, fixture.ctx, expectedHistory, nil)
+				expectSavePickupCode(t, repo, req)
 			},
-			wantTotal: 100.0,
+			wantTotal: 100 * domain.Ruble,
 			wantErr:   assert.NoError,
 		},
+		{
+			name: "Fail_NoFreeCell",
+			req:  modifyRequest(fixture.defaultReq, withWeight(8*domain.Kilogram)),
+			prepare: func(t *testing.T, repo *mock.OrderRepositoryMock, req domain.AcceptOrderRequest) {
+				expectOrderNotFound(repo, fixture.ctx, req.OrderID)
+				expectPackageRules(repo, fixture.ctx, req.PackageType, fixture.packageRules, nil)
+				expectOccupyCell(repo, fixture.ctx, domain.CellSizeMedium, domain.NoFreeCellError(domain.DefaultPVZID, domain.CellSizeMedium))
+			},
+			wantTotal: 0,
+			wantErr:   errIs(domain.NoFreeCellError(domain.DefaultPVZID, domain.CellSizeMedium)),
+		},
 		{
 			name: "Fail_SaveHistory",
 			req:  fixture.defaultReq,
 			prepare: func(t *testing.T, repo *mock.OrderRepositoryMock, req domain.AcceptOrderRequest) {
-				expectedOrder := buildExpectedOrder(req, req.Price+5, fixture.fixedTime)
+				expectedOrder := buildExpectedOrder(req, req.Price+5*domain.Ruble, fixture.fixedTime)
 				expectedHistory := buildExpectedHistory(req.OrderID, fixture.fixedTime)
 				expectOrderNotFound(repo, fixture.ctx, req.OrderID)
 				expectPackageRules(repo, fixture.ctx, req.PackageType, fixture.packageRules, nil)
+				expectOccupyCell(repo, fixture.ctx, domain.CellSizeSmall, nil)
+				expectSavePickupCode(t, repo, req)
 				repo.SaveMock.Set(func(ctx context.Context, order domain.Order) error {
 					if assert.Equal(t, expectedOrder, order) {
 						return nil
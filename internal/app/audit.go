@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+const (
+	defaultAuditSearchLimit = 100
+	auditVerifyBatch        = 500
+)
+
+// RecordAudit дописывает вызов API в журнал аудита
+func (s *PVZService) RecordAudit(ctx context.Context, rec domain.AuditRecord) error {
+	// Postgres хранит время с точностью до микросекунд; хеш считаем от того, что будет прочитано обратно
+	rec.CreatedAt = s.nowFn().UTC().Truncate(time.Microsecond)
+	if _, err := s.orderRepo.AppendAuditRecord(ctx, rec); err != nil {
+		return fmt.Errorf("repo.AppendAuditRecord: %w", err)
+	}
+	return nil
+}
+
+// SearchAuditLog ищет записи журнала пункта из контекста: чужие пункты оператору не видны
+func (s *PVZService) SearchAuditLog(ctx context.Context, f domain.AuditFilter) ([]domain.AuditRecord, error) {
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return nil, fmt.Errorf("validation: %w", domain.ValidationFailedError("audit search range is empty"))
+	}
+	if f.Limit == 0 {
+		f.Limit = defaultAuditSearchLimit
+	}
+	f.PVZID = domain.PVZIDFromContext(ctx)
+
+	list, err := s.orderRepo.SearchAuditLog(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("repo.SearchAuditLog: %w", err)
+	}
+	return list, nil
+}
+
+// VerifyAuditLog проходит всю цепочку журнала и возвращает число проверенных записей
+// и ID первой испорченной (0, если цепочка цела)
+func (s *PVZService) VerifyAuditLog(ctx context.Context) (uint64, uint64, error) {
+	var (
+		checked uint64
+		last    *domain.AuditRecord
+	)
+	for {
+		batch, err := s.orderRepo.ListAuditChain(ctx, lastAuditID(last), auditVerifyBatch)
+		if err != nil {
+			return checked, 0, fmt.Errorf("repo.ListAuditChain: %w", err)
+		}
+		if len(batch) == 0 {
+			return checked, 0, nil
+		}
+
+		// первая запись журнала ни на что не ссылается: иначе начало цепочки удалено
+		if last == nil && batch[0].PrevHash != "" {
+			return checked, batch[0].ID, nil
+		}
+		// последняя запись прошлой пачки нужна, чтобы проверить стык пачек
+		chain := batch
+		if last != nil {
+			chain = append([]domain.AuditRecord{*last}, batch...)
+		}
+		if brokenID, ok := domain.VerifyAuditChain(chain); !ok {
+			return checked, brokenID, nil
+		}
+		checked += uint64(len(batch))
+		last = &batch[len(batch)-1]
+	}
+}
+
+func lastAuditID(rec *domain.AuditRecord) uint64 {
+	if rec == nil {
+		return 0
+	}
+	return rec.ID
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func auditChain(n int) []domain.AuditRecord {
+	chain := make([]domain.AuditRecord, n)
+	prev := ""
+	for i := range chain {
+		rec := domain.AuditRecord{
+			ID:         uint64(i + 1),
+			Method:     "/orders.v2.OrdersService/AcceptOrder",
+			PVZID:      domain.DefaultPVZID,
+			OrderIDs:   []uint64{uint64(i + 1)},
+			ResultCode: "OK",
+			CreatedAt:  someConstTime.Add(time.Duration(i) * time.Minute),
+			PrevHash:   prev,
+		}
+		rec.Hash = rec.ComputeHash()
+		prev = rec.Hash
+		chain[i] = rec
+	}
+	return chain
+}
+
+func TestPVZService_RecordAudit(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	rec := domain.AuditRecord{Method: "/orders.v2.OrdersService/ReturnOrder", ResultCode: "OK"}
+	want := rec
+	want.CreatedAt = someConstTime
+	repo.AppendAuditRecordMock.Expect(contextBack, want).Return(want, nil)
+
+	assert.NoError(t, svc.RecordAudit(context.Background(), rec))
+}
+
+func TestPVZService_VerifyAuditLog(t *testing.T) {
+	t.Parallel()
+
+	tampered := auditChain(3)
+	tampered[1].Payload = []byte(`{"order_id":"999"}`)
+
+	tests := []struct {
+		name        string
+		chain       []domain.AuditRecord
+		wantChecked uint64
+		wantBroken  uint64
+	}{
+		{
+			name:        "Intact",
+			chain:       auditChain(3),
+			wantChecked: 3,
+		},
+		{
+			name:       "Tampered",
+			chain:      tampered,
+			wantBroken: 2,
+		},
+		{
+			name:       "HeadDeleted",
+			chain:      auditChain(3)[1:],
+			wantBroken: 2,
+		},
+		{
+			name:  "Empty",
+			chain: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.ListAuditChainMock.Set(func(_ context.Context, afterID, limit uint64) ([]domain.AuditRecord, error) {
+				var out []domain.AuditRecord
+				for _, r := range tc.chain {
+					if r.ID > afterID && uint64(len(out)) < limit {
+						out = append(out, r)
+					}
+				}
+				return out, nil
+			})
+
+			checked, broken, err := svc.VerifyAuditLog(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantChecked, checked)
+			assert.Equal(t, tc.wantBroken, broken)
+		})
+	}
+}
+
+func TestPVZService_SearchAuditLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		t.Parallel()
+		repo, svc := NewEnv(t)
+		want := domain.AuditFilter{PVZID: domain.DefaultPVZID, OrderID: 1, Limit: defaultAuditSearchLimit}
+		repo.SearchAuditLogMock.Expect(contextBack, want).Return(auditChain(1), nil)
+
+		list, err := svc.SearchAuditLog(context.Background(), domain.AuditFilter{OrderID: 1})
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+	})
+
+	t.Run("ScopedToContextPVZ", func(t *testing.T) {
+		t.Parallel()
+		repo, svc := NewEnv(t)
+		ctx := domain.WithPVZID(contextBack, 2)
+		// пункт из фильтра вызывающего не учитывается
+		want := domain.AuditFilter{PVZID: 2, Limit: defaultAuditSearchLimit}
+		repo.SearchAuditLogMock.Expect(ctx, want).Return(nil, nil)
+
+		_, err := svc.SearchAuditLog(ctx, domain.AuditFilter{PVZID: 1})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail_EmptyRange", func(t *testing.T) {
+		t.Parallel()
+		_, svc := NewEnv(t)
+		_, err := svc.SearchAuditLog(context.Background(), domain.AuditFilter{From: someConstTime, To: someConstTime})
+		assert.Error(t, err)
+	})
+}
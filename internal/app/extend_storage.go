@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+// ExtendStorage продлевает хранение заказа на days дней и возвращает обновленный заказ и плату за продление.
+// Плата записывается отдельным начислением, цена заказа не меняется
+func (s *PVZService) ExtendStorage(ctx context.Context, orderID uint64, days uint32) (domain.Order, domain.Money, error) {
+	if days == 0 {
+		return domain.Order{}, 0, fmt.Errorf("validation: %w", domain.ValidationFailedError("extension must be at least one day"))
+	}
+
+	var (
+		order domain.Order
+		fee   domain.Money
+	)
+	// при конфликте версий повтор перечитает заказ и заново проверит остаток продления
+	err := retryOnConflict(ctx, func() (err error) {
+		order, fee, err = s.extendStorage(ctx, orderID, days)
+		return err
+	})
+	return order, fee, err
+}
+
+func (s *PVZService) extendStorage(ctx context.Context, orderID uint64, days uint32) (domain.Order, domain.Money, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return domain.Order{}, 0, fmt.Errorf("repo.GetByID: %w", err)
+	}
+	if order.PVZID != pvzID {
+		return domain.Order{}, 0, domain.BelongsToDifferentPVZError(orderID, pvzID, order.PVZID)
+	}
+
+	now := s.nowFn()
+	next, err := s.checkAction(ctx, order, domain.ActionExtendStorage, now)
+	if err != nil {
+		return domain.Order{}, 0, fmt.Errorf("validation: %w", err)
+	}
+	if remaining := s.storageExtension.MaxDays - order.ExtendedDays; days > remaining {
+		return domain.Order{}, 0, fmt.Errorf("validation: %w",
+			domain.StorageExtensionLimitError(orderID, s.storageExtension.MaxDays, remaining))
+	}
+
+	fee := s.storageExtension.Charge(order, days, now)
+	prev := order.Status
+	order.Status = next
+	order.StorageUntil = order.StorageUntil.AddDate(0, 0, int(days))
+	order.ExtendedDays += days
+	order.LastUpdateTime = now
+
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeClient, ID: order.ReceiverID})
+	history := domain.OrderHistory{
+		OrderID:    orderID,
+		PVZID:      pvzID,
+		PrevStatus: &prev,
+		Status:     next,
+		ChangedAt:  now,
+		Actor:      actor,
+		Reason:     domain.ReasonStorageExtended,
+		Comment:    fmt.Sprintf("extended by %d days", days),
+	}
+
+	event := domain.NewEvent(
+		domain.EventTypeOrderStorageExtended,
+		pvzID,
+		actor,
+		domain.OrderInfo{
+			ID:             orderID,
+			UserID:         order.ReceiverID,
+			Status:         "storage_extended",
+			StorageFee:     fee.Amount,
+			StorageFeeDays: fee.PaidDays,
+		},
+	)
+
+	if s.dbClient == nil {
+		if err := s.orderRepo.Update(ctx, order); err != nil {
+			return domain.Order{}, 0, fmt.Errorf("repo.Update: %w", err)
+		}
+		if err := s.orderRepo.SaveHistory(ctx, history); err != nil {
+			return domain.Order{}, 0, fmt.Errorf("repo.SaveHistory: %w", err)
+		}
+		if fee.Amount > 0 {
+			if err := s.orderRepo.SaveStorageFee(ctx, fee); err != nil {
+				return domain.Order{}, 0, fmt.Errorf("repo.SaveStorageFee: %w", err)
+			}
+		}
+		return order, fee.Amount, nil
+	}
+
+	err = s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+		if err := s.orderRepo.UpdateOrderInTx(ctx, tx, order); err != nil {
+			return fmt.Errorf("update order: %w", err)
+		}
+
+		if err := s.orderRepo.SaveHistoryInTx(ctx, tx, history); err != nil {
+			return fmt.Errorf("save history: %w", err)
+		}
+
+		if fee.Amount > 0 {
+			if err := s.orderRepo.SaveStorageFeeInTx(ctx, tx, fee); err != nil {
+				return fmt.Errorf("save storage fee: %w", err)
+			}
+		}
+
+		if err := s.saveEvent(ctx, tx, event); err != nil {
+			return fmt.Errorf("save event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.Order{}, 0, err
+	}
+	return order, fee.Amount, nil
+}
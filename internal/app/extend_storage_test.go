@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func TestPVZService_ExtendStorage(t *testing.T) {
+	t.Parallel()
+
+	errUpd := errors.New("update err")
+	policy := domain.StorageExtensionPolicy{MaxDays: 5, FeePerDay: 10 * domain.Ruble}
+
+	tests := []struct {
+		name     string
+		order    domain.Order
+		days     uint32
+		setup    func(*mock.OrderRepositoryMock, domain.Order)
+		wantFee  domain.Money
+		wantDays uint32
+		assertE  assert.ErrorAssertionFunc
+	}{
+		{
+			name:  "Success",
+			order: OrderInStorage(1, 24*time.Hour),
+			days:  3,
+			setup: func(r *mock.OrderRepositoryMock, o domain.Order) {
+				want := o
+				want.StorageUntil = o.StorageUntil.AddDate(0, 0, 3)
+				want.ExtendedDays = 3
+				want.LastUpdateTime = someConstTime
+				r.UpdateMock.Expect(contextBack, want).Return(nil)
+				hist := HistoryBy(History(1, domain.StatusInStorage, 0),
+					domain.Actor{Type: domain.ActorTypeClient, ID: someRecieverID})
+				r.SaveHistoryMock.Expect(contextBack, Transition(hist, domain.StatusInStorage,
+					domain.ReasonStorageExtended, "extended by 3 days")).Return(nil)
+				// плата — отдельное начисление, цена заказа прежняя
+				r.SaveStorageFeeMock.Expect(contextBack, domain.StorageFee{
+					Kind:       domain.StorageFeeExtension,
+					OrderID:    1,
+					PVZID:      domain.DefaultPVZID,
+					ReceiverID: someRecieverID,
+					PaidDays:   3,
+					DailyRate:  10 * domain.Ruble,
+					Amount:     30 * domain.Ruble,
+					ChargedAt:  someConstTime,
+				}).Return(nil)
+			},
+			wantFee:  30 * domain.Ruble,
+			wantDays: 3,
+			assertE:  assert.NoError,
+		},
+		{
+			name:  "Success_RetriesOnConflict",
+			order: OrderInStorage(5, 24*time.Hour),
+			days:  1,
+			setup: func(r *mock.OrderRepositoryMock, _ domain.Order) {
+				conflicts := 1
+				r.UpdateMock.Set(func(_ context.Context, o domain.Order) error {
+					if conflicts > 0 {
+						conflicts--
+						return domain.ConcurrentModificationError(o.OrderID)
+					}
+					return nil
+				})
+				r.SaveHistoryMock.Return(nil)
+				r.SaveStorageFeeMock.Return(nil)
+			},
+			wantFee:  10 * domain.Ruble,
+			wantDays: 1,
+			assertE:  assert.NoError,
+		},
+		{
+			name: "Fail_OverLimit",
+			order: func() domain.Order {
+				o := OrderInStorage(2, 24*time.Hour)
+				o.ExtendedDays = 4
+				return o
+			}(),
+			days:    2,
+			setup:   func(*mock.OrderRepositoryMock, domain.Order) {},
+			assertE: errIs(domain.StorageExtensionLimitError(2, 5, 1)),
+		},
+		{
+			name:    "Fail_NotInStorage",
+			order:   OrderGiven(3, 0),
+			days:    1,
+			setup:   func(*mock.OrderRepositoryMock, domain.Order) {},
+			assertE: errIs(domain.InvalidTransitionError(3, "Given to client", "extend_storage")),
+		},
+		{
+			name:  "Fail_UpdateError",
+			order: OrderInStorage(4, 24*time.Hour),
+			days:  1,
+			setup: func(r *mock.OrderRepositoryMock, _ domain.Order) {
+				r.UpdateMock.Return(errUpd)
+			},
+			assertE: errIs(errUpd),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			svc.SetStorageExtensionPolicy(policy)
+			repo.GetByIDMock.Expect(contextBack, tc.order.OrderID).Return(tc.order, nil)
+			tc.setup(repo, tc.order)
+
+			got, fee, err := svc.ExtendStorage(context.Background(), tc.order.OrderID, tc.days)
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, tc.wantFee, fee)
+				assert.Equal(t, tc.wantDays, got.ExtendedDays)
+			}
+		})
+	}
+}
@@ -3,61 +3,149 @@ package app
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 )
 
-func (s *PVZService) GetReceiverOrders(ctx context.Context, req domain.ReceiverOrdersRequest) ([]domain.Order, uint64, error) {
-	receiverOrders, err := s.orderRepo.GetByReceiverID(ctx, req.ReceiverID)
-	if err != nil {
-		return nil, 0, fmt.Errorf("repo.GetByReceiverID: %w", err)
+func (s *PVZService) GetReceiverOrders(ctx context.Context, req domain.ReceiverOrdersRequest) (domain.OrderPage, error) {
+	if req.ReceiverID == 0 {
+		if req.Phone == "" {
+			return domain.OrderPage{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("receiver id or phone is required"))
+		}
+		receiverID, err := s.receiverIDByPhone(ctx, req.Phone)
+		if err != nil {
+			return domain.OrderPage{}, err
+		}
+		req.ReceiverID = receiverID
 	}
 
-	var filteredOrders []domain.Order
-	for _, order := range receiverOrders {
-		if req.InPVZ && order.Status != domain.StatusInStorage {
-			continue
-		}
-		filteredOrders = append(filteredOrders, order)
+	f := domain.OrderListFilter{PVZID: domain.PVZIDFromContext(ctx), ReceiverID: req.ReceiverID}
+	if req.InPVZ {
+		f.Statuses = []domain.OrderStatus{domain.StatusInStorage}
+	}
+	if req.LastN > 0 {
+		return s.lastOrders(ctx, f, req.LastN)
+	}
+	return s.listOrdersPage(ctx, f, domain.PageRequest{Page: req.Page, Limit: req.Limit, Token: req.PageToken}, false)
+}
+
+// GetReturnedOrders отдает возвраты пункта от свежих к старым
+func (s *PVZService) GetReturnedOrders(ctx context.Context, req domain.PageRequest) (domain.OrderPage, error) {
+	f := domain.OrderListFilter{
+		PVZID:    domain.PVZIDFromContext(ctx),
+		Statuses: []domain.OrderStatus{domain.StatusReturnedFromClient, domain.StatusGivenToCourier},
 	}
+	return s.listOrdersPage(ctx, f, req, true)
+}
 
-	var paginatedOrders []domain.Order
-	totalItems := uint64(len(filteredOrders))
+// GetOrderHistory отдает заказы пункта от свежих обновлений к старым
+func (s *PVZService) GetOrderHistory(ctx context.Context, req domain.PageRequest) (domain.OrderPage, error) {
+	f := domain.OrderListFilter{PVZID: domain.PVZIDFromContext(ctx)}
+	return s.listOrdersPage(ctx, f, req, true)
+}
 
-	if req.LastN > 0 {
-		if totalItems > req.LastN {
-			paginatedOrders = filteredOrders[totalItems-req.LastN:]
-		} else {
-			paginatedOrders = filteredOrders
+// listOrdersPage читает из базы одну страницу заказов и их общее число. Заказов запрашивается на один
+// больше страницы: так видно, есть ли следующая, и токен отдается только тогда. byUpdate выбирает порядок:
+// от свежих обновлений к старым или по возрастанию ID
+func (s *PVZService) listOrdersPage(ctx context.Context, f domain.OrderListFilter, req domain.PageRequest, byUpdate bool) (domain.OrderPage, error) {
+	order := domain.PageOrderByID
+	if byUpdate {
+		order = domain.PageOrderByUpdate
+	}
+	scope := domain.PageScope(order, f)
+	q, err := orderPageQuery(req, scope)
+	if err != nil {
+		return domain.OrderPage{}, err
+	}
+	total, err := s.orderRepo.CountOrders(ctx, f)
+	if err != nil {
+		return domain.OrderPage{}, fmt.Errorf("repo.CountOrders: %w", err)
+	}
+	page := domain.OrderPage{Total: total}
+	if req.Limit == 0 {
+		return page, nil
+	}
+
+	q.Limit = req.Limit + 1
+	var orders []domain.Order
+	if byUpdate {
+		orders, err = s.orderRepo.ListOrdersByUpdate(ctx, f, q)
+		if err != nil {
+			return domain.OrderPage{}, fmt.Errorf("repo.ListOrdersByUpdate: %w", err)
 		}
 	} else {
-		paginatedOrders = Paginate(filteredOrders, req.Page, req.Limit)
+		orders, err = s.orderRepo.ListOrdersByID(ctx, f, q)
+		if err != nil {
+			return domain.OrderPage{}, fmt.Errorf("repo.ListOrdersByID: %w", err)
+		}
 	}
 
-	return paginatedOrders, totalItems, nil
+	if uint64(len(orders)) > req.Limit {
+		orders = orders[:req.Limit]
+		last := orders[len(orders)-1]
+		cursor := domain.PageCursor{ID: last.OrderID, Scope: scope}
+		if byUpdate {
+			cursor.Time = last.LastUpdateTime
+		}
+		page.NextPageToken = cursor.Token()
+	}
+	page.Orders = orders
+	return page, nil
 }
 
-func (s *PVZService) GetReturnedOrders(ctx context.Context, page, limit uint64) ([]domain.Order, uint64, error) {
-	returnOrders, err := s.orderRepo.GetReturnedOrders(ctx)
+// lastOrders отдает последние n заказов по возрастанию ID
+func (s *PVZService) lastOrders(ctx context.Context, f domain.OrderListFilter, n uint64) (domain.OrderPage, error) {
+	total, err := s.orderRepo.CountOrders(ctx, f)
 	if err != nil {
-		return nil, 0, fmt.Errorf("repo.GetReturnedOrders: %w", err)
+		return domain.OrderPage{}, fmt.Errorf("repo.CountOrders: %w", err)
+	}
+	page := domain.OrderPage{Total: total}
+	n = min(n, total)
+	if n == 0 {
+		return page, nil
 	}
+	page.Orders, err = s.orderRepo.ListOrdersByID(ctx, f, domain.OrderPageQuery{Offset: total - n, Limit: n})
+	if err != nil {
+		return domain.OrderPage{}, fmt.Errorf("repo.ListOrdersByID: %w", err)
+	}
+	return page, nil
+}
 
-	paginated := Paginate(returnOrders, page, limit)
-	return paginated, uint64(len(returnOrders)), nil
+// orderPageQuery переводит страницу запроса в условие для базы: токен задает keyset-курсор,
+// номер страницы — пропуск. Токен, выданный для другого порядка или фильтра, отвергается.
+// Размер страницы подставляет вызывающий
+func orderPageQuery(req domain.PageRequest, scope string) (domain.OrderPageQuery, error) {
+	if req.Token != "" {
+		cursor, err := domain.ParsePageToken(req.Token, scope)
+		if err != nil {
+			return domain.OrderPageQuery{}, fmt.Errorf("validation: %w", err)
+		}
+		return domain.OrderPageQuery{After: &cursor}, nil
+	}
+	page := max(req.Page, 1)
+	return domain.OrderPageQuery{Offset: (page - 1) * req.Limit}, nil
 }
 
-func (s *PVZService) GetOrderHistory(ctx context.Context) ([]domain.Order, error) {
-	orders, err := s.orderRepo.GetAllOrders(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("repo.GetAllOrders: %w", err)
+// ExportOrders передает заказы пункта по фильтру в emit по одному, не собирая выборку в памяти
+func (s *PVZService) ExportOrders(ctx context.Context, f domain.OrderExportFilter, emit func(domain.Order) error) error {
+	if !f.AcceptedFrom.IsZero() && !f.AcceptedTo.IsZero() && !f.AcceptedFrom.Before(f.AcceptedTo) {
+		return fmt.Errorf("validation: %w", domain.ValidationFailedError("export date range is empty"))
+	}
+	if f.ReceiverID == 0 && f.Phone != "" {
+		receiverID, err := s.receiverIDByPhone(ctx, f.Phone)
+		if err != nil {
+			return err
+		}
+		f.ReceiverID = receiverID
 	}
+	f.PVZID = domain.PVZIDFromContext(ctx)
 
-	sort.Slice(orders, func(i, j int) bool {
-		return orders[i].LastUpdateTime.After(orders[j].LastUpdateTime)
-	})
-	return orders, nil
+	if err := s.orderRepo.ExportOrders(ctx, f, emit); err != nil {
+		return fmt.Errorf("repo.ExportOrders: %w", err)
+	}
+	return nil
 }
 
 func (s *PVZService) GetOrderHistoryByID(ctx context.Context, orderID uint64) ([]domain.OrderHistory, error) {
@@ -66,6 +154,11 @@ func (s *PVZService) GetOrderHistoryByID(ctx context.Context, orderID uint64) ([
 		return nil, fmt.Errorf("repo.GetHistoryByOrderID: %w", err)
 	}
 
+	pvzID := domain.PVZIDFromContext(ctx)
+	history = slices.DeleteFunc(history, func(h domain.OrderHistory) bool {
+		return h.PVZID != pvzID
+	})
+
 	if len(history) == 0 {
 		return nil, fmt.Errorf("history: %w", domain.EntityNotFoundError("Order", fmt.Sprintf("%d", orderID)))
 	}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -21,40 +22,60 @@ func TestPVZService_GetReceiverOrders(t *testing.T) {
 		BuildOrder(4, domain.StatusReturnedFromClient, 4*time.Hour, 0),
 		OrderInStorage(5, 5*time.Hour),
 	}
+	byReceiver := domain.OrderListFilter{PVZID: domain.DefaultPVZID, ReceiverID: someRecieverID}
+	inPVZ := domain.OrderListFilter{PVZID: domain.DefaultPVZID, ReceiverID: someRecieverID,
+		Statuses: []domain.OrderStatus{domain.StatusInStorage}}
+	afterFourth := domain.PageCursor{ID: 4, Scope: domain.PageScope(domain.PageOrderByID, byReceiver)}
 
 	tests := []struct {
 		name      string
+		ctx       context.Context
 		req       domain.ReceiverOrdersRequest
 		setup     func(*mock.OrderRepositoryMock)
 		wantIDs   []uint64
 		wantTotal uint64
+		wantToken string
 		assertE   assert.ErrorAssertionFunc
 	}{
 		{
 			name: "All_NoFilter",
 			req:  domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, Page: 1, Limit: 100},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetByReceiverIDMock.Expect(contextBack, someRecieverID).Return(allOrders, nil)
+				r.CountOrdersMock.Expect(contextBack, byReceiver).Return(5, nil)
+				r.ListOrdersByIDMock.Expect(contextBack, byReceiver, domain.OrderPageQuery{Limit: 101}).Return(allOrders, nil)
 			},
 			wantIDs:   []uint64{1, 2, 3, 4, 5},
 			wantTotal: 5,
 			assertE:   assert.NoError,
 		},
 		{
-			name: "Filter_InPVZ",
-			req:  domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, InPVZ: true, Page: 1, Limit: 100},
+			name: "ByPhone",
+			req:  domain.ReceiverOrdersRequest{Phone: "8 (999) 123-45-67", InPVZ: true, Page: 1, Limit: 100},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetByReceiverIDMock.Expect(contextBack, someRecieverID).Return(allOrders, nil)
+				r.GetReceiverByPhoneMock.Expect(contextBack, "+79991234567").Return(domain.Receiver{ID: someRecieverID}, nil)
+				r.CountOrdersMock.Expect(contextBack, inPVZ).Return(3, nil)
+				r.ListOrdersByIDMock.Expect(contextBack, inPVZ, domain.OrderPageQuery{Limit: 101}).
+					Return([]domain.Order{allOrders[0], allOrders[2], allOrders[4]}, nil)
 			},
 			wantIDs:   []uint64{1, 3, 5},
 			wantTotal: 3,
 			assertE:   assert.NoError,
 		},
+		{
+			name: "ByPhone_Unknown",
+			req:  domain.ReceiverOrdersRequest{Phone: "+79990000000", Page: 1, Limit: 100},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetReceiverByPhoneMock.Return(domain.Receiver{}, domain.EntityNotFoundError("Receiver", "+79990000000"))
+			},
+			assertE: assert.Error,
+		},
 		{
 			name: "LastN",
 			req:  domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, LastN: 2},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetByReceiverIDMock.Expect(contextBack, someRecieverID).Return(allOrders, nil)
+				r.CountOrdersMock.Expect(contextBack, byReceiver).Return(5, nil)
+				r.ListOrdersByIDMock.Expect(contextBack, byReceiver, domain.OrderPageQuery{Offset: 3, Limit: 2}).
+					Return(allOrders[3:], nil)
 			},
 			wantIDs:   []uint64{4, 5},
 			wantTotal: 5,
@@ -64,17 +85,64 @@ func TestPVZService_GetReceiverOrders(t *testing.T) {
 			name: "Pagination_Page2",
 			req:  domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, Page: 2, Limit: 2},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetByReceiverIDMock.Expect(contextBack, someRecieverID).Return(allOrders, nil)
+				r.CountOrdersMock.Expect(contextBack, byReceiver).Return(5, nil)
+				r.ListOrdersByIDMock.Expect(contextBack, byReceiver, domain.OrderPageQuery{Offset: 2, Limit: 3}).
+					Return(allOrders[2:], nil)
 			},
 			wantIDs:   []uint64{3, 4},
 			wantTotal: 5,
+			wantToken: afterFourth.Token(),
+			assertE:   assert.NoError,
+		},
+		{
+			name: "PageToken_LastPage",
+			req:  domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, Page: 7, Limit: 2, PageToken: afterFourth.Token()},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.CountOrdersMock.Expect(contextBack, byReceiver).Return(5, nil)
+				r.ListOrdersByIDMock.Expect(contextBack, byReceiver, domain.OrderPageQuery{After: &afterFourth, Limit: 3}).
+					Return(allOrders[4:], nil)
+			},
+			wantIDs:   []uint64{5},
+			wantTotal: 5,
+			assertE:   assert.NoError,
+		},
+		{
+			name:    "PageToken_Invalid",
+			req:     domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, Limit: 2, PageToken: "not-a-token"},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: errIs(domain.ValidationFailedError("invalid page token")),
+		},
+		{
+			// токен выдан для списка только хранящихся заказов
+			name: "PageToken_OtherFilter",
+			req: domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, Limit: 2,
+				PageToken: domain.PageCursor{ID: 4, Scope: domain.PageScope(domain.PageOrderByID, inPVZ)}.Token()},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: errIs(domain.ValidationFailedError("page token belongs to another list")),
+		},
+		{
+			name: "ScopedToCallerPVZ",
+			ctx:  domain.WithPVZID(contextBack, 2),
+			req:  domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, Page: 1, Limit: 100},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.CountOrdersMock.Set(func(_ context.Context, f domain.OrderListFilter) (uint64, error) {
+					if f.PVZID != 2 || f.ReceiverID != someRecieverID {
+						return 0, fmt.Errorf("unexpected pvz %d receiver %d", f.PVZID, f.ReceiverID)
+					}
+					return 1, nil
+				})
+				r.ListOrdersByIDMock.Return(allOrders[:1], nil)
+			},
+			wantIDs:   []uint64{1},
+			wantTotal: 1,
 			assertE:   assert.NoError,
 		},
 		{
 			name: "RepoError",
 			req:  domain.ReceiverOrdersRequest{ReceiverID: someRecieverID, Page: 1, Limit: 10},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetByReceiverIDMock.Expect(contextBack, someRecieverID).Return(nil, assert.AnError)
+				r.CountOrdersMock.Expect(contextBack, byReceiver).Return(5, nil)
+				r.ListOrdersByIDMock.Return(nil, assert.AnError)
 			},
 			wantIDs:   nil,
 			wantTotal: 0,
@@ -88,11 +156,16 @@ func TestPVZService_GetReceiverOrders(t *testing.T) {
 			repo, svc := NewEnv(t)
 			tc.setup(repo)
 
-			got, total, err := svc.GetReceiverOrders(contextBack, tc.req)
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = contextBack
+			}
+			got, err := svc.GetReceiverOrders(ctx, tc.req)
 
 			tc.assertE(t, err)
-			assert.Equal(t, tc.wantTotal, total)
-			assert.ElementsMatch(t, tc.wantIDs, IdsOf(got))
+			assert.Equal(t, tc.wantTotal, got.Total)
+			assert.Equal(t, tc.wantIDs, IdsOf(got.Orders))
+			assert.Equal(t, tc.wantToken, got.NextPageToken)
 		})
 	}
 }
@@ -106,40 +179,75 @@ func TestPVZService_GetReturnedOrders(t *testing.T) {
 		BuildOrder(3, domain.StatusGivenToCourier, 0, -3*time.Minute),
 		OrderReturned(4, -4*time.Minute),
 	}
+	filter := domain.OrderListFilter{
+		PVZID:    domain.DefaultPVZID,
+		Statuses: []domain.OrderStatus{domain.StatusReturnedFromClient, domain.StatusGivenToCourier},
+	}
+	afterSecond := domain.PageCursor{Time: returned[1].LastUpdateTime, ID: 2,
+		Scope: domain.PageScope(domain.PageOrderByUpdate, filter)}
 
 	tests := []struct {
 		name      string
-		page, lim uint64
+		req       domain.PageRequest
 		setup     func(*mock.OrderRepositoryMock)
 		wantIDs   []uint64
 		wantTotal uint64
+		wantToken string
 		assertE   assert.ErrorAssertionFunc
 	}{
 		{
 			name: "All_NoPagination",
-			page: 1, lim: 100,
+			req:  domain.PageRequest{Page: 1, Limit: 100},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetReturnedOrdersMock.Expect(contextBack).Return(returned, nil)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(4, nil)
+				r.ListOrdersByUpdateMock.Expect(contextBack, filter, domain.OrderPageQuery{Limit: 101}).Return(returned, nil)
 			},
 			wantIDs:   []uint64{1, 2, 3, 4},
 			wantTotal: 4,
 			assertE:   assert.NoError,
 		},
 		{
-			name: "Pagination_Page2",
-			page: 2, lim: 2,
+			name: "FirstPage_ReturnsToken",
+			req:  domain.PageRequest{Limit: 2},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetReturnedOrdersMock.Expect(contextBack).Return(returned, nil)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(4, nil)
+				r.ListOrdersByUpdateMock.Expect(contextBack, filter, domain.OrderPageQuery{Limit: 3}).Return(returned[:3], nil)
+			},
+			wantIDs:   []uint64{1, 2},
+			wantTotal: 4,
+			wantToken: afterSecond.Token(),
+			assertE:   assert.NoError,
+		},
+		{
+			name: "PageToken",
+			req:  domain.PageRequest{Limit: 2, Token: afterSecond.Token()},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.CountOrdersMock.Expect(contextBack, filter).Return(4, nil)
+				r.ListOrdersByUpdateMock.Set(func(_ context.Context, _ domain.OrderListFilter, q domain.OrderPageQuery) ([]domain.Order, error) {
+					if q.After == nil || q.After.ID != 2 || !q.After.Time.Equal(afterSecond.Time) || q.Offset != 0 {
+						return nil, fmt.Errorf("unexpected page query %+v", q)
+					}
+					return returned[2:], nil
+				})
 			},
 			wantIDs:   []uint64{3, 4},
 			wantTotal: 4,
 			assertE:   assert.NoError,
 		},
+		{
+			// токен истории заказов пункта: тот же порядок, но другой фильтр
+			name: "PageToken_FromOrderHistory",
+			req: domain.PageRequest{Limit: 2, Token: domain.PageCursor{Time: afterSecond.Time, ID: 2,
+				Scope: domain.PageScope(domain.PageOrderByUpdate, domain.OrderListFilter{PVZID: domain.DefaultPVZID})}.Token()},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: errIs(domain.ValidationFailedError("page token belongs to another list")),
+		},
 		{
 			name: "PageBeyondRange",
-			page: 3, lim: 2,
+			req:  domain.PageRequest{Page: 3, Limit: 2},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetReturnedOrdersMock.Expect(contextBack).Return(returned, nil)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(4, nil)
+				r.ListOrdersByUpdateMock.Expect(contextBack, filter, domain.OrderPageQuery{Offset: 4, Limit: 3}).Return(nil, nil)
 			},
 			wantIDs:   nil,
 			wantTotal: 4,
@@ -147,9 +255,9 @@ func TestPVZService_GetReturnedOrders(t *testing.T) {
 		},
 		{
 			name: "LimitZero",
-			page: 1, lim: 0,
+			req:  domain.PageRequest{Page: 1},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetReturnedOrdersMock.Expect(contextBack).Return(returned, nil)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(4, nil)
 			},
 			wantIDs:   nil,
 			wantTotal: 4,
@@ -157,9 +265,9 @@ func TestPVZService_GetReturnedOrders(t *testing.T) {
 		},
 		{
 			name: "RepoError",
-			page: 1, lim: 10,
+			req:  domain.PageRequest{Page: 1, Limit: 10},
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetReturnedOrdersMock.Expect(contextBack).Return(nil, assert.AnError)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(0, assert.AnError)
 			},
 			wantIDs:   nil,
 			wantTotal: 0,
@@ -173,11 +281,12 @@ func TestPVZService_GetReturnedOrders(t *testing.T) {
 			repo, svc := NewEnv(t)
 			tc.setup(repo)
 
-			got, total, err := svc.GetReturnedOrders(contextBack, tc.page, tc.lim)
+			got, err := svc.GetReturnedOrders(contextBack, tc.req)
 
 			tc.assertE(t, err)
-			assert.Equal(t, tc.wantTotal, total)
-			assert.ElementsMatch(t, tc.wantIDs, IdsOf(got))
+			assert.Equal(t, tc.wantTotal, got.Total)
+			assert.Equal(t, tc.wantIDs, IdsOf(got.Orders))
+			assert.Equal(t, tc.wantToken, got.NextPageToken)
 		})
 	}
 }
@@ -185,12 +294,12 @@ func TestPVZService_GetReturnedOrders(t *testing.T) {
 func TestPVZService_GetOrderHistory(t *testing.T) {
 	t.Parallel()
 
-	input := []domain.Order{
-		BuildOrder(1, domain.StatusGivenToClient, 0, -3*time.Hour),
+	sorted := []domain.Order{
 		BuildOrder(2, domain.StatusGivenToClient, 0, -1*time.Hour),
 		BuildOrder(3, domain.StatusGivenToClient, 0, -2*time.Hour),
+		BuildOrder(1, domain.StatusGivenToClient, 0, -3*time.Hour),
 	}
-	wantIDs := []uint64{2, 3, 1}
+	filter := domain.OrderListFilter{PVZID: domain.DefaultPVZID}
 	tests := []struct {
 		name    string
 		setup   func(*mock.OrderRepositoryMock)
@@ -200,15 +309,17 @@ func TestPVZService_GetOrderHistory(t *testing.T) {
 		{
 			name: "Success_Sorted",
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetAllOrdersMock.Expect(contextBack).Return(input, nil)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(3, nil)
+				r.ListOrdersByUpdateMock.Expect(contextBack, filter, domain.OrderPageQuery{Limit: 11}).Return(sorted, nil)
 			},
-			wantIDs: wantIDs,
+			wantIDs: []uint64{2, 3, 1},
 			assertE: assert.NoError,
 		},
 		{
 			name: "Success_EmptyList",
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetAllOrdersMock.Expect(contextBack).Return([]domain.Order{}, nil)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(0, nil)
+				r.ListOrdersByUpdateMock.Return([]domain.Order{}, nil)
 			},
 			wantIDs: nil,
 			assertE: assert.NoError,
@@ -216,7 +327,8 @@ func TestPVZService_GetOrderHistory(t *testing.T) {
 		{
 			name: "RepoError",
 			setup: func(r *mock.OrderRepositoryMock) {
-				r.GetAllOrdersMock.Expect(contextBack).Return(nil, assert.AnError)
+				r.CountOrdersMock.Expect(contextBack, filter).Return(3, nil)
+				r.ListOrdersByUpdateMock.Return(nil, assert.AnError)
 			},
 			wantIDs: nil,
 			assertE: errIs(assert.AnError),
@@ -229,13 +341,14 @@ func TestPVZService_GetOrderHistory(t *testing.T) {
 			repo, svc := NewEnv(t)
 			tc.setup(repo)
 
-			got, err := svc.GetOrderHistory(contextBack)
+			got, err := svc.GetOrderHistory(contextBack, domain.PageRequest{Page: 1, Limit: 10})
 
 			tc.assertE(t, err)
-			assert.Equal(t, tc.wantIDs, IdsOf(got))
+			assert.Equal(t, tc.wantIDs, IdsOf(got.Orders))
 		})
 	}
 }
+
 func TestPVZService_GetOrderHistoryByID(t *testing.T) {
 	t.Parallel()
 
@@ -292,3 +405,67 @@ func TestPVZService_GetOrderHistoryByID(t *testing.T) {
 		})
 	}
 }
+
+func TestPVZService_ExportOrders(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		filter  domain.OrderExportFilter
+		setup   func(*mock.OrderRepositoryMock)
+		wantIDs []uint64
+		assertE assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "ByPhone",
+			filter: domain.OrderExportFilter{Phone: "8 (999) 123-45-67", Statuses: []domain.OrderStatus{domain.StatusInStorage}},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetReceiverByPhoneMock.Expect(contextBack, "+79991234567").Return(domain.Receiver{ID: someRecieverID}, nil)
+				r.ExportOrdersMock.Set(func(_ context.Context, f domain.OrderExportFilter, emit func(domain.Order) error) error {
+					assert.Equal(t, domain.DefaultPVZID, f.PVZID)
+					assert.Equal(t, someRecieverID, f.ReceiverID)
+					for _, order := range []domain.Order{OrderInStorage(1, time.Hour), OrderInStorage(3, time.Hour)} {
+						if err := emit(order); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			},
+			wantIDs: []uint64{1, 3},
+			assertE: assert.NoError,
+		},
+		{
+			name:    "EmptyRange",
+			filter:  domain.OrderExportFilter{AcceptedFrom: day, AcceptedTo: day},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+		{
+			name:   "RepoError",
+			filter: domain.OrderExportFilter{},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.ExportOrdersMock.Return(assert.AnError)
+			},
+			assertE: errIs(assert.AnError),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			tc.setup(repo)
+
+			var got []uint64
+			err := svc.ExportOrders(contextBack, tc.filter, func(order domain.Order) error {
+				got = append(got, order.OrderID)
+				return nil
+			})
+
+			tc.assertE(t, err)
+			assert.Equal(t, tc.wantIDs, got)
+		})
+	}
+}
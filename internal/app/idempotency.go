@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+// BeginIdempotent занимает ключ идемпотентности под запрос. Возвращает сохраненный ответ,
+// если запрос с этим ключом уже выполнен, и nil, если запрос нужно выполнить; тогда claimedAt —
+// отметка, по которой запрос потом сохранит ответ или освободит ключ.
+// Ключ, брошенный незавершенным дольше idempotencyLock, занимается заново
+func (s *PVZService) BeginIdempotent(
+	ctx context.Context,
+	pvzID uint64,
+	key, method, requestHash string,
+) (saved *domain.IdempotencyRecord, claimedAt time.Time, err error) {
+	// база хранит время с точностью до микросекунд, а отметка сравнивается с записанной
+	now := s.nowFn().Truncate(time.Microsecond)
+	rec := domain.IdempotencyRecord{
+		PVZID:       pvzID,
+		Key:         key,
+		Method:      method,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+	}
+	existing, reserved, err := s.orderRepo.ReserveIdempotencyKey(ctx, rec,
+		now.Add(-s.idempotencyTTL), now.Add(-s.idempotencyLock))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("repo.ReserveIdempotencyKey: %w", err)
+	}
+	if reserved {
+		return nil, now, nil
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, time.Time{}, domain.IdempotencyKeyReusedError(key)
+	}
+	if !existing.Completed {
+		return nil, time.Time{}, domain.IdempotencyInProgressError(key)
+	}
+	return &existing, time.Time{}, nil
+}
+
+// CompleteIdempotent сохраняет ответ выполненного запроса для повторов с тем же ключом. Запрос, чей ключ
+// за время выполнения занял повтор, ответ не сохраняет: записан будет ответ повтора
+func (s *PVZService) CompleteIdempotent(
+	ctx context.Context,
+	pvzID uint64,
+	key, method string,
+	claimedAt time.Time,
+	responseType string,
+	response []byte,
+) error {
+	rec := domain.IdempotencyRecord{
+		PVZID:        pvzID,
+		Key:          key,
+		Method:       method,
+		ResponseType: responseType,
+		Response:     response,
+		CreatedAt:    claimedAt,
+	}
+	stored, err := s.orderRepo.CompleteIdempotencyKey(ctx, rec)
+	if err != nil {
+		return fmt.Errorf("repo.CompleteIdempotencyKey: %w", err)
+	}
+	if !stored {
+		slog.Warn("Idempotent response dropped: key was taken over by a retry", "key", key, "method", method)
+	}
+	return nil
+}
+
+// AbortIdempotent освобождает ключ запроса, прерванного сбоем: повтор выполнится заново
+func (s *PVZService) AbortIdempotent(ctx context.Context, pvzID uint64, key, method string, claimedAt time.Time) error {
+	if err := s.orderRepo.ReleaseIdempotencyKey(ctx, pvzID, key, method, claimedAt); err != nil {
+		return fmt.Errorf("repo.ReleaseIdempotencyKey: %w", err)
+	}
+	return nil
+}
+
+// PurgeIdempotencyKeys удаляет ответы, срок хранения которых истек
+func (s *PVZService) PurgeIdempotencyKeys(ctx context.Context) (int64, error) {
+	purged, err := s.orderRepo.PurgeIdempotencyKeys(ctx, s.nowFn().Add(-s.idempotencyTTL))
+	if err != nil {
+		return 0, fmt.Errorf("repo.PurgeIdempotencyKeys: %w", err)
+	}
+	return purged, nil
+}
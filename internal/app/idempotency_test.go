@@ -0,0 +1,125 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+const (
+	someIdempotencyKey = "key-1"
+	someMethod         = "/orders.v2.OrdersService/ProcessOrders"
+)
+
+func TestPVZService_BeginIdempotent(t *testing.T) {
+	t.Parallel()
+
+	completed := domain.IdempotencyRecord{
+		Key:          someIdempotencyKey,
+		Method:       someMethod,
+		RequestHash:  "hash",
+		ResponseType: "orders.v2.ProcessResult",
+		Response:     []byte{1, 2, 3},
+		Completed:    true,
+	}
+
+	tests := []struct {
+		name     string
+		existing domain.IdempotencyRecord
+		reserved bool
+		want     *domain.IdempotencyRecord
+		wantAt   time.Time
+		assertE  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "Success_FirstRequest",
+			reserved: true,
+			wantAt:   someConstTime,
+			assertE:  assert.NoError,
+		},
+		{
+			name:     "Success_Replay",
+			existing: completed,
+			want:     &completed,
+			assertE:  assert.NoError,
+		},
+		{
+			name:     "Fail_DifferentPayload",
+			existing: domain.IdempotencyRecord{Key: someIdempotencyKey, RequestHash: "other", Completed: true},
+			assertE:  errIs(domain.IdempotencyKeyReusedError(someIdempotencyKey)),
+		},
+		{
+			name:     "Fail_InProgress",
+			existing: domain.IdempotencyRecord{Key: someIdempotencyKey, RequestHash: "hash"},
+			assertE:  errIs(domain.IdempotencyInProgressError(someIdempotencyKey)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			want := domain.IdempotencyRecord{
+				PVZID:       domain.DefaultPVZID,
+				Key:         someIdempotencyKey,
+				Method:      someMethod,
+				RequestHash: "hash",
+				CreatedAt:   someConstTime,
+			}
+			repo.ReserveIdempotencyKeyMock.
+				Expect(contextBack, want,
+					someConstTime.Add(-domain.DefaultIdempotencyTTL),
+					someConstTime.Add(-domain.DefaultIdempotencyLockTimeout)).
+				Return(tc.existing, tc.reserved, nil)
+
+			got, claimedAt, err := svc.BeginIdempotent(context.Background(), domain.DefaultPVZID, someIdempotencyKey, someMethod, "hash")
+			tc.assertE(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantAt, claimedAt)
+		})
+	}
+}
+
+func TestPVZService_CompleteIdempotent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		stored bool
+	}{
+		{"Success", true},
+		// запрос выполнялся дольше блокировки, и ключ занял повтор: ответ опоздавшего не записан, но это не ошибка
+		{"Success_LateAfterTakeover", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.CompleteIdempotencyKeyMock.Set(func(_ context.Context, rec domain.IdempotencyRecord) (bool, error) {
+				assert.Equal(t, domain.DefaultPVZID, rec.PVZID)
+				assert.Equal(t, someIdempotencyKey, rec.Key)
+				assert.Equal(t, someConstTime, rec.CreatedAt)
+				assert.Equal(t, "orders.v2.ProcessResult", rec.ResponseType)
+				assert.Equal(t, []byte{1}, rec.Response)
+				return tc.stored, nil
+			})
+
+			err := svc.CompleteIdempotent(context.Background(), domain.DefaultPVZID, someIdempotencyKey, someMethod,
+				someConstTime, "orders.v2.ProcessResult", []byte{1})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPVZService_AbortIdempotent(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	repo.ReleaseIdempotencyKeyMock.Expect(contextBack, domain.DefaultPVZID, someIdempotencyKey, someMethod, someConstTime).Return(nil)
+
+	assert.NoError(t, svc.AbortIdempotent(context.Background(), domain.DefaultPVZID, someIdempotencyKey, someMethod, someConstTime))
+}
@@ -0,0 +1,306 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/internal/workerpool"
+)
+
+// importJobChunk — сколько строк задачи обрабатывается и фиксируется за раз. Если сервис упал
+// посреди пачки, после перезапуска она проходит заново; при штатной остановке пачка дорабатывается до конца
+const importJobChunk = 100
+
+// JobPool — отдельный от запросов пул, на котором выполняются фоновые задачи импорта
+type JobPool interface {
+	TrySubmit(j workerpool.Job) bool
+}
+
+var errNoJobPool = errors.New("import job pool is not configured")
+
+func (s *PVZService) SetJobPool(pool JobPool) {
+	s.jobPool = pool
+}
+
+// newInstanceID называет экземпляр сервиса для владения задачами импорта. Случайный суффикс
+// отличает перезапущенный процесс от прежнего с тем же хостом и pid
+func newInstanceID() string {
+	host, _ := os.Hostname()
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(suffix))
+}
+
+// StartImport сохраняет заказы как задачу импорта и ставит ее в пул, не дожидаясь обработки
+func (s *PVZService) StartImport(ctx context.Context, orders []domain.OrderToImport) (domain.ImportJob, error) {
+	if s.jobPool == nil {
+		return domain.ImportJob{}, errNoJobPool
+	}
+	now := s.nowFn()
+	job := domain.ImportJob{
+		PVZID:     domain.PVZIDFromContext(ctx),
+		Status:    domain.ImportJobPending,
+		Owner:     s.instanceID,
+		Total:     uint64(len(orders)),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	job.Actor, _ = domain.ActorFromContext(ctx)
+
+	job, err := s.orderRepo.SaveImportJob(ctx, job, orders)
+	if err != nil {
+		return domain.ImportJob{}, fmt.Errorf("repo.SaveImportJob: %w", err)
+	}
+	// задача уже сохранена: если пул ее не принял, ее заберет ResumeImportJobs
+	if !s.submitImportJob(job) {
+		s.releaseImportJob(ctx, job.ID)
+		job.Owner = ""
+	}
+	return job, nil
+}
+
+func (s *PVZService) GetImportJob(ctx context.Context, id uint64) (domain.ImportJob, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	job, err := s.orderRepo.GetImportJob(ctx, id)
+	if err != nil {
+		return domain.ImportJob{}, fmt.Errorf("repo.GetImportJob: %w", err)
+	}
+	if job.PVZID != pvzID {
+		return domain.ImportJob{}, fmt.Errorf("validation: %w", domain.ValidationFailedError(
+			fmt.Sprintf("import job %d belongs to pickup point %d", id, job.PVZID)))
+	}
+	return job, nil
+}
+
+// CancelImportJob останавливает задачу, которая ждет или выполняется. Задачу на другом экземпляре
+// сервиса останавливает смена статуса: следующая пачка ее строк уже не будет зафиксирована
+func (s *PVZService) CancelImportJob(ctx context.Context, id uint64) (domain.ImportJob, error) {
+	job, err := s.GetImportJob(ctx, id)
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+	now := s.nowFn()
+	ok := false
+	if !job.Status.Finished() {
+		ok, err = s.orderRepo.UpdateImportJobStatus(ctx, id,
+			[]domain.ImportJobStatus{domain.ImportJobPending, domain.ImportJobRunning}, domain.ImportJobCancelled, "", now)
+		if err != nil {
+			return domain.ImportJob{}, fmt.Errorf("repo.UpdateImportJobStatus: %w", err)
+		}
+	}
+	if !ok {
+		return domain.ImportJob{}, fmt.Errorf("validation: %w", domain.ValidationFailedError(
+			fmt.Sprintf("import job %d is already finished", id)))
+	}
+	s.stopImportJob(id)
+
+	job.Status, job.UpdatedAt = domain.ImportJobCancelled, now
+	return job, nil
+}
+
+// ResumeImportJobs забирает задачи, которые никто не выполняет: их не успел начать или бросил
+// остановленный экземпляр. Задача ставится в пул, только если ее удалось закрепить за собой, поэтому
+// два экземпляра одну задачу не выполняют; выполнявшиеся продолжаются со строки после последней зафиксированной
+func (s *PVZService) ResumeImportJobs(ctx context.Context) (int, error) {
+	if s.jobPool == nil {
+		return 0, errNoJobPool
+	}
+	now := s.nowFn()
+	staleBefore := now.Add(-domain.ImportJobLeaseTTL)
+	jobs, err := s.orderRepo.ListUnfinishedImportJobs(ctx, staleBefore)
+	if err != nil {
+		return 0, fmt.Errorf("repo.ListUnfinishedImportJobs: %w", err)
+	}
+	resumed := 0
+	for _, job := range jobs {
+		if s.runsImportJob(job.ID) {
+			continue
+		}
+		ok, err := s.orderRepo.ClaimImportJob(ctx, job.ID, s.instanceID, now, staleBefore)
+		if err != nil {
+			return resumed, fmt.Errorf("repo.ClaimImportJob: %w", err)
+		}
+		if !ok {
+			continue
+		}
+		job.Owner = s.instanceID
+		if !s.submitImportJob(job) {
+			s.releaseImportJob(ctx, job.ID)
+			break
+		}
+		resumed++
+	}
+	return resumed, nil
+}
+
+// StopImportJobs останавливает задачи этого экземпляра при выключении сервиса и больше не принимает
+// новые в пул. Статус задач не меняется, и их продолжит ResumeImportJobs этого или другого экземпляра
+func (s *PVZService) StopImportJobs() {
+	s.importJobsMu.Lock()
+	defer s.importJobsMu.Unlock()
+	s.importJobsStopped = true
+	for id, cancel := range s.importJobs {
+		cancel()
+		delete(s.importJobs, id)
+	}
+}
+
+// submitImportJob ставит задачу в пул импорта. Возвращает false, если сервис останавливается
+// или очередь пула заполнена; задача, которую этот экземпляр уже выполняет, второй раз не ставится
+func (s *PVZService) submitImportJob(job domain.ImportJob) bool {
+	// задача живет дольше запроса, который ее создал: пункт и исполнитель берутся из самой задачи
+	ctx := domain.WithPVZID(context.Background(), job.PVZID)
+	if job.Actor.Type != "" {
+		ctx = domain.WithActor(ctx, job.Actor)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	s.importJobsMu.Lock()
+	defer s.importJobsMu.Unlock()
+	if s.importJobsStopped {
+		cancel()
+		return false
+	}
+	if _, ok := s.importJobs[job.ID]; ok {
+		cancel()
+		return true
+	}
+
+	submitted := s.jobPool.TrySubmit(workerpool.Job{
+		Ctx: ctx,
+		Run: func(ctx context.Context) (any, error) {
+			defer s.stopImportJob(job.ID)
+			err := s.runImportJob(ctx, job)
+			if err != nil {
+				slog.Error("Import job failed", "job_id", job.ID, "error", err)
+			}
+			return nil, err
+		},
+		Resp: make(chan workerpool.Response, 1),
+	})
+	if !submitted {
+		cancel()
+		return false
+	}
+	s.importJobs[job.ID] = cancel
+	return true
+}
+
+// releaseImportJob снимает с задачи владельца, чтобы ее сразу забрал другой экземпляр
+func (s *PVZService) releaseImportJob(ctx context.Context, id uint64) {
+	if err := s.orderRepo.ReleaseImportJob(ctx, id, s.instanceID); err != nil {
+		slog.Error("Import job release failed", "job_id", id, "error", err)
+	}
+}
+
+func (s *PVZService) runsImportJob(id uint64) bool {
+	s.importJobsMu.Lock()
+	defer s.importJobsMu.Unlock()
+	_, ok := s.importJobs[id]
+	return ok
+}
+
+func (s *PVZService) stopImportJob(id uint64) {
+	s.importJobsMu.Lock()
+	defer s.importJobsMu.Unlock()
+	if cancel, ok := s.importJobs[id]; ok {
+		cancel()
+		delete(s.importJobs, id)
+	}
+}
+
+// runImportJob закрепляет задачу за этим экземпляром, принимает ее строки пачками после LastRow
+// и фиксирует итог каждой пачки. Отмена ctx останавливает задачу между пачками
+func (s *PVZService) runImportJob(ctx context.Context, job domain.ImportJob) error {
+	// пока задача ждала в очереди, ее могли отменить или забрать другим экземпляром
+	now := s.nowFn()
+	ok, err := s.orderRepo.ClaimImportJob(ctx, job.ID, s.instanceID, now, now.Add(-domain.ImportJobLeaseTTL))
+	if err != nil {
+		return fmt.Errorf("repo.ClaimImportJob: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	lastRow := job.LastRow
+	for ctx.Err() == nil {
+		rows, err := s.orderRepo.ListImportJobRows(ctx, job.ID, lastRow, importJobChunk)
+		if err != nil {
+			return s.failImportJob(ctx, job.ID, fmt.Errorf("repo.ListImportJobRows: %w", err))
+		}
+		if len(rows) == 0 {
+			_, err := s.orderRepo.UpdateImportJobStatus(ctx, job.ID,
+				[]domain.ImportJobStatus{domain.ImportJobRunning}, domain.ImportJobCompleted, "", s.nowFn())
+			if err != nil {
+				return fmt.Errorf("repo.UpdateImportJobStatus: %w", err)
+			}
+			return nil
+		}
+
+		// начатая пачка дорабатывается и после отмены, иначе прерванные строки попали бы в отказы
+		chunkCtx := context.WithoutCancel(ctx)
+		results := processEach(chunkCtx, rows, s.workerLimit, func(ctx context.Context, row domain.ImportRow) domain.OrderResult {
+			return s.importJobRowResult(ctx, job.ID, row)
+		})
+
+		progress := domain.NewImportJobProgress(job.ID, rows, results, s.nowFn())
+		progress.Owner, progress.PrevRow = s.instanceID, lastRow
+		committed, err := s.orderRepo.CommitImportJobProgress(chunkCtx, progress)
+		if err != nil {
+			return s.failImportJob(chunkCtx, job.ID, fmt.Errorf("repo.CommitImportJobProgress: %w", err))
+		}
+		// задачу отменили или ее ведет другой исполнитель: его итог не перезаписываем
+		if !committed {
+			return nil
+		}
+		lastRow = progress.LastRow
+	}
+	return nil
+}
+
+// importJobRowResult принимает заказ строки задачи. Если сервис упал между приемкой и фиксацией пачки,
+// повтор строки получит «уже существует»; заказ, принятый именно этой строкой, считается принятым
+func (s *PVZService) importJobRowResult(ctx context.Context, jobID uint64, row domain.ImportRow) domain.OrderResult {
+	comment := domain.ImportJobRowComment(jobID, row.Row)
+	err := s.importSingle(ctx, row.Order, comment)
+	if domain.ErrorCodeOf(err) == domain.ErrorCodeAlreadyExists && s.acceptedWith(ctx, row.Order.OrderID, comment) {
+		err = nil
+	}
+	if err != nil {
+		return domain.FailedOrderResult(row.Order.OrderID, nil, err)
+	}
+	status := domain.StatusInStorage
+	return domain.OrderResult{OrderID: row.Order.OrderID, Status: &status}
+}
+
+// acceptedWith проверяет, что заказ был принят с записью истории comment
+func (s *PVZService) acceptedWith(ctx context.Context, orderID uint64, comment string) bool {
+	history, err := s.orderRepo.GetHistoryByOrderID(ctx, orderID)
+	if err != nil {
+		return false
+	}
+	for _, h := range history {
+		if h.Reason == domain.ReasonAccepted && h.Comment == comment {
+			return true
+		}
+	}
+	return false
+}
+
+// failImportJob останавливает задачу со статусом FAILED. Ошибка из-за отмены ctx сбоем не считается
+func (s *PVZService) failImportJob(ctx context.Context, id uint64, cause error) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	if _, err := s.orderRepo.UpdateImportJobStatus(ctx, id,
+		[]domain.ImportJobStatus{domain.ImportJobRunning}, domain.ImportJobFailed, cause.Error(), s.nowFn()); err != nil {
+		return fmt.Errorf("%w; repo.UpdateImportJobStatus: %v", cause, err)
+	}
+	return cause
+}
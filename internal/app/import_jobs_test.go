@@ -0,0 +1,278 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/internal/workerpool"
+)
+
+// queuePool складывает задачи в канал, тест сам решает, когда их выполнить
+type queuePool chan workerpool.Job
+
+func (p queuePool) TrySubmit(j workerpool.Job) bool {
+	select {
+	case p <- j:
+		return true
+	default:
+		return false
+	}
+}
+
+func acceptImportMocks(r *mock.OrderRepositoryMock) {
+	r.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
+	r.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+		if id == 2 {
+			return Stored(2, domain.StatusInStorage), nil
+		}
+		return domain.Order{}, domain.EntityNotFoundError("Order", fmt.Sprint(id))
+	})
+	r.GetPackageRulesMock.Set(func(_ context.Context, _ string) (domain.PackageRules, error) {
+		return bagRules, nil
+	})
+	r.OccupyCellMock.Return(domain.StorageCell{ID: 1, Code: "S-01"}, nil)
+	r.SaveMock.Set(func(_ context.Context, _ domain.Order) error { return nil })
+	r.SaveHistoryMock.Set(func(_ context.Context, _ domain.OrderHistory) error { return nil })
+	r.SavePickupCodeMock.Return(true, nil)
+	// заказ 2 принят не задачей импорта
+	r.GetHistoryByOrderIDMock.Optional().Return([]domain.OrderHistory{{OrderID: 2, Reason: domain.ReasonAccepted}}, nil)
+}
+
+func TestPVZService_StartImport_RunsJob(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+	pool := make(queuePool, 1)
+	svc.SetJobPool(pool)
+	acceptImportMocks(repo)
+
+	input := []domain.OrderToImport{DTO(1, "bag", 24*time.Hour), DTO(2, "bag", 24*time.Hour)}
+	repo.SaveImportJobMock.Set(func(_ context.Context, job domain.ImportJob, orders []domain.OrderToImport) (domain.ImportJob, error) {
+		assert.Equal(t, domain.ImportJobPending, job.Status)
+		assert.Equal(t, svc.instanceID, job.Owner)
+		assert.Equal(t, uint64(2), job.Total)
+		assert.Len(t, orders, 2)
+		job.ID = 10
+		return job, nil
+	})
+	repo.ListImportJobRowsMock.Set(func(_ context.Context, jobID, afterRow, _ uint64) ([]domain.ImportRow, error) {
+		if afterRow > 0 {
+			return nil, nil
+		}
+		return []domain.ImportRow{{Row: 1, Order: input[0]}, {Row: 2, Order: input[1]}}, nil
+	})
+	repo.ClaimImportJobMock.Set(func(_ context.Context, id uint64, owner string, _, _ time.Time) (bool, error) {
+		assert.Equal(t, uint64(10), id)
+		assert.Equal(t, svc.instanceID, owner)
+		return true, nil
+	})
+	var statuses []domain.ImportJobStatus
+	repo.UpdateImportJobStatusMock.Set(func(_ context.Context, id uint64, _ []domain.ImportJobStatus,
+		to domain.ImportJobStatus, _ string, _ time.Time) (bool, error) {
+		statuses = append(statuses, to)
+		return true, nil
+	})
+	var progress domain.ImportJobProgress
+	repo.CommitImportJobProgressMock.Set(func(_ context.Context, p domain.ImportJobProgress) (bool, error) {
+		progress = p
+		return true, nil
+	})
+
+	job, err := svc.StartImport(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), job.ID)
+
+	queued := <-pool
+	_, err = queued.Run(queued.Ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ImportJobStatus{domain.ImportJobCompleted}, statuses)
+	assert.Equal(t, svc.instanceID, progress.Owner)
+	assert.Equal(t, uint64(0), progress.PrevRow)
+	assert.Equal(t, uint64(2), progress.LastRow)
+	assert.Equal(t, uint64(1), progress.Imported)
+	assert.Equal(t, uint64(1), progress.Failed)
+	if assert.Len(t, progress.Failures, 1) {
+		assert.Equal(t, domain.ErrorCodeAlreadyExists, progress.Failures[0].ErrorCode)
+	}
+}
+
+// задача, которую пул не принял, остается ждать в базе без владельца
+func TestPVZService_StartImport_NotSubmitted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		setup func(*PVZService)
+	}{
+		{"QueueFull", func(svc *PVZService) { svc.SetJobPool(make(queuePool)) }},
+		{"ShuttingDown", func(svc *PVZService) {
+			svc.SetJobPool(make(queuePool, 1))
+			svc.StopImportJobs()
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			tc.setup(svc)
+			repo.SaveImportJobMock.Set(func(_ context.Context, job domain.ImportJob, _ []domain.OrderToImport) (domain.ImportJob, error) {
+				job.ID = 4
+				return job, nil
+			})
+			repo.ReleaseImportJobMock.Expect(context.Background(), 4, svc.instanceID).Return(nil)
+
+			job, err := svc.StartImport(context.Background(), []domain.OrderToImport{DTO(1, "bag", 24*time.Hour)})
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.ImportJobPending, job.Status)
+			assert.Empty(t, job.Owner)
+			assert.False(t, svc.runsImportJob(4))
+		})
+	}
+}
+
+func TestPVZService_RunImportJob_ResumesAndStopsWhenCancelled(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+	acceptImportMocks(repo)
+
+	repo.ClaimImportJobMock.Return(true, nil)
+	repo.ListImportJobRowsMock.Set(func(_ context.Context, _, afterRow, _ uint64) ([]domain.ImportRow, error) {
+		assert.Equal(t, uint64(100), afterRow)
+		return []domain.ImportRow{{Row: 101, Order: DTO(3, "bag", 24*time.Hour)}}, nil
+	})
+	// задачу отменили или пачку уже зафиксировал другой исполнитель: итог не пишется, и задача останавливается
+	repo.CommitImportJobProgressMock.Set(func(_ context.Context, p domain.ImportJobProgress) (bool, error) {
+		assert.Equal(t, uint64(100), p.PrevRow)
+		assert.Equal(t, uint64(101), p.LastRow)
+		return false, nil
+	})
+
+	err := svc.runImportJob(context.Background(), domain.ImportJob{ID: 7, LastRow: 100, Status: domain.ImportJobRunning})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), repo.ListImportJobRowsAfterCounter())
+}
+
+// сервис упал после приемки строки 101, но до фиксации пачки: повтор засчитывает ее как принятую
+func TestPVZService_RunImportJob_CountsRowsAcceptedBeforeCrash(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+	repo.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
+	repo.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+		return Stored(id, domain.StatusInStorage), nil
+	})
+	repo.GetHistoryByOrderIDMock.Set(func(_ context.Context, orderID uint64) ([]domain.OrderHistory, error) {
+		if orderID == 3 {
+			return []domain.OrderHistory{{OrderID: 3, Reason: domain.ReasonAccepted, Comment: "import job 7, row 101"}}, nil
+		}
+		return []domain.OrderHistory{{OrderID: orderID, Reason: domain.ReasonAccepted, Comment: "import job 7, row 1"}}, nil
+	})
+	repo.ClaimImportJobMock.Return(true, nil)
+	repo.ListImportJobRowsMock.Set(func(_ context.Context, _, afterRow, _ uint64) ([]domain.ImportRow, error) {
+		if afterRow > 100 {
+			return nil, nil
+		}
+		// строка 102 повторяет заказ, принятый другой строкой, и остается отказом
+		return []domain.ImportRow{
+			{Row: 101, Order: DTO(3, "bag", 24*time.Hour)},
+			{Row: 102, Order: DTO(4, "bag", 24*time.Hour)},
+		}, nil
+	})
+	var progress domain.ImportJobProgress
+	repo.CommitImportJobProgressMock.Set(func(_ context.Context, p domain.ImportJobProgress) (bool, error) {
+		progress = p
+		return true, nil
+	})
+	repo.UpdateImportJobStatusMock.Return(true, nil)
+
+	err := svc.runImportJob(context.Background(), domain.ImportJob{ID: 7, LastRow: 100, Status: domain.ImportJobRunning})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), progress.Imported)
+	assert.Equal(t, uint64(1), progress.Failed)
+	if assert.Len(t, progress.Failures, 1) {
+		assert.Equal(t, uint64(102), progress.Failures[0].Row)
+		assert.Equal(t, domain.ErrorCodeAlreadyExists, progress.Failures[0].ErrorCode)
+	}
+}
+
+func TestPVZService_RunImportJob_ClaimedElsewhere(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+
+	// пока задача ждала в очереди, ее забрал другой экземпляр: строки не читаются
+	repo.ClaimImportJobMock.Return(false, nil)
+
+	err := svc.runImportJob(context.Background(), domain.ImportJob{ID: 7, Status: domain.ImportJobPending})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), repo.ListImportJobRowsAfterCounter())
+}
+
+func TestPVZService_ResumeImportJobs_SubmitsOnlyClaimedJobs(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+	pool := make(queuePool, 2)
+	svc.SetJobPool(pool)
+
+	repo.ListUnfinishedImportJobsMock.Set(func(_ context.Context, staleBefore time.Time) ([]domain.ImportJob, error) {
+		assert.Equal(t, someConstTime.Add(-domain.ImportJobLeaseTTL), staleBefore)
+		return []domain.ImportJob{{ID: 1, PVZID: 1}, {ID: 2, PVZID: 1}}, nil
+	})
+	// вторую задачу между чтением списка и захватом забрал другой экземпляр
+	repo.ClaimImportJobMock.Set(func(_ context.Context, id uint64, owner string, at, _ time.Time) (bool, error) {
+		assert.Equal(t, svc.instanceID, owner)
+		assert.Equal(t, someConstTime, at)
+		return id == 1, nil
+	})
+
+	resumed, err := svc.ResumeImportJobs(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resumed)
+	assert.Len(t, pool, 1)
+	assert.True(t, svc.runsImportJob(1))
+	assert.False(t, svc.runsImportJob(2))
+}
+
+func TestPVZService_CancelImportJob(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  domain.ImportJobStatus
+		updated bool
+		want    domain.ImportJobStatus
+		assertE assert.ErrorAssertionFunc
+	}{
+		{"Running", domain.ImportJobRunning, true, domain.ImportJobCancelled, assert.NoError},
+		{"AlreadyCompleted", domain.ImportJobCompleted, false, 0, assert.Error},
+		{"FinishedMeanwhile", domain.ImportJobPending, false, 0, assert.Error},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.GetImportJobMock.Return(domain.ImportJob{ID: 3, PVZID: domain.DefaultPVZID, Status: tc.status}, nil)
+			repo.UpdateImportJobStatusMock.Optional().Return(tc.updated, nil)
+
+			job, err := svc.CancelImportJob(context.Background(), 3)
+
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, tc.want, job.Status)
+			} else {
+				assert.Equal(t, domain.ErrorCodeValidationFailed, domain.ErrorCodeOf(err))
+			}
+		})
+	}
+}
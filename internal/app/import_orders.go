@@ -3,31 +3,218 @@ package app
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 )
 
-func (s *PVZService) importSingle(ctx context.Context, raw domain.OrderToImport) error {
+func importRequest(raw domain.OrderToImport) (domain.AcceptOrderRequest, error) {
 	storageUntil, err := cli.MapStringToTime(raw.StorageUntil)
 	if err != nil {
-		return fmt.Errorf("time.Parse: %w", err)
+		return domain.AcceptOrderRequest{}, fmt.Errorf("time.Parse: %w", err)
 	}
-	req := domain.AcceptOrderRequest{
-		ReceiverID:   raw.ReceiverID,
-		OrderID:      raw.OrderID,
-		StorageUntil: storageUntil,
-		Weight:       raw.Weight,
-		Price:        raw.Price,
-		PackageType:  raw.PackageType,
+	return domain.AcceptOrderRequest{
+		ReceiverID:     raw.ReceiverID,
+		OrderID:        raw.OrderID,
+		StorageUntil:   storageUntil,
+		Weight:         raw.Weight,
+		Price:          raw.Price,
+		PackageType:    raw.PackageType,
+		SellerID:       raw.SellerID,
+		CashOnDelivery: raw.CashOnDelivery,
+	}, nil
+}
+
+func (s *PVZService) importSingle(ctx context.Context, raw domain.OrderToImport, comment string) error {
+	req, err := importRequest(raw)
+	if err != nil {
+		return err
 	}
+	req.Comment = comment
 	_, err = s.AcceptOrder(ctx, req)
 	return err
 }
 
-func (s *PVZService) ImportOrders(
+func (s *PVZService) importResult(ctx context.Context, raw domain.OrderToImport) domain.OrderResult {
+	if err := s.importSingle(ctx, raw, ""); err != nil {
+		return domain.FailedOrderResult(raw.OrderID, nil, err)
+	}
+	status := domain.StatusInStorage
+	return domain.OrderResult{OrderID: raw.OrderID, Status: &status}
+}
+
+// validateResult проводит заказ через проверки AcceptOrder, ничего не записывая;
+// у прошедшего проверку заказа статус не задан, потому что заказ не создан
+func (s *PVZService) validateResult(ctx context.Context, raw domain.OrderToImport) domain.OrderResult {
+	req, err := importRequest(raw)
+	if err == nil {
+		_, err = s.buildOrder(ctx, req, domain.StatusInStorage)
+	}
+	if err != nil {
+		return domain.FailedOrderResult(raw.OrderID, nil, err)
+	}
+	return domain.OrderResult{OrderID: raw.OrderID}
+}
+
+// duplicateImportError — ошибка повторной строки с уже встреченным в файле заказом.
+// При настоящем импорте такую строку отклонит проверка уникальности, а при проверке без записи
+// первая строка в базу не попадает, поэтому повторы ищем сами
+func duplicateImportError(orderID uint64) error {
+	return fmt.Errorf("validation: %w", domain.OrderAlreadyExistsError(orderID))
+}
+
+// ImportOrders принимает каждый заказ независимо и возвращает результат по каждому
+func (s *PVZService) ImportOrders(ctx context.Context, orders []domain.OrderToImport) []domain.OrderResult {
+	return processEach(ctx, orders, s.workerLimit, s.importResult)
+}
+
+// ValidateImportOrders проверяет заказы так же, как ImportOrders, но ничего не записывает.
+// Возвращает результат по каждому заказу: прошел он проверку или почему был бы отклонен
+func (s *PVZService) ValidateImportOrders(ctx context.Context, orders []domain.OrderToImport) []domain.OrderResult {
+	type item struct {
+		order     domain.OrderToImport
+		duplicate bool
+	}
+	items := make([]item, len(orders))
+	seen := make(map[uint64]struct{}, len(orders))
+	for i, order := range orders {
+		_, dup := seen[order.OrderID]
+		seen[order.OrderID] = struct{}{}
+		items[i] = item{order: order, duplicate: dup}
+	}
+	return processEach(ctx, items, s.workerLimit, func(ctx context.Context, it item) domain.OrderResult {
+		if it.duplicate {
+			return domain.FailedOrderResult(it.order.OrderID, nil, duplicateImportError(it.order.OrderID))
+		}
+		return s.validateResult(ctx, it.order)
+	})
+}
+
+// ImportOrdersStream принимает заказы из rows по мере поступления, не дожидаясь конца потока,
+// и отдает результат каждой строки в emit. Одновременно обрабатывается не больше workerLimit строк,
+// emit не вызывается параллельно. Ошибка emit останавливает импорт: уже начатые строки дорабатываются,
+// а остаток rows не читается
+func (s *PVZService) ImportOrdersStream(
+	ctx context.Context,
+	rows <-chan domain.ImportRow,
+	emit func(domain.ImportRowResult) error,
+) error {
+	return s.importStream(ctx, rows, s.importResult, emit)
+}
+
+// ValidateImportOrdersStream проверяет строки потока так же, как ImportOrdersStream, но ничего не записывает.
+// Заказ, уже встреченный в потоке раньше, отклоняется как повтор
+func (s *PVZService) ValidateImportOrdersStream(
 	ctx context.Context,
-	orders []domain.OrderToImport,
-) (uint64, error) {
-	return processConcurrently(ctx, orders, s.workerLimit, s.importSingle)
+	rows <-chan domain.ImportRow,
+	emit func(domain.ImportRowResult) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	return s.importStream(ctx, markDuplicateRows(ctx, rows), s.validateResult, emit)
+}
+
+// markDuplicateRows помечает ошибкой строки с заказом, встреченным в потоке раньше.
+// Строки читаются по порядку, поэтому повтором всегда считается более поздняя строка
+func markDuplicateRows(ctx context.Context, rows <-chan domain.ImportRow) <-chan domain.ImportRow {
+	out := make(chan domain.ImportRow)
+	go func() {
+		defer close(out)
+		seen := make(map[uint64]struct{})
+		for {
+			var (
+				row domain.ImportRow
+				ok  bool
+			)
+			select {
+			case row, ok = <-rows:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+			if row.Err == nil {
+				if _, dup := seen[row.Order.OrderID]; dup {
+					row.Err = duplicateImportError(row.Order.OrderID)
+				}
+				seen[row.Order.OrderID] = struct{}{}
+			}
+			select {
+			case out <- row:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (s *PVZService) importStream(
+	ctx context.Context,
+	rows <-chan domain.ImportRow,
+	handle func(context.Context, domain.OrderToImport) domain.OrderResult,
+	emit func(domain.ImportRowResult) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		emitErr error
+	)
+	sem := make(chan struct{}, s.workerLimit)
+	report := func(res domain.ImportRowResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		if emitErr != nil {
+			return
+		}
+		if err := emit(res); err != nil {
+			emitErr = err
+			cancel()
+		}
+	}
+
+loop:
+	for {
+		var (
+			row domain.ImportRow
+			ok  bool
+		)
+		select {
+		case row, ok = <-rows:
+			if !ok {
+				break loop
+			}
+		case <-ctx.Done():
+			break loop
+		}
+		if row.Err != nil {
+			report(domain.ImportRowResult{Row: row.Row, Result: domain.FailedOrderResult(row.Order.OrderID, nil, row.Err)})
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				<-sem
+			}()
+			report(domain.ImportRowResult{Row: row.Row, Result: handle(ctx, row.Order)})
+		}()
+	}
+	wg.Wait()
+
+	if emitErr != nil {
+		return emitErr
+	}
+	return ctx.Err()
 }
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,7 +14,7 @@ import (
 )
 
 var (
-	bagRules = []domain.PackageRules{{MaxWeight: 10, Price: 5}}
+	bagRules = domain.PackageRules{MaxWeight: 10 * domain.Kilogram, Price: 5 * domain.Ruble}
 	errDB    = errors.New("db err")
 )
 
@@ -34,14 +35,17 @@ func TestPVZService_ImportOrders(t *testing.T) {
 				DTO(2, "bag", 24*time.Hour),
 			},
 			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				r.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
 				r.GetByIDMock.Set(func(_ context.Context, _ uint64) (domain.Order, error) {
 					return domain.Order{}, domain.EntityNotFoundError("Order", "x")
 				})
-				r.GetPackageRulesMock.Set(func(_ context.Context, _ string) ([]domain.PackageRules, error) {
+				r.GetPackageRulesMock.Set(func(_ context.Context, _ string) (domain.PackageRules, error) {
 					return bagRules, nil
 				})
+				r.OccupyCellMock.Return(domain.StorageCell{ID: 1, Code: "S-01"}, nil)
 				r.SaveMock.Set(func(_ context.Context, _ domain.Order) error { return nil })
 				r.SaveHistoryMock.Set(func(_ context.Context, _ domain.OrderHistory) error { return nil })
+				r.SavePickupCodeMock.Return(true, nil)
 			},
 			wantImported: 2,
 			assertE:      assert.NoError,
@@ -50,13 +54,22 @@ func TestPVZService_ImportOrders(t *testing.T) {
 			name:  "Fail_SaveError",
 			input: []domain.OrderToImport{DTO(6, "bag", 24*time.Hour)},
 			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				r.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
 				r.GetByIDMock.Set(func(_ context.Context, _ uint64) (domain.Order, error) {
 					return domain.Order{}, domain.EntityNotFoundError("Order", "6")
 				})
-				r.GetPackageRulesMock.Set(func(_ context.Context, _ string) ([]domain.PackageRules, error) {
+				r.GetPackageRulesMock.Set(func(_ context.Context, _ string) (domain.PackageRules, error) {
 					return bagRules, nil
 				})
+				r.OccupyCellMock.Return(domain.StorageCell{ID: 1, Code: "S-01"}, nil)
+				r.SavePickupCodeMock.Return(true, nil)
 				r.SaveMock.Set(func(_ context.Context, _ domain.Order) error { return errDB })
+				r.ReleaseCellMock.Set(func(_ context.Context, cellID uint64) error {
+					if cellID != 1 {
+						return fmt.Errorf("unexpected cell %d", cellID)
+					}
+					return nil
+				})
 			},
 			wantImported: 0,
 			assertE:      errIs(errDB),
@@ -70,9 +83,135 @@ func TestPVZService_ImportOrders(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 			tc.setup(repo, ctx)
-			got, err := svc.ImportOrders(ctx, tc.input)
-			assert.Equal(t, tc.wantImported, got)
-			tc.assertE(t, err)
+			results := svc.ImportOrders(ctx, tc.input)
+			assert.Equal(t, tc.wantImported, uint64(len(domain.SucceededOrders(results))))
+			tc.assertE(t, resultsErr(results, nil))
 		})
 	}
 }
+
+func TestPVZService_ImportOrdersStream(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+	repo.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
+	repo.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+		return domain.Order{}, domain.EntityNotFoundError("Order", fmt.Sprint(id))
+	})
+	repo.GetPackageRulesMock.Set(func(_ context.Context, _ string) (domain.PackageRules, error) {
+		return bagRules, nil
+	})
+	repo.OccupyCellMock.Return(domain.StorageCell{ID: 1, Code: "S-01"}, nil)
+	repo.SaveMock.Set(func(_ context.Context, _ domain.Order) error { return nil })
+	repo.SaveHistoryMock.Set(func(_ context.Context, _ domain.OrderHistory) error { return nil })
+	repo.SavePickupCodeMock.Return(true, nil)
+
+	rows := make(chan domain.ImportRow, 3)
+	rows <- domain.ImportRow{Row: 1, Order: DTO(1, "bag", 24*time.Hour)}
+	rows <- domain.ImportRow{Row: 2, Order: domain.OrderToImport{OrderID: 2},
+		Err: domain.ValidationFailedError("weight: bad value")}
+	rows <- domain.ImportRow{Row: 3, Order: DTO(3, "bag", 24*time.Hour)}
+	close(rows)
+
+	got := make(map[uint64]domain.OrderResult)
+	err := svc.ImportOrdersStream(context.Background(), rows, func(res domain.ImportRowResult) error {
+		got[res.Row] = res.Result
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 3)
+	assert.True(t, got[1].OK())
+	assert.True(t, got[3].OK())
+	assert.Equal(t, uint64(2), got[2].OrderID)
+	assert.Equal(t, domain.ErrorCodeValidationFailed, got[2].ErrorCode())
+}
+
+func TestPVZService_ImportOrdersStream_EmitError(t *testing.T) {
+	t.Parallel()
+	_, svc := NewEnv(t)
+
+	rows := make(chan domain.ImportRow, 2)
+	rows <- domain.ImportRow{Row: 1, Err: domain.ValidationFailedError("bad row")}
+	rows <- domain.ImportRow{Row: 2, Err: domain.ValidationFailedError("bad row")}
+	close(rows)
+
+	errSend := errors.New("send failed")
+	var emitted int
+	err := svc.ImportOrdersStream(context.Background(), rows, func(domain.ImportRowResult) error {
+		emitted++
+		return errSend
+	})
+
+	assert.ErrorIs(t, err, errSend)
+	assert.Equal(t, 1, emitted)
+}
+
+func TestPVZService_ValidateImportOrders(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+	repo.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
+	repo.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+		if id == 2 {
+			return Stored(2, domain.StatusInStorage), nil
+		}
+		return domain.Order{}, domain.EntityNotFoundError("Order", fmt.Sprint(id))
+	})
+	repo.GetPackageRulesMock.Set(func(_ context.Context, code string) (domain.PackageRules, error) {
+		if code != "bag" {
+			return domain.PackageRules{}, domain.InvalidPackageError(code)
+		}
+		return bagRules, nil
+	})
+
+	heavy := DTO(5, "bag", 24*time.Hour)
+	heavy.Weight = 12 * domain.Kilogram
+	input := []domain.OrderToImport{
+		DTO(1, "bag", 24*time.Hour),
+		DTO(2, "bag", 24*time.Hour),
+		DTO(3, "crate", 24*time.Hour),
+		DTO(4, "bag", -24*time.Hour),
+		heavy,
+		DTO(1, "bag", 48*time.Hour),
+	}
+
+	results := svc.ValidateImportOrders(context.Background(), input)
+
+	assert.Len(t, results, len(input))
+	assert.True(t, results[0].OK())
+	assert.Nil(t, results[0].Status)
+	assert.Equal(t, domain.ErrorCodeAlreadyExists, results[1].ErrorCode())
+	assert.Equal(t, domain.ErrorCodeInvalidPackage, results[2].ErrorCode())
+	assert.Equal(t, domain.ErrorCodeStorageDateInPast, results[3].ErrorCode())
+	assert.Equal(t, domain.ErrorCodeWeightTooHeavy, results[4].ErrorCode())
+	assert.Equal(t, uint64(1), results[5].OrderID)
+	assert.Equal(t, domain.ErrorCodeAlreadyExists, results[5].ErrorCode())
+}
+
+func TestPVZService_ValidateImportOrdersStream_Duplicates(t *testing.T) {
+	t.Parallel()
+	repo, svc := NewEnv(t)
+	repo.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
+	repo.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+		return domain.Order{}, domain.EntityNotFoundError("Order", fmt.Sprint(id))
+	})
+	repo.GetPackageRulesMock.Set(func(_ context.Context, _ string) (domain.PackageRules, error) {
+		return bagRules, nil
+	})
+
+	rows := make(chan domain.ImportRow, 3)
+	rows <- domain.ImportRow{Row: 1, Order: DTO(7, "bag", 24*time.Hour)}
+	rows <- domain.ImportRow{Row: 2, Order: DTO(8, "bag", 24*time.Hour)}
+	rows <- domain.ImportRow{Row: 3, Order: DTO(7, "bag", 24*time.Hour)}
+	close(rows)
+
+	got := make(map[uint64]domain.OrderResult)
+	err := svc.ValidateImportOrdersStream(context.Background(), rows, func(res domain.ImportRowResult) error {
+		got[res.Row] = res.Result
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, got[1].OK())
+	assert.True(t, got[2].OK())
+	assert.Equal(t, domain.ErrorCodeAlreadyExists, got[3].ErrorCode())
+}
@@ -5,90 +5,202 @@ import (
 	"fmt"
 	"time"
 
-	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
-func (s *PVZService) issueSingle(ctx context.Context, receiverID uint64, orderID uint64, now time.Time) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
-	if err != nil {
-		return fmt.Errorf("repo.GetByID: %w", err)
-	}
+// issuePlan — проверенная выдача одного заказа, готовая к записи
+type issuePlan struct {
+	order  domain.Order
+	cellID uint64
+	fee    domain.StorageFee
+	hist   domain.OrderHistory
+	event  domain.Event
+}
 
-	if order.ReceiverID != receiverID {
-		return domain.BelongsToDifferentReceiverError(orderID, receiverID, order.ReceiverID)
+// planIssue проверяет, что прочитанный заказ можно выдать, и готовит все изменения, ничего не записывая
+func (s *PVZService) planIssue(ctx context.Context, order domain.Order, receiverID uint64, now time.Time) (issuePlan, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	orderID := order.OrderID
+	if order.PVZID != pvzID {
+		return issuePlan{}, domain.BelongsToDifferentPVZError(orderID, pvzID, order.PVZID)
 	}
-	if order.Status == domain.StatusGivenToClient {
-		return domain.OrderAlreadyGivenError(orderID)
-	}
-	if order.Status == domain.StatusReturnedFromClient {
-		return domain.UnavaliableReturnedOrderError(orderID)
+	if order.ReceiverID != receiverID {
+		return issuePlan{}, domain.BelongsToDifferentReceiverError(orderID, receiverID, order.ReceiverID)
 	}
-	if now.After(order.StorageUntil) {
-		return domain.StorageExpiredError(orderID, cli.MapTimeToString(order.StorageUntil))
+	next, err := s.checkAction(ctx, order, domain.ActionIssue, now)
+	if err != nil {
+		return issuePlan{}, err
 	}
 
-	order.Status = domain.StatusGivenToClient
+	// плату считаем до смены статуса: она зависит от срока хранения с момента приемки
+	fee := s.storageFees.Charge(order, now)
+
+	cellID := order.CellID
+	prev := order.Status
+	order.Status = next
 	order.LastUpdateTime = now
+	order.CellID, order.CellCode = 0, ""
 
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeClient, ID: receiverID})
 	hist := domain.OrderHistory{
-		OrderID:   orderID,
-		Status:    domain.StatusGivenToClient,
-		ChangedAt: now,
+		OrderID:    orderID,
+		PVZID:      pvzID,
+		PrevStatus: &prev,
+		Status:     next,
+		ChangedAt:  now,
+		Actor:      actor,
+		Reason:     domain.ReasonIssued,
+		Comment:    issueComment(fee),
 	}
 
 	event := domain.NewEvent(
 		domain.EventTypeOrderIssued,
-		domain.Actor{
-			Type: domain.ActorTypeClient,
-			ID:   receiverID,
-		},
+		pvzID,
+		actor,
 		domain.OrderInfo{
-			ID:     orderID,
-			UserID: receiverID,
-			Status: "issued",
+			ID:             orderID,
+			UserID:         receiverID,
+			Status:         "issued",
+			StorageFee:     fee.Amount,
+			StorageFeeDays: fee.PaidDays,
 		},
 	)
-	if s.dbClient == nil {
-		if err := s.orderRepo.Update(ctx, order); err != nil {
-			return fmt.Errorf("failed to update order: %w", err)
-		}
-		history := domain.OrderHistory{
-			OrderID:   order.OrderID,
-			Status:    order.Status,
-			ChangedAt: order.AcceptTime,
+	return issuePlan{order: order, cellID: cellID, fee: fee, hist: hist, event: event}, nil
+}
+
+func (p issuePlan) result() domain.OrderResult {
+	res := domain.OrderResult{OrderID: p.order.OrderID, Status: &p.order.Status}
+	// бесплатная выдача платы не несет
+	if p.fee.Amount > 0 {
+		res.StorageFee = p.fee
+	}
+	return res
+}
+
+func (s *PVZService) applyIssue(ctx context.Context, p issuePlan) error {
+	if err := s.orderRepo.Update(ctx, p.order); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+	if p.cellID != 0 {
+		if err := s.orderRepo.ReleaseCell(ctx, p.cellID); err != nil {
+			return fmt.Errorf("failed to release cell: %w", err)
 		}
-		return s.orderRepo.SaveHistory(ctx, history)
 	}
-	return s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
-		if err := s.orderRepo.UpdateOrderInTx(ctx, tx, order); err != nil {
-			return fmt.Errorf("update order: %w", err)
+	if err := s.orderRepo.SaveHistory(ctx, p.hist); err != nil {
+		return err
+	}
+	if p.fee.Amount > 0 {
+		if err := s.orderRepo.SaveStorageFee(ctx, p.fee); err != nil {
+			return fmt.Errorf("repo.SaveStorageFee: %w", err)
 		}
+	}
+	return nil
+}
 
-		if err := s.orderRepo.SaveHistoryInTx(ctx, tx, hist); err != nil {
-			return fmt.Errorf("save history: %w", err)
+func (s *PVZService) applyIssueInTx(ctx context.Context, tx *db.Tx, p issuePlan) error {
+	if err := s.orderRepo.UpdateOrderInTx(ctx, tx, p.order); err != nil {
+		return fmt.Errorf("update order: %w", err)
+	}
+
+	if p.cellID != 0 {
+		if err := s.orderRepo.ReleaseCellInTx(ctx, tx, p.cellID); err != nil {
+			return fmt.Errorf("release cell: %w", err)
 		}
+	}
 
-		if err := s.outboxRepo.Save(ctx, tx, event); err != nil {
-			return fmt.Errorf("save event: %w", err)
+	if err := s.orderRepo.SaveHistoryInTx(ctx, tx, p.hist); err != nil {
+		return fmt.Errorf("save history: %w", err)
+	}
+
+	if p.fee.Amount > 0 {
+		if err := s.orderRepo.SaveStorageFeeInTx(ctx, tx, p.fee); err != nil {
+			return fmt.Errorf("save storage fee: %w", err)
 		}
+	}
 
-		return nil
-	})
+	if err := s.saveEvent(ctx, tx, p.event); err != nil {
+		return fmt.Errorf("save event: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PVZService) issueSingle(ctx context.Context, receiverID uint64, orderID uint64, now time.Time) domain.OrderResult {
+	plan := func(order domain.Order) (issuePlan, error) {
+		return s.planIssue(ctx, order, receiverID, now)
+	}
+	return processOne(ctx, s, orderID, plan, s.applyIssue, s.applyIssueInTx)
 }
 
+// IssueOrdersToClient выдает каждый заказ независимо и возвращает результат по каждому.
+// Ошибка возвращается, только если не прошла проверка кода выдачи
 func (s *PVZService) IssueOrdersToClient(
 	ctx context.Context,
 	receiverID uint64,
 	orderIDs []uint64,
-) error {
-	processed, err := processConcurrently(ctx, orderIDs, s.workerLimit, func(c context.Context, id uint64) error {
-		return s.issueSingle(c, receiverID, id, s.nowFn())
+	pickupCode string,
+) ([]domain.OrderResult, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	if err := s.verifyPickupCode(ctx, pvzID, receiverID, pickupCode, s.nowFn()); err != nil {
+		return nil, err
+	}
+
+	results := processEach(ctx, orderIDs, s.workerLimit, func(c context.Context, id uint64) domain.OrderResult {
+		var res domain.OrderResult
+		// заказ мог выдать или вернуть другой оператор: повтор перечитает его и проверит заново
+		_ = retryOnConflict(c, func() error {
+			res = s.issueSingle(c, receiverID, id, s.nowFn())
+			return res.Err
+		})
+		return res
 	})
+	s.finishIssue(ctx, pvzID, receiverID, results)
+	return results, nil
+}
+
+// IssueOrdersToClientAtomic выдает заказы по принципу «все или ничего»: сначала проверяет каждый,
+// затем записывает всю выдачу одной транзакцией. Если хотя бы один заказ не проходит, не выдается ни один
+func (s *PVZService) IssueOrdersToClientAtomic(
+	ctx context.Context,
+	receiverID uint64,
+	orderIDs []uint64,
+	pickupCode string,
+) ([]domain.OrderResult, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	// ошибку в списке ловим до проверки кода, чтобы не тратить попытки ввода
+	if err := checkDistinctOrders(orderIDs); err != nil {
+		return nil, err
+	}
+	if err := s.verifyPickupCode(ctx, pvzID, receiverID, pickupCode, s.nowFn()); err != nil {
+		return nil, err
+	}
+
+	plan := func(order domain.Order, now time.Time) (issuePlan, error) {
+		return s.planIssue(ctx, order, receiverID, now)
+	}
+	results, err := processAtomically(ctx, s, orderIDs, plan, s.applyIssue, s.applyIssueInTx)
+	if err != nil {
+		return nil, err
+	}
+	s.finishIssue(ctx, pvzID, receiverID, results)
+	return results, nil
+}
+
+func (s *PVZService) finishIssue(ctx context.Context, pvzID, receiverID uint64, results []domain.OrderResult) {
+	issued := uint64(len(domain.SucceededOrders(results)))
+	s.metricsProvider.OrdersIssued(issued)
+	s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, pvzID)
 
-	s.metricsProvider.OrdersIssued(processed)
-	s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo)
+	// заказы уже выданы; если код не удалось погасить, его заменит следующая приемка
+	if issued > 0 {
+		_ = s.releasePickupCode(ctx, pvzID, receiverID)
+	}
+}
 
-	return err
+func issueComment(fee domain.StorageFee) string {
+	if fee.Amount == 0 {
+		return ""
+	}
+	return fmt.Sprintf("storage fee %s for %d days", fee.Amount, fee.PaidDays)
 }
@@ -53,6 +53,8 @@ func TestPVZService_IssueOrdersToClient(t *testing.T) {
 					}
 					return fmt.Errorf("unexpected history %d", h.OrderID)
 				})
+				r.GetByReceiverIDMock.Return(nil, nil)
+				r.DeletePickupCodeMock.Expect(ctx, domain.DefaultPVZID, someRecieverID).Return(nil)
 			},
 			assertE: assert.NoError,
 		},
@@ -84,6 +86,21 @@ func TestPVZService_IssueOrdersToClient(t *testing.T) {
 			},
 			assertE: errIs(domain.BelongsToDifferentReceiverError(4, someRecieverID, 999)),
 		},
+		{
+			name:     "Fail_BelongsToDifferentPVZ",
+			orderIDs: []uint64{9},
+			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				r.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+					if id == 9 {
+						other := OrderInStorage(9, 24*time.Hour)
+						other.PVZID = 7
+						return other, nil
+					}
+					return domain.Order{}, fmt.Errorf("unexpected id %d", id)
+				})
+			},
+			assertE: errIs(domain.BelongsToDifferentPVZError(9, domain.DefaultPVZID, 7)),
+		},
 		{
 			name:     "Fail_AlreadyGiven",
 			orderIDs: []uint64{5},
@@ -95,7 +112,7 @@ func TestPVZService_IssueOrdersToClient(t *testing.T) {
 					return domain.Order{}, fmt.Errorf("unexpected id %d", id)
 				})
 			},
-			assertE: errIs(domain.OrderAlreadyGivenError(5)),
+			assertE: errIs(domain.InvalidTransitionError(5, "Given to client", "issue")),
 		},
 		{
 			name:     "Fail_ReturnedOrderUnavailable",
@@ -108,7 +125,7 @@ func TestPVZService_IssueOrdersToClient(t *testing.T) {
 					return domain.Order{}, fmt.Errorf("unexpected id %d", id)
 				})
 			},
-			assertE: errIs(domain.UnavaliableReturnedOrderError(6)),
+			assertE: errIs(domain.InvalidTransitionError(6, "Returned from client", "issue")),
 		},
 		{
 			name:     "Fail_UpdateError",
@@ -162,10 +179,329 @@ func TestPVZService_IssueOrdersToClient(t *testing.T) {
 			repo, svc := NewEnv(t)
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
+			ValidPickupCode(repo)
 			tc.setup(repo, ctx)
 
-			err := svc.IssueOrdersToClient(ctx, someRecieverID, tc.orderIDs)
-			tc.assertE(t, err)
+			tc.assertE(t, resultsErr(svc.IssueOrdersToClient(ctx, someRecieverID, tc.orderIDs, somePickupCode)))
+		})
+	}
+}
+
+func TestPVZService_IssueOrdersToClient_PickupCode(t *testing.T) {
+	t.Parallel()
+
+	lockUntil := someConstTime.Add(domain.DefaultPickupCodePolicy.LockoutDuration)
+
+	tests := []struct {
+		name    string
+		code    string
+		setup   func(*mock.OrderRepositoryMock)
+		assertE assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Fail_CodeMissing",
+			code:    "",
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: errIs(domain.PickupCodeRequiredError(someRecieverID)),
+		},
+		{
+			name: "Fail_NoCodeIssued",
+			code: somePickupCode,
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetPickupCodeMock.Return(domain.PickupCode{}, domain.EntityNotFoundError("PickupCode", "100"))
+			},
+			assertE: errIs(domain.InvalidPickupCodeError(someRecieverID)),
+		},
+		{
+			name: "Fail_WrongCode",
+			code: "000000",
+			setup: func(r *mock.OrderRepositoryMock) {
+				ValidPickupCode(r)
+				r.RegisterPickupCodeFailureMock.
+					Expect(contextBack, domain.DefaultPVZID, someRecieverID, domain.DefaultPickupCodePolicy.MaxAttempts, lockUntil).
+					Return(StoredPickupCode(1, time.Time{}), nil)
+			},
+			assertE: errIs(domain.InvalidPickupCodeError(someRecieverID)),
+		},
+		{
+			name: "Fail_WrongCodeLocks",
+			code: "000000",
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetPickupCodeMock.Return(StoredPickupCode(domain.DefaultPickupCodePolicy.MaxAttempts-1, time.Time{}), nil)
+				r.RegisterPickupCodeFailureMock.Return(StoredPickupCode(0, lockUntil), nil)
+			},
+			assertE: errIs(domain.PickupCodeLockedError(someRecieverID, DateString(domain.DefaultPickupCodePolicy.LockoutDuration))),
+		},
+		{
+			name: "Fail_LockedEvenWithRightCode",
+			code: somePickupCode,
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetPickupCodeMock.Return(StoredPickupCode(0, someConstTime.Add(time.Minute)), nil)
+			},
+			assertE: errIs(domain.PickupCodeLockedError(someRecieverID, DateString(time.Minute))),
+		},
+		{
+			name: "Success_ResetsFailuresAndKeepsCodeForRemainingOrders",
+			code: somePickupCode,
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetPickupCodeMock.Return(StoredPickupCode(2, someConstTime.Add(-time.Minute)), nil)
+				r.ResetPickupCodeFailuresMock.Expect(contextBack, domain.DefaultPVZID, someRecieverID).Return(nil)
+				r.GetByIDMock.Return(OrderInStorage(1, 24*time.Hour), nil)
+				r.UpdateMock.Return(nil)
+				r.SaveHistoryMock.Return(nil)
+				r.GetByReceiverIDMock.Return([]domain.Order{OrderInStorage(2, 24*time.Hour)}, nil)
+			},
+			assertE: assert.NoError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			tc.setup(repo)
+
+			tc.assertE(t, resultsErr(svc.IssueOrdersToClient(context.Background(), someRecieverID, []uint64{1}, tc.code)))
+		})
+	}
+}
+
+func TestPVZService_IssueOrdersToClient_StorageFee(t *testing.T) {
+	t.Parallel()
+
+	policy := domain.StorageFeePolicy{
+		FreeDays:      3,
+		DefaultPerDay: 10 * domain.Ruble,
+		Rates:         []domain.StorageFeeRate{{PackageType: "box", PerDay: 20 * domain.Ruble}},
+	}
+	acceptedAgo := func(id uint64, packageType string, stored time.Duration) domain.Order {
+		o := OrderInStorage(id, 24*time.Hour)
+		o.AcceptTime = someConstTime.Add(-stored)
+		o.PackageType = packageType
+		return o
+	}
+
+	tests := []struct {
+		name     string
+		order    domain.Order
+		wantFees []domain.StorageFee
+	}{
+		{
+			name:  "Free_WithinGracePeriod",
+			order: acceptedAgo(1, "bag", 72*time.Hour),
+		},
+		{
+			name:  "Paid_StartedDayCounts",
+			order: acceptedAgo(2, "bag", 73*time.Hour),
+			wantFees: []domain.StorageFee{{
+				OrderID: 2, PVZID: domain.DefaultPVZID, ReceiverID: someRecieverID,
+				PaidDays: 1, DailyRate: 10 * domain.Ruble, Amount: 10 * domain.Ruble, ChargedAt: someConstTime,
+			}},
+		},
+		{
+			name:  "Paid_PackageRate",
+			order: acceptedAgo(3, "box+film", 5*24*time.Hour),
+			wantFees: []domain.StorageFee{{
+				OrderID: 3, PVZID: domain.DefaultPVZID, ReceiverID: someRecieverID,
+				PaidDays: 2, DailyRate: 20 * domain.Ruble, Amount: 40 * domain.Ruble, ChargedAt: someConstTime,
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			svc.SetStorageFeePolicy(policy)
+			ValidPickupCode(repo)
+			repo.GetByIDMock.Return(tc.order, nil)
+			repo.UpdateMock.Return(nil)
+			repo.SaveHistoryMock.Return(nil)
+			repo.GetByReceiverIDMock.Return(nil, nil)
+			repo.DeletePickupCodeMock.Return(nil)
+			if len(tc.wantFees) > 0 {
+				repo.SaveStorageFeeMock.Set(func(_ context.Context, f domain.StorageFee) error {
+					if f != tc.wantFees[0] {
+						return fmt.Errorf("unexpected fee %+v", f)
+					}
+					return nil
+				})
+			}
+
+			results, err := svc.IssueOrdersToClient(context.Background(), someRecieverID, []uint64{tc.order.OrderID}, somePickupCode)
+			assert.NoError(t, resultsErr(results, err))
+			var fees []domain.StorageFee
+			for _, r := range results {
+				if r.StorageFee.Amount > 0 {
+					fees = append(fees, r.StorageFee)
+				}
+			}
+			assert.Equal(t, tc.wantFees, fees)
+		})
+	}
+}
+
+// дни продления оплачены при продлении, при выдаче за них второй раз не платят
+func TestPVZService_ExtendStorageThenIssue_ChargesEachDayOnce(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	svc.SetStorageFeePolicy(domain.StorageFeePolicy{FreeDays: 3, DefaultPerDay: 10 * domain.Ruble})
+	svc.SetStorageExtensionPolicy(domain.StorageExtensionPolicy{MaxDays: 5, FeePerDay: 10 * domain.Ruble})
+
+	order := OrderInStorage(1, 24*time.Hour)
+	order.AcceptTime = someConstTime.Add(-6 * 24 * time.Hour)
+
+	var charged []domain.StorageFee
+	repo.GetByIDMock.Set(func(_ context.Context, _ uint64) (domain.Order, error) {
+		return order, nil
+	})
+	repo.UpdateMock.Set(func(_ context.Context, o domain.Order) error {
+		order = o
+		return nil
+	})
+	repo.SaveStorageFeeMock.Set(func(_ context.Context, f domain.StorageFee) error {
+		charged = append(charged, f)
+		return nil
+	})
+	repo.SaveHistoryMock.Return(nil)
+	ValidPickupCode(repo)
+	repo.GetByReceiverIDMock.Return(nil, nil)
+	repo.DeletePickupCodeMock.Return(nil)
+
+	_, fee, err := svc.ExtendStorage(contextBack, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 20*domain.Ruble, fee)
+
+	// шесть суток хранения: три бесплатных, два продления, платные только последние сутки
+	results, err := svc.IssueOrdersToClient(contextBack, someRecieverID, []uint64{1}, somePickupCode)
+	assert.NoError(t, resultsErr(results, err))
+	assert.Equal(t, uint32(1), results[0].StorageFee.PaidDays)
+
+	var total domain.Money
+	for _, f := range charged {
+		total += f.Amount
+	}
+	assert.Len(t, charged, 2)
+	assert.Equal(t, 30*domain.Ruble, total)
+}
+
+func TestPVZService_IssueOrdersToClientAtomic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		orderIDs []uint64
+		setup    func(*mock.OrderRepositoryMock)
+		assertE  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "Success_AllOrders",
+			orderIDs: []uint64{1, 2},
+			setup: func(r *mock.OrderRepositoryMock) {
+				ValidPickupCode(r)
+				r.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+					return OrderInStorage(id, 24*time.Hour), nil
+				})
+				r.UpdateMock.Return(nil)
+				r.SaveHistoryMock.Return(nil)
+				r.GetByReceiverIDMock.Return(nil, nil)
+				r.DeletePickupCodeMock.Return(nil)
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name:     "Fail_OneInvalid_NothingIssued",
+			orderIDs: []uint64{1, 2},
+			setup: func(r *mock.OrderRepositoryMock) {
+				ValidPickupCode(r)
+				r.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+					order := OrderInStorage(id, 24*time.Hour)
+					if id == 2 {
+						order.ReceiverID = 999
+					}
+					return order, nil
+				})
+				// Update и SaveHistory не настроены: любая запись провалит тест
+			},
+			assertE: errIs(domain.BelongsToDifferentReceiverError(2, someRecieverID, 999)),
+		},
+		{
+			name:     "Fail_DuplicateOrder",
+			orderIDs: []uint64{1, 1},
+			setup:    func(*mock.OrderRepositoryMock) {},
+			assertE:  errIs(domain.ValidationFailedError("Order 1 is listed more than once")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			tc.setup(repo)
+
+			tc.assertE(t, resultsErr(svc.IssueOrdersToClientAtomic(context.Background(), someRecieverID, tc.orderIDs, somePickupCode)))
+		})
+	}
+}
+
+func TestPVZService_IssueOrdersToClient_Results(t *testing.T) {
+	t.Parallel()
+
+	inStorage, issued := domain.StatusInStorage, domain.StatusGivenToClient
+	tests := []struct {
+		name   string
+		atomic bool
+		want   []domain.OrderResult
+	}{
+		{
+			name: "BestEffort",
+			want: []domain.OrderResult{
+				{OrderID: 1, Status: &issued},
+				{OrderID: 2, Status: &inStorage, Err: domain.BelongsToDifferentReceiverError(2, someRecieverID, 999)},
+				{OrderID: 3, Err: fmt.Errorf("repo.GetByID: %w", domain.EntityNotFoundError("Order", "3"))},
+			},
+		},
+		{
+			name:   "Atomic",
+			atomic: true,
+			want: []domain.OrderResult{
+				{OrderID: 1, Status: &inStorage, Err: domain.BatchAbortedError(1)},
+				{OrderID: 2, Status: &inStorage, Err: domain.BelongsToDifferentReceiverError(2, someRecieverID, 999)},
+				{OrderID: 3, Err: fmt.Errorf("repo.GetByID: %w", domain.EntityNotFoundError("Order", "3"))},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			ValidPickupCode(repo)
+			repo.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
+				switch id {
+				case 2:
+					other := OrderInStorage(2, 24*time.Hour)
+					other.ReceiverID = 999
+					return other, nil
+				case 3:
+					return domain.Order{}, domain.EntityNotFoundError("Order", "3")
+				}
+				return OrderInStorage(id, 24*time.Hour), nil
+			})
+			repo.UpdateMock.Optional().Return(nil)
+			repo.SaveHistoryMock.Optional().Return(nil)
+			repo.GetByReceiverIDMock.Optional().Return(nil, nil)
+			repo.DeletePickupCodeMock.Optional().Return(nil)
+
+			issue := svc.IssueOrdersToClient
+			if tc.atomic {
+				issue = svc.IssueOrdersToClientAtomic
+			}
+			results, err := issue(context.Background(), someRecieverID, []uint64{1, 2, 3}, somePickupCode)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, results)
+			assert.Equal(t, domain.ErrorCodeBelongsToOtherReceiver, results[1].ErrorCode())
 		})
 	}
 }
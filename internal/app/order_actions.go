@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+// проверяет переход по таблице статусов и временные ограничения действия
+func (s *PVZService) checkAction(ctx context.Context, order domain.Order, action domain.OrderAction, now time.Time) (domain.OrderStatus, error) {
+	next, err := order.NextStatus(action)
+	if err != nil {
+		return order.Status, err
+	}
+
+	switch action {
+	case domain.ActionIssue:
+		if now.After(order.StorageUntil) {
+			return order.Status, domain.StorageExpiredError(order.OrderID, cli.MapTimeToString(order.StorageUntil))
+		}
+		if order.AwaitsPayment() {
+			return order.Status, domain.PaymentRequiredError(order.OrderID, order.Price)
+		}
+	case domain.ActionReturnFromClient:
+		if err := s.checkReturnWindow(ctx, order, now); err != nil {
+			return order.Status, err
+		}
+	case domain.ActionReturnToCourier:
+		if now.Before(order.StorageUntil) {
+			return order.Status, domain.StorageNotExpiredError(order.OrderID, cli.MapTimeToString(order.StorageUntil))
+		}
+	case domain.ActionExtendStorage:
+		if order.ExtendedDays >= s.storageExtension.MaxDays {
+			return order.Status, domain.StorageExtensionLimitError(order.OrderID, s.storageExtension.MaxDays, 0)
+		}
+	}
+	return next, nil
+}
+
+func (s *PVZService) GetAllowedActions(ctx context.Context, orderID uint64) (domain.Order, []domain.OrderAction, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return domain.Order{}, nil, fmt.Errorf("repo.GetByID: %w", err)
+	}
+	if order.PVZID != pvzID {
+		return domain.Order{}, nil, domain.BelongsToDifferentPVZError(orderID, pvzID, order.PVZID)
+	}
+
+	now := s.nowFn()
+	actions := make([]domain.OrderAction, 0)
+	for _, action := range order.Status.AllowedActions() {
+		_, err := s.checkAction(ctx, order, action, now)
+		var domainErr domain.Error
+		switch {
+		case err == nil:
+			actions = append(actions, action)
+		case !errors.As(err, &domainErr):
+			return domain.Order{}, nil, err
+		}
+	}
+	return order, actions, nil
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func TestPVZService_GetAllowedActions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		order   domain.Order
+		want    []domain.OrderAction
+		assertE assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "InStorage_NotExpired",
+			order:   OrderInStorage(1, 24*time.Hour),
+			want:    []domain.OrderAction{domain.ActionIssue, domain.ActionExtendStorage},
+			assertE: assert.NoError,
+		},
+		{
+			name:    "InStorage_Expired",
+			order:   OrderInStorage(2, -1*time.Hour),
+			want:    []domain.OrderAction{domain.ActionReturnToCourier, domain.ActionExtendStorage},
+			assertE: assert.NoError,
+		},
+		{
+			name: "InStorage_ExtensionLimitReached",
+			order: func() domain.Order {
+				o := OrderInStorage(6, 24*time.Hour)
+				o.ExtendedDays = domain.DefaultStorageExtensionPolicy.MaxDays
+				return o
+			}(),
+			want:    []domain.OrderAction{domain.ActionIssue},
+			assertE: assert.NoError,
+		},
+		{
+			name:    "Given_WithinReturnWindow",
+			order:   OrderGiven(3, -1*time.Hour),
+			want:    []domain.OrderAction{domain.ActionReturnFromClient},
+			assertE: assert.NoError,
+		},
+		{
+			name:    "Given_ReturnWindowPassed",
+			order:   OrderGiven(4, -72*time.Hour),
+			want:    []domain.OrderAction{},
+			assertE: assert.NoError,
+		},
+		{
+			name: "OtherPVZ",
+			order: func() domain.Order {
+				o := OrderInStorage(5, 24*time.Hour)
+				o.PVZID = 7
+				return o
+			}(),
+			assertE: errIs(domain.BelongsToDifferentPVZError(5, domain.DefaultPVZID, 7)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.GetByIDMock.Expect(contextBack, tc.order.OrderID).Return(tc.order, nil)
+			if tc.order.Status == domain.StatusGivenToClient {
+				DefaultReturnPolicy(repo)
+			}
+
+			_, got, err := svc.GetAllowedActions(context.Background(), tc.order.OrderID)
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
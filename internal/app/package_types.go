@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func (s *PVZService) CreatePackageType(ctx context.Context, p domain.PackageType) (domain.PackageType, error) {
+	p.Code = strings.TrimSpace(p.Code)
+	if err := p.Validate(); err != nil {
+		return domain.PackageType{}, fmt.Errorf("validation: %w", err)
+	}
+	if err := s.orderRepo.SavePackageType(ctx, p); err != nil {
+		return domain.PackageType{}, fmt.Errorf("repo.SavePackageType: %w", err)
+	}
+	return p, nil
+}
+
+func (s *PVZService) UpdatePackageType(ctx context.Context, p domain.PackageType) (domain.PackageType, error) {
+	p.Code = strings.TrimSpace(p.Code)
+	if err := p.Validate(); err != nil {
+		return domain.PackageType{}, fmt.Errorf("validation: %w", err)
+	}
+	if err := s.orderRepo.UpdatePackageType(ctx, p); err != nil {
+		return domain.PackageType{}, fmt.Errorf("repo.UpdatePackageType: %w", err)
+	}
+	return p, nil
+}
+
+func (s *PVZService) DeletePackageType(ctx context.Context, code string) error {
+	if err := s.orderRepo.DeletePackageType(ctx, strings.TrimSpace(code)); err != nil {
+		return fmt.Errorf("repo.DeletePackageType: %w", err)
+	}
+	return nil
+}
+
+func (s *PVZService) ListPackageTypes(ctx context.Context) ([]domain.PackageType, error) {
+	types, err := s.orderRepo.ListPackageTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListPackageTypes: %w", err)
+	}
+	return types, nil
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func TestPVZService_CreatePackageType(t *testing.T) {
+	t.Parallel()
+
+	crate := domain.PackageType{Code: "crate", Kind: domain.PackageKindContainer, MaxWeight: 50 * domain.Kilogram, Price: 40 * domain.Ruble}
+
+	tests := []struct {
+		name    string
+		input   domain.PackageType
+		setup   func(*mock.OrderRepositoryMock)
+		assertE assert.ErrorAssertionFunc
+	}{
+		{
+			name:  "Success",
+			input: crate,
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.SavePackageTypeMock.Expect(contextBack, crate).Return(nil)
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name:    "Fail_CombinedCode",
+			input:   domain.PackageType{Code: "box+film"},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+		{
+			name:  "Fail_AlreadyExists",
+			input: crate,
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.SavePackageTypeMock.Expect(contextBack, crate).
+					Return(domain.ValidationFailedError(`package type "crate" already exists`))
+			},
+			assertE: assert.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			tc.setup(repo)
+
+			got, err := svc.CreatePackageType(context.Background(), tc.input)
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, tc.input, got)
+			}
+		})
+	}
+}
+
+func TestPVZService_UpdatePackageType(t *testing.T) {
+	t.Parallel()
+
+	film := domain.PackageType{Code: "film", Kind: domain.PackageKindWrapping, Price: 2 * domain.Ruble}
+
+	tests := []struct {
+		name    string
+		setup   func(*mock.OrderRepositoryMock)
+		assertE assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.UpdatePackageTypeMock.Expect(contextBack, film).Return(nil)
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name: "Fail_NotFound",
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.UpdatePackageTypeMock.Expect(contextBack, film).Return(domain.EntityNotFoundError("PackageType", "film"))
+			},
+			assertE: errIs(domain.EntityNotFoundError("PackageType", "film")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			tc.setup(repo)
+
+			_, err := svc.UpdatePackageType(context.Background(), film)
+			tc.assertE(t, err)
+		})
+	}
+}
+
+func TestPVZService_DeletePackageType(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	repo.DeletePackageTypeMock.Expect(contextBack, "film").Return(nil)
+
+	assert.NoError(t, svc.DeletePackageType(context.Background(), " film "))
+}
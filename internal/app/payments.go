@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+// ConfirmPayment фиксирует оплату заказа с оплатой при получении; повторное подтверждение ничего не меняет
+func (s *PVZService) ConfirmPayment(ctx context.Context, orderID uint64) (domain.Order, error) {
+	var order domain.Order
+	// подтверждение идемпотентно, поэтому при конфликте версий его безопасно повторить
+	err := retryOnConflict(ctx, func() (err error) {
+		order, err = s.confirmPayment(ctx, orderID)
+		return err
+	})
+	return order, err
+}
+
+func (s *PVZService) confirmPayment(ctx context.Context, orderID uint64) (domain.Order, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("repo.GetByID: %w", err)
+	}
+	if order.PVZID != pvzID {
+		return domain.Order{}, domain.BelongsToDifferentPVZError(orderID, pvzID, order.PVZID)
+	}
+	if !order.CashOnDelivery {
+		return domain.Order{}, fmt.Errorf("validation: %w", domain.ValidationFailedError(
+			fmt.Sprintf("order %d is prepaid and does not take payment at pickup", orderID)))
+	}
+	if order.PaymentStatus == domain.PaymentStatusPaid {
+		return order, nil
+	}
+	if order.Status != domain.StatusInStorage || order.PaymentStatus != domain.PaymentStatusUnpaid {
+		return domain.Order{}, fmt.Errorf("validation: %w", domain.ValidationFailedError(
+			fmt.Sprintf("order %d cannot be paid (status: %s, payment: %s)",
+				orderID, order.GetStatusString(), order.PaymentStatus)))
+	}
+
+	now := s.nowFn()
+	order.PaymentStatus = domain.PaymentStatusPaid
+	order.LastUpdateTime = now
+
+	payment := domain.Payment{
+		OrderID:   orderID,
+		PVZID:     pvzID,
+		Kind:      domain.PaymentKindCharge,
+		Amount:    order.Price,
+		CreatedAt: now,
+	}
+
+	event := domain.NewEvent(
+		domain.EventTypeOrderPaid,
+		pvzID,
+		actorOr(ctx, domain.Actor{Type: domain.ActorTypeClient, ID: order.ReceiverID}),
+		domain.OrderInfo{
+			ID:     orderID,
+			UserID: order.ReceiverID,
+			Status: "paid",
+			Amount: payment.Amount,
+		},
+	)
+
+	if s.dbClient == nil {
+		if err := s.orderRepo.Update(ctx, order); err != nil {
+			return domain.Order{}, fmt.Errorf("repo.Update: %w", err)
+		}
+		if _, err := s.orderRepo.SavePayment(ctx, payment); err != nil {
+			return domain.Order{}, fmt.Errorf("repo.SavePayment: %w", err)
+		}
+		return order, nil
+	}
+
+	err = s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+		if err := s.orderRepo.UpdateOrderInTx(ctx, tx, order); err != nil {
+			return fmt.Errorf("update order: %w", err)
+		}
+
+		if _, err := s.orderRepo.SavePaymentInTx(ctx, tx, payment); err != nil {
+			return fmt.Errorf("save payment: %w", err)
+		}
+
+		if err := s.saveEvent(ctx, tx, event); err != nil {
+			return fmt.Errorf("save event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+	return order, nil
+}
+
+// refundFor переводит оплаченный заказ в статус возврата денег и готовит запись журнала и событие.
+// Деньги возвращает только пункт, который сам их принял: предоплаченный заказ оплачен через магазин
+// и приходит уже оплаченным, возврат по нему делает магазин
+func refundFor(order *domain.Order, actor domain.Actor, now time.Time) (domain.Payment, domain.Event, bool) {
+	if !order.CashOnDelivery || order.PaymentStatus != domain.PaymentStatusPaid {
+		return domain.Payment{}, domain.Event{}, false
+	}
+	order.PaymentStatus = domain.PaymentStatusRefunded
+
+	refund := domain.Payment{
+		OrderID:   order.OrderID,
+		PVZID:     order.PVZID,
+		Kind:      domain.PaymentKindRefund,
+		Amount:    order.Price,
+		CreatedAt: now,
+	}
+	event := domain.NewEvent(
+		domain.EventTypeOrderRefunded,
+		order.PVZID,
+		actor,
+		domain.OrderInfo{
+			ID:     order.OrderID,
+			UserID: order.ReceiverID,
+			Status: "refunded",
+			Amount: refund.Amount,
+		},
+	)
+	return refund, event, true
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func codOrder(o domain.Order, status domain.PaymentStatus) domain.Order {
+	o.CashOnDelivery = true
+	o.PaymentStatus = status
+	o.Price = 500 * domain.Ruble
+	return o
+}
+
+func TestPVZService_ConfirmPayment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		order      domain.Order
+		setup      func(*mock.OrderRepositoryMock)
+		wantStatus domain.PaymentStatus
+		assertE    assert.ErrorAssertionFunc
+	}{
+		{
+			name:  "Success",
+			order: codOrder(OrderInStorage(1, 24*time.Hour), domain.PaymentStatusUnpaid),
+			setup: func(r *mock.OrderRepositoryMock) {
+				paid := codOrder(OrderInStorage(1, 24*time.Hour), domain.PaymentStatusPaid)
+				paid.LastUpdateTime = someConstTime
+				r.UpdateMock.Expect(contextBack, paid).Return(nil)
+				r.SavePaymentMock.Expect(contextBack, domain.Payment{
+					OrderID:   1,
+					PVZID:     domain.DefaultPVZID,
+					Kind:      domain.PaymentKindCharge,
+					Amount:    500 * domain.Ruble,
+					CreatedAt: someConstTime,
+				}).Return(domain.Payment{ID: 1}, nil)
+			},
+			wantStatus: domain.PaymentStatusPaid,
+			assertE:    assert.NoError,
+		},
+		{
+			name:       "Success_AlreadyPaid",
+			order:      codOrder(OrderInStorage(2, 24*time.Hour), domain.PaymentStatusPaid),
+			setup:      func(*mock.OrderRepositoryMock) {},
+			wantStatus: domain.PaymentStatusPaid,
+			assertE:    assert.NoError,
+		},
+		{
+			name:    "Fail_Prepaid",
+			order:   OrderInStorage(3, 24*time.Hour),
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+		{
+			name:    "Fail_Refunded",
+			order:   codOrder(OrderReturned(4, 0), domain.PaymentStatusRefunded),
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.GetByIDMock.Expect(contextBack, tc.order.OrderID).Return(tc.order, nil)
+			tc.setup(repo)
+
+			got, err := svc.ConfirmPayment(context.Background(), tc.order.OrderID)
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, tc.wantStatus, got.PaymentStatus)
+			}
+		})
+	}
+}
+
+func TestPVZService_IssueOrdersToClient_CashOnDelivery(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	ValidPickupCode(repo)
+	repo.GetByIDMock.Return(codOrder(OrderInStorage(1, 24*time.Hour), domain.PaymentStatusUnpaid), nil)
+
+	err := resultsErr(svc.IssueOrdersToClient(context.Background(), someRecieverID, []uint64{1}, somePickupCode))
+	assert.ErrorIs(t, err, domain.PaymentRequiredError(1, 500*domain.Ruble))
+}
+
+func TestPVZService_ReturnOrdersFromClient_Refund(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	DefaultReturnPolicy(repo)
+	repo.GetByIDMock.Return(codOrder(OrderGiven(1, -time.Hour), domain.PaymentStatusPaid), nil)
+	repo.UpdateMock.Set(func(_ context.Context, o domain.Order) error {
+		if o.PaymentStatus != domain.PaymentStatusRefunded {
+			return fmt.Errorf("unexpected payment status %s", o.PaymentStatus)
+		}
+		return nil
+	})
+	repo.SaveHistoryMock.Return(nil)
+	repo.SavePaymentMock.Set(func(_ context.Context, p domain.Payment) (domain.Payment, error) {
+		if p.Kind != domain.PaymentKindRefund || p.Amount != 500*domain.Ruble {
+			return domain.Payment{}, fmt.Errorf("unexpected refund %+v", p)
+		}
+		return p, nil
+	})
+
+	assert.NoError(t, resultsErr(svc.ReturnOrdersFromClient(context.Background(), someRecieverID, []uint64{1})))
+}
+
+func TestPVZService_ReturnOrdersFromClient_PrepaidNotRefunded(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	DefaultReturnPolicy(repo)
+	prepaid := OrderGiven(1, -time.Hour)
+	prepaid.PaymentStatus = domain.InitialPaymentStatus(false)
+	prepaid.Price = 500 * domain.Ruble
+	repo.GetByIDMock.Return(prepaid, nil)
+	repo.UpdateMock.Set(func(_ context.Context, o domain.Order) error {
+		if o.PaymentStatus != domain.PaymentStatusPaid {
+			return fmt.Errorf("unexpected payment status %s", o.PaymentStatus)
+		}
+		return nil
+	})
+	repo.SaveHistoryMock.Return(nil)
+
+	assert.NoError(t, resultsErr(svc.ReturnOrdersFromClient(context.Background(), someRecieverID, []uint64{1})))
+	assert.Zero(t, repo.SavePaymentAfterCounter())
+}
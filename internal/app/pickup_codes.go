@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+// выпускает кандидата в коды получателя: открытый код уходит только в уведомление, в базу — хэш.
+// Действующий код получателя репозиторий оставит как есть
+func (s *PVZService) newPickupCode(pvzID, receiverID uint64, now time.Time) (string, domain.PickupCode, error) {
+	code, err := domain.GeneratePickupCode()
+	if err != nil {
+		return "", domain.PickupCode{}, err
+	}
+	return code, domain.PickupCode{
+		PVZID:      pvzID,
+		ReceiverID: receiverID,
+		CodeHash:   s.pickupCodes.Hash(pvzID, receiverID, code),
+		CreatedAt:  now,
+	}, nil
+}
+
+func (s *PVZService) verifyPickupCode(ctx context.Context, pvzID, receiverID uint64, code string, now time.Time) error {
+	if code == "" {
+		return domain.PickupCodeRequiredError(receiverID)
+	}
+
+	stored, err := s.orderRepo.GetPickupCode(ctx, pvzID, receiverID)
+	var domainErr domain.Error
+	if errors.As(err, &domainErr) && domainErr.Code == domain.ErrorCodeNotFound {
+		return domain.InvalidPickupCodeError(receiverID)
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetPickupCode: %w", err)
+	}
+
+	if stored.IsLocked(now) {
+		return domain.PickupCodeLockedError(receiverID, cli.MapTimeToString(stored.LockedUntil))
+	}
+
+	if !s.pickupCodes.Matches(stored, code) {
+		failed, err := s.orderRepo.RegisterPickupCodeFailure(ctx, pvzID, receiverID,
+			s.pickupCodes.MaxAttempts, now.Add(s.pickupCodes.LockoutDuration))
+		if err != nil {
+			return fmt.Errorf("repo.RegisterPickupCodeFailure: %w", err)
+		}
+		if failed.IsLocked(now) {
+			return domain.PickupCodeLockedError(receiverID, cli.MapTimeToString(failed.LockedUntil))
+		}
+		return domain.InvalidPickupCodeError(receiverID)
+	}
+
+	if stored.FailedAttempts > 0 {
+		if err := s.orderRepo.ResetPickupCodeFailures(ctx, pvzID, receiverID); err != nil {
+			return fmt.Errorf("repo.ResetPickupCodeFailures: %w", err)
+		}
+	}
+	return nil
+}
+
+// код одноразовый: гасим его, когда у получателя в пункте не осталось заказов к выдаче
+func (s *PVZService) releasePickupCode(ctx context.Context, pvzID, receiverID uint64) error {
+	orders, err := s.orderRepo.GetByReceiverID(ctx, pvzID, receiverID)
+	if err != nil {
+		return fmt.Errorf("repo.GetByReceiverID: %w", err)
+	}
+	for _, o := range orders {
+		if o.Status == domain.StatusInStorage {
+			return nil
+		}
+	}
+	if err := s.orderRepo.DeletePickupCode(ctx, pvzID, receiverID); err != nil {
+		return fmt.Errorf("repo.DeletePickupCode: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func (s *PVZService) CreatePickupPoint(ctx context.Context, name, address string) (domain.PickupPoint, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.PickupPoint{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("pickup point name is required"))
+	}
+
+	point, err := s.orderRepo.SavePickupPoint(ctx, domain.PickupPoint{
+		Name:      name,
+		Address:   strings.TrimSpace(address),
+		CreatedAt: s.nowFn(),
+	})
+	if err != nil {
+		return domain.PickupPoint{}, fmt.Errorf("repo.SavePickupPoint: %w", err)
+	}
+	return point, nil
+}
+
+func (s *PVZService) ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error) {
+	points, err := s.orderRepo.ListPickupPoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListPickupPoints: %w", err)
+	}
+	return points, nil
+}
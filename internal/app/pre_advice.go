@@ -0,0 +1,353 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (s *PVZService) announceSingle(ctx context.Context, shipmentID string, req domain.AcceptOrderRequest) error {
+	// анонсированный заказ еще не в пункте: ни ячейки, ни кода получателя
+	order, err := s.buildOrder(ctx, req, domain.StatusExpected)
+	if err != nil {
+		return err
+	}
+	order.ShipmentID = shipmentID
+
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeCourier, ID: 1})
+	history := domain.OrderHistory{
+		OrderID:   order.OrderID,
+		PVZID:     order.PVZID,
+		Status:    domain.StatusExpected,
+		ChangedAt: order.AcceptTime,
+		Actor:     actor,
+		Reason:    domain.ReasonAnnounced,
+		Comment:   "shipment " + shipmentID,
+	}
+
+	event := domain.NewEvent(
+		domain.EventTypeOrderAnnounced,
+		order.PVZID,
+		actor,
+		domain.OrderInfo{
+			ID:         order.OrderID,
+			UserID:     order.ReceiverID,
+			Status:     "announced",
+			ShipmentID: shipmentID,
+		},
+	)
+
+	if s.dbClient == nil {
+		if err := s.orderRepo.Save(ctx, order); err != nil {
+			return fmt.Errorf("repo.Save: %w", err)
+		}
+		if err := s.orderRepo.SaveHistory(ctx, history); err != nil {
+			return fmt.Errorf("repo.SaveHistory: %w", err)
+		}
+		return nil
+	}
+
+	return s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+		if err := s.orderRepo.SaveOrderInTx(ctx, tx, order); err != nil {
+			return fmt.Errorf("save order: %w", err)
+		}
+
+		if err := s.orderRepo.SaveHistoryInTx(ctx, tx, history); err != nil {
+			return fmt.Errorf("save history: %w", err)
+		}
+
+		if err := s.saveEvent(ctx, tx, event); err != nil {
+			return fmt.Errorf("save event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// AnnounceOrders регистрирует заказы поставки до их физического прибытия в пункт
+// и возвращает результат по каждому. Ошибка возвращается, только если не задана поставка
+func (s *PVZService) AnnounceOrders(
+	ctx context.Context,
+	shipmentID string,
+	reqs []domain.AcceptOrderRequest,
+) ([]domain.OrderResult, error) {
+	shipmentID = strings.TrimSpace(shipmentID)
+	if shipmentID == "" {
+		return nil, fmt.Errorf("validation: %w", domain.ValidationFailedError("shipment id is required"))
+	}
+	return processEach(ctx, reqs, s.workerLimit, func(ctx context.Context, req domain.AcceptOrderRequest) domain.OrderResult {
+		if err := s.announceSingle(ctx, shipmentID, req); err != nil {
+			return domain.FailedOrderResult(req.OrderID, nil, err)
+		}
+		status := domain.StatusExpected
+		return domain.OrderResult{OrderID: req.OrderID, Status: &status}
+	}), nil
+}
+
+func (s *PVZService) confirmSingle(ctx context.Context, order domain.Order, now time.Time) error {
+	next, err := s.checkAction(ctx, order, domain.ActionConfirmArrival, now)
+	if err != nil {
+		return err
+	}
+	prev := order.Status
+	order.Status = next
+	order.AcceptTime = now
+	order.LastUpdateTime = now
+
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeCourier, ID: 1})
+	history := domain.OrderHistory{
+		OrderID:    order.OrderID,
+		PVZID:      order.PVZID,
+		PrevStatus: &prev,
+		Status:     next,
+		ChangedAt:  now,
+		Actor:      actor,
+		Reason:     domain.ReasonArrived,
+		Comment:    "shipment " + order.ShipmentID,
+	}
+
+	code, pickupCode, err := s.newPickupCode(order.PVZID, order.ReceiverID, now)
+	if err != nil {
+		return fmt.Errorf("newPickupCode: %w", err)
+	}
+
+	// код попадает в событие, только если он стал действующим; иначе получатель забирает заказ прежним кодом
+	event := domain.NewEvent(
+		domain.EventTypeOrderArrived,
+		order.PVZID,
+		actor,
+		domain.OrderInfo{
+			ID:         order.OrderID,
+			UserID:     order.ReceiverID,
+			Status:     "arrived",
+			ShipmentID: order.ShipmentID,
+		},
+	)
+
+	cellSize := domain.CellSizeForWeight(order.Weight)
+
+	if s.dbClient == nil {
+		cell, err := s.orderRepo.OccupyCell(ctx, order.PVZID, cellSize)
+		if err != nil {
+			return fmt.Errorf("repo.OccupyCell: %w", err)
+		}
+		order.CellID, order.CellCode = cell.ID, cell.Code
+
+		// код сохраняем раньше заказа: прежние заказы получателя на хранении оставляют ему прежний код
+		if _, err := s.orderRepo.SavePickupCode(ctx, pickupCode); err != nil {
+			_ = s.orderRepo.ReleaseCell(ctx, cell.ID)
+			return fmt.Errorf("repo.SavePickupCode: %w", err)
+		}
+		if err := s.orderRepo.Update(ctx, order); err != nil {
+			_ = s.orderRepo.ReleaseCell(ctx, cell.ID)
+			return fmt.Errorf("repo.Update: %w", err)
+		}
+		if err := s.orderRepo.SaveHistory(ctx, history); err != nil {
+			return fmt.Errorf("repo.SaveHistory: %w", err)
+		}
+		if err := s.orderRepo.ResolveMissing(ctx, order.PVZID, order.ShipmentID, order.OrderID); err != nil {
+			return fmt.Errorf("repo.ResolveMissing: %w", err)
+		}
+		return nil
+	}
+
+	return s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+		cell, err := s.orderRepo.OccupyCellInTx(ctx, tx, order.PVZID, cellSize)
+		if err != nil {
+			return fmt.Errorf("occupy cell: %w", err)
+		}
+		order.CellID, order.CellCode = cell.ID, cell.Code
+
+		issued, err := s.orderRepo.SavePickupCodeInTx(ctx, tx, pickupCode)
+		if err != nil {
+			return fmt.Errorf("save pickup code: %w", err)
+		}
+		if issued {
+			event.Order.PickupCode = code
+		}
+
+		if err := s.orderRepo.UpdateOrderInTx(ctx, tx, order); err != nil {
+			return fmt.Errorf("update order: %w", err)
+		}
+
+		if err := s.orderRepo.SaveHistoryInTx(ctx, tx, history); err != nil {
+			return fmt.Errorf("save history: %w", err)
+		}
+
+		if err := s.orderRepo.ResolveMissingInTx(ctx, tx, order.PVZID, order.ShipmentID, order.OrderID); err != nil {
+			return fmt.Errorf("resolve discrepancy: %w", err)
+		}
+
+		if err := s.saveEvent(ctx, tx, event); err != nil {
+			return fmt.Errorf("save event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// confirmWithRetry принимает прибывший заказ; при конфликте версий заказ перечитывается
+// и проверяется заново, как в processOne: его могли параллельно принять или изменить
+func (s *PVZService) confirmWithRetry(ctx context.Context, order domain.Order, now time.Time) error {
+	current := order
+	return retryOnConflict(ctx, func() error {
+		err := s.confirmSingle(ctx, current, now)
+		if domain.ErrorCodeOf(err) == domain.ErrorCodeConcurrentModification {
+			fresh, getErr := s.orderRepo.GetByID(ctx, order.OrderID)
+			if getErr != nil {
+				return fmt.Errorf("repo.GetByID: %w", getErr)
+			}
+			current = fresh
+		}
+		return err
+	})
+}
+
+func (s *PVZService) recordDiscrepancy(ctx context.Context, d domain.ArrivalDiscrepancy) error {
+	eventType, status := domain.EventTypeOrderMissing, "missing"
+	if d.Kind == domain.DiscrepancyUnexpected {
+		eventType, status = domain.EventTypeOrderUnexpected, "unexpected"
+	}
+	event := domain.NewEvent(
+		eventType,
+		d.PVZID,
+		domain.Actor{
+			Type: domain.ActorTypeSystem,
+		},
+		domain.OrderInfo{
+			ID:         d.OrderID,
+			Status:     status,
+			ShipmentID: d.ShipmentID,
+		},
+	)
+
+	if s.dbClient == nil {
+		if _, err := s.orderRepo.SaveDiscrepancy(ctx, d); err != nil {
+			return fmt.Errorf("repo.SaveDiscrepancy: %w", err)
+		}
+		return nil
+	}
+
+	return s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+		inserted, err := s.orderRepo.SaveDiscrepancyInTx(ctx, tx, d)
+		if err != nil {
+			return fmt.Errorf("save discrepancy: %w", err)
+		}
+		// о расхождении, найденном прошлой приемкой поставки, уже сообщили
+		if !inserted {
+			return nil
+		}
+
+		if err := s.saveEvent(ctx, tx, event); err != nil {
+			return fmt.Errorf("save event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ConfirmArrival сверяет фактически прибывшие посылки с анонсом поставки:
+// прибывшие переходят на хранение, остальное попадает в отчет о расхождениях.
+// Каждый заказ обрабатывается независимо: сбой одного попадает в его результат и не останавливает остальные
+func (s *PVZService) ConfirmArrival(ctx context.Context, shipmentID string, arrivedIDs []uint64) (domain.ArrivalReport, error) {
+	shipmentID = strings.TrimSpace(shipmentID)
+	if shipmentID == "" {
+		return domain.ArrivalReport{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("shipment id is required"))
+	}
+	pvzID := domain.PVZIDFromContext(ctx)
+	now := s.nowFn()
+
+	announced, err := s.orderRepo.GetByShipmentID(ctx, pvzID, shipmentID)
+	if err != nil {
+		return domain.ArrivalReport{}, fmt.Errorf("repo.GetByShipmentID: %w", err)
+	}
+	if len(announced) == 0 {
+		return domain.ArrivalReport{}, domain.EntityNotFoundError("Shipment", shipmentID)
+	}
+
+	arrived := make(map[uint64]struct{}, len(arrivedIDs))
+	for _, id := range arrivedIDs {
+		arrived[id] = struct{}{}
+	}
+
+	report := domain.ArrivalReport{ShipmentID: shipmentID}
+	known := make(map[uint64]struct{}, len(announced))
+	for _, order := range announced {
+		known[order.OrderID] = struct{}{}
+		// заказы, принятые прошлыми подтверждениями поставки, не трогаем
+		if order.Status != domain.StatusExpected {
+			continue
+		}
+
+		if _, ok := arrived[order.OrderID]; ok {
+			if err := s.confirmWithRetry(ctx, order, now); err != nil {
+				report.Results = append(report.Results, domain.FailedOrderResult(order.OrderID, &order.Status, err))
+				continue
+			}
+			s.metricsProvider.OrderAccepted()
+			report.Confirmed = append(report.Confirmed, order.OrderID)
+			status := domain.StatusInStorage
+			report.Results = append(report.Results, domain.OrderResult{OrderID: order.OrderID, Status: &status})
+			continue
+		}
+
+		d := domain.ArrivalDiscrepancy{
+			PVZID:      pvzID,
+			ShipmentID: shipmentID,
+			OrderID:    order.OrderID,
+			Kind:       domain.DiscrepancyMissing,
+			DetectedAt: now,
+		}
+		s.addDiscrepancy(ctx, &report, d, &order.Status)
+	}
+
+	for _, id := range arrivedIDs {
+		if _, ok := known[id]; ok {
+			continue
+		}
+		known[id] = struct{}{}
+
+		d := domain.ArrivalDiscrepancy{
+			PVZID:      pvzID,
+			ShipmentID: shipmentID,
+			OrderID:    id,
+			Kind:       domain.DiscrepancyUnexpected,
+			DetectedAt: now,
+		}
+		// заказа без анонса в пункте нет, статуса у него тоже
+		s.addDiscrepancy(ctx, &report, d, nil)
+	}
+
+	if len(report.Confirmed) > 0 {
+		s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, pvzID)
+	}
+	return report, nil
+}
+
+// addDiscrepancy записывает расхождение и добавляет в отчет его результат
+func (s *PVZService) addDiscrepancy(
+	ctx context.Context,
+	report *domain.ArrivalReport,
+	d domain.ArrivalDiscrepancy,
+	status *domain.OrderStatus,
+) {
+	if err := s.recordDiscrepancy(ctx, d); err != nil {
+		report.Results = append(report.Results, domain.FailedOrderResult(d.OrderID, status, err))
+		return
+	}
+	report.Discrepancies = append(report.Discrepancies, d)
+	report.Results = append(report.Results, domain.OrderResult{OrderID: d.OrderID, Status: status})
+}
+
+func (s *PVZService) GetDiscrepancyReport(ctx context.Context, shipmentID string) ([]domain.ArrivalDiscrepancy, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	list, err := s.orderRepo.ListDiscrepancies(ctx, pvzID, strings.TrimSpace(shipmentID))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListDiscrepancies: %w", err)
+	}
+	return list, nil
+}
@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+const someShipmentID = "SHP-1"
+
+func Announced(id uint64) domain.Order {
+	o := Stored(id, domain.StatusExpected)
+	o.ShipmentID = someShipmentID
+	o.Weight = 2 * domain.Kilogram
+	return o
+}
+
+func TestPVZService_AnnounceOrders(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	repo.GetPickupPointMock.Return(domain.PickupPoint{ID: domain.DefaultPVZID}, nil)
+	repo.GetByIDMock.Return(domain.Order{}, domain.EntityNotFoundError("Order", "1"))
+	repo.SaveMock.Set(func(_ context.Context, o domain.Order) error {
+		if o.Status != domain.StatusExpected || o.ShipmentID != someShipmentID || o.CellID != 0 {
+			return fmt.Errorf("unexpected announced order %+v", o)
+		}
+		return nil
+	})
+	repo.SaveHistoryMock.Return(nil)
+
+	reqs := []domain.AcceptOrderRequest{
+		{OrderID: 1, ReceiverID: someRecieverID, StorageUntil: someConstTime.Add(24 * time.Hour), Weight: domain.Kilogram},
+		{OrderID: 2, ReceiverID: someRecieverID, StorageUntil: someConstTime.Add(-24 * time.Hour), Weight: domain.Kilogram},
+		{OrderID: 3, ReceiverID: someRecieverID, StorageUntil: someConstTime.Add(24 * time.Hour), Weight: domain.Kilogram},
+	}
+	results, err := svc.AnnounceOrders(context.Background(), someShipmentID, reqs)
+	assert.NoError(t, err)
+	// просроченный срок хранения отклоняет только свой заказ
+	assert.Equal(t, []uint64{1, 3}, domain.SucceededOrders(results))
+	assert.Equal(t, []uint64{2}, domain.FailedOrders(results))
+	assert.Equal(t, domain.ErrorCodeStorageDateInPast, results[1].ErrorCode())
+	assert.Equal(t, domain.StatusExpected, *results[0].Status)
+}
+
+func TestPVZService_AnnounceOrders_NoShipment(t *testing.T) {
+	t.Parallel()
+
+	_, svc := NewEnv(t)
+	_, err := svc.AnnounceOrders(context.Background(), "  ", []domain.AcceptOrderRequest{{OrderID: 1}})
+	assert.Error(t, err)
+}
+
+func TestPVZService_ConfirmArrival(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		announced     []domain.Order
+		arrived       []uint64
+		setup         func(*mock.OrderRepositoryMock)
+		wantConfirmed []uint64
+		wantFailed    []uint64
+		wantKinds     map[uint64]domain.DiscrepancyKind
+		assertE       assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "Success_AllArrived",
+			announced: []domain.Order{Announced(1), Announced(2)},
+			arrived:   []uint64{1, 2},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.OccupyCellMock.Return(domain.StorageCell{ID: 7, Code: "A-1"}, nil)
+				r.UpdateMock.Set(func(_ context.Context, o domain.Order) error {
+					if o.Status != domain.StatusInStorage || o.CellID != 7 || !o.AcceptTime.Equal(someConstTime) {
+						return fmt.Errorf("unexpected arrived order %+v", o)
+					}
+					return nil
+				})
+				r.SaveHistoryMock.Return(nil)
+				r.SavePickupCodeMock.Return(true, nil)
+				r.ResolveMissingMock.Return(nil)
+			},
+			wantConfirmed: []uint64{1, 2},
+			wantKinds:     map[uint64]domain.DiscrepancyKind{},
+			assertE:       assert.NoError,
+		},
+		{
+			name:      "Success_MissingAndUnexpected",
+			announced: []domain.Order{Announced(1), Announced(2)},
+			arrived:   []uint64{1, 3},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.OccupyCellMock.Return(domain.StorageCell{ID: 7, Code: "A-1"}, nil)
+				r.UpdateMock.Return(nil)
+				r.SaveHistoryMock.Return(nil)
+				r.SavePickupCodeMock.Return(true, nil)
+				r.ResolveMissingMock.Expect(contextBack, domain.DefaultPVZID, someShipmentID, 1).Return(nil)
+				r.SaveDiscrepancyMock.Return(true, nil)
+			},
+			wantConfirmed: []uint64{1},
+			wantKinds: map[uint64]domain.DiscrepancyKind{
+				2: domain.DiscrepancyMissing,
+				3: domain.DiscrepancyUnexpected,
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name:      "Success_FailedOrderDoesNotStopOthers",
+			announced: []domain.Order{Announced(1), Announced(2), Announced(3)},
+			arrived:   []uint64{1, 2},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.OccupyCellMock.Return(domain.StorageCell{ID: 7, Code: "A-1"}, nil)
+				r.UpdateMock.Set(func(_ context.Context, o domain.Order) error {
+					if o.OrderID == 1 {
+						return fmt.Errorf("db down")
+					}
+					return nil
+				})
+				r.ReleaseCellMock.Return(nil)
+				r.SaveHistoryMock.Return(nil)
+				r.SavePickupCodeMock.Return(true, nil)
+				r.ResolveMissingMock.Return(nil)
+				r.SaveDiscrepancyMock.Return(true, nil)
+			},
+			wantConfirmed: []uint64{2},
+			wantFailed:    []uint64{1},
+			wantKinds:     map[uint64]domain.DiscrepancyKind{3: domain.DiscrepancyMissing},
+			assertE:       assert.NoError,
+		},
+		{
+			// заказ параллельно изменили: подтверждение перечитывает его и повторяет
+			name:      "Success_RetriesOnConflict",
+			announced: []domain.Order{Announced(1)},
+			arrived:   []uint64{1},
+			setup: func(r *mock.OrderRepositoryMock) {
+				conflicts := 1
+				r.OccupyCellMock.Return(domain.StorageCell{ID: 7, Code: "A-1"}, nil)
+				r.UpdateMock.Set(func(_ context.Context, o domain.Order) error {
+					if conflicts > 0 {
+						conflicts--
+						return domain.ConcurrentModificationError(o.OrderID)
+					}
+					return nil
+				})
+				r.ReleaseCellMock.Expect(contextBack, 7).Return(nil)
+				r.GetByIDMock.Expect(contextBack, 1).Return(Announced(1), nil)
+				r.SaveHistoryMock.Return(nil)
+				r.SavePickupCodeMock.Return(true, nil)
+				r.ResolveMissingMock.Return(nil)
+			},
+			wantConfirmed: []uint64{1},
+			wantKinds:     map[uint64]domain.DiscrepancyKind{},
+			assertE:       assert.NoError,
+		},
+		{
+			// заказ успели принять параллельно: повтор видит новый статус и не принимает его второй раз
+			name:      "Fail_ConfirmedConcurrently",
+			announced: []domain.Order{Announced(1)},
+			arrived:   []uint64{1},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.OccupyCellMock.Return(domain.StorageCell{ID: 7, Code: "A-1"}, nil)
+				r.UpdateMock.Return(domain.ConcurrentModificationError(1))
+				r.ReleaseCellMock.Return(nil)
+				r.GetByIDMock.Return(Updated(Announced(1), domain.StatusInStorage, someConstTime), nil)
+				r.SavePickupCodeMock.Return(true, nil)
+			},
+			wantFailed: []uint64{1},
+			wantKinds:  map[uint64]domain.DiscrepancyKind{},
+			assertE:    assert.NoError,
+		},
+		{
+			name:      "Success_AlreadyConfirmedSkipped",
+			announced: []domain.Order{Updated(Announced(1), domain.StatusInStorage, someConstTime)},
+			arrived:   []uint64{1},
+			setup:     func(*mock.OrderRepositoryMock) {},
+			wantKinds: map[uint64]domain.DiscrepancyKind{},
+			assertE:   assert.NoError,
+		},
+		{
+			name:    "Fail_UnknownShipment",
+			arrived: []uint64{1},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.GetByShipmentIDMock.Expect(contextBack, domain.DefaultPVZID, someShipmentID).Return(tc.announced, nil)
+			tc.setup(repo)
+
+			report, err := svc.ConfirmArrival(context.Background(), someShipmentID, tc.arrived)
+			tc.assertE(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantConfirmed, report.Confirmed)
+			assert.Equal(t, tc.wantFailed, domain.FailedOrders(report.Results))
+			kinds := make(map[uint64]domain.DiscrepancyKind, len(report.Discrepancies))
+			for _, d := range report.Discrepancies {
+				kinds[d.OrderID] = d.Kind
+			}
+			assert.Equal(t, tc.wantKinds, kinds)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+const defaultReceiverSearchLimit = 20
+
+func (s *PVZService) UpsertReceiver(ctx context.Context, rec domain.Receiver) (domain.Receiver, error) {
+	phone, err := domain.NormalizePhone(rec.Phone)
+	if err != nil {
+		return domain.Receiver{}, fmt.Errorf("validation: %w", err)
+	}
+	rec.Phone = phone
+	rec.Name = strings.TrimSpace(rec.Name)
+	rec.UpdatedAt = s.nowFn()
+	if err := rec.Validate(); err != nil {
+		return domain.Receiver{}, fmt.Errorf("validation: %w", err)
+	}
+
+	saved, err := s.orderRepo.UpsertReceiver(ctx, rec)
+	if err != nil {
+		return domain.Receiver{}, fmt.Errorf("repo.UpsertReceiver: %w", err)
+	}
+	return saved, nil
+}
+
+// SearchReceivers ищет получателей по фрагменту телефона или имени
+func (s *PVZService) SearchReceivers(ctx context.Context, query string, limit uint64) ([]domain.Receiver, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("validation: %w", domain.ValidationFailedError("search query is required"))
+	}
+	if limit == 0 {
+		limit = defaultReceiverSearchLimit
+	}
+
+	list, err := s.orderRepo.SearchReceivers(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.SearchReceivers: %w", err)
+	}
+	return list, nil
+}
+
+func (s *PVZService) receiverIDByPhone(ctx context.Context, phone string) (uint64, error) {
+	normalized, err := domain.NormalizePhone(phone)
+	if err != nil {
+		return 0, fmt.Errorf("validation: %w", err)
+	}
+	rec, err := s.orderRepo.GetReceiverByPhone(ctx, normalized)
+	if err != nil {
+		return 0, fmt.Errorf("repo.GetReceiverByPhone: %w", err)
+	}
+	return rec.ID, nil
+}
+
+// saveEvent дополняет событие контактами получателя из справочника и кладет его в outbox;
+// получателя без записи в справочнике уведомление покажет только по id
+func (s *PVZService) saveEvent(ctx context.Context, tx *db.Tx, event domain.Event) error {
+	if event.Order.UserID != 0 && event.Order.Receiver == nil {
+		rec, err := s.orderRepo.GetReceiverInTx(ctx, tx, event.Order.UserID)
+		var domainErr domain.Error
+		switch {
+		case err == nil:
+			event.Order.Receiver = rec.Contact()
+		case errors.As(err, &domainErr) && domainErr.Code == domain.ErrorCodeNotFound:
+		default:
+			return fmt.Errorf("repo.GetReceiverInTx: %w", err)
+		}
+	}
+	return s.outboxRepo.Save(ctx, tx, event)
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func TestPVZService_UpsertReceiver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rec     domain.Receiver
+		setup   func(*mock.OrderRepositoryMock)
+		assertE assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success_NormalizesPhone",
+			rec:  domain.Receiver{ID: someRecieverID, Phone: "8 999 123-45-67", Name: "  Иван Петров "},
+			setup: func(r *mock.OrderRepositoryMock) {
+				want := domain.Receiver{ID: someRecieverID, Phone: "+79991234567", Name: "Иван Петров", UpdatedAt: someConstTime}
+				r.UpsertReceiverMock.Expect(contextBack, want).Return(want, nil)
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name:    "Fail_BadPhone",
+			rec:     domain.Receiver{ID: someRecieverID, Phone: "call me"},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+		{
+			name:    "Fail_NoID",
+			rec:     domain.Receiver{Phone: "+79991234567"},
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			tc.setup(repo)
+
+			_, err := svc.UpsertReceiver(context.Background(), tc.rec)
+			tc.assertE(t, err)
+		})
+	}
+}
+
+func TestPVZService_SearchReceivers(t *testing.T) {
+	t.Parallel()
+
+	repo, svc := NewEnv(t)
+	found := []domain.Receiver{{ID: someRecieverID, Phone: "+79991234567", Name: "Иван"}}
+	repo.SearchReceiversMock.Expect(contextBack, "иван", 20).Return(found, nil)
+
+	got, err := svc.SearchReceivers(context.Background(), " иван ", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, found, got)
+
+	_, err = svc.SearchReceivers(context.Background(), "  ", 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+// SweepExpiredOrders собирает в ведомость возврата все заказы пункта, которые пора отдать курьеру;
+// если таких нет, ведомость не создается и возвращается пустой
+func (s *PVZService) SweepExpiredOrders(ctx context.Context) (domain.ReturnManifest, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	now := s.nowFn()
+
+	orders, err := s.orderRepo.ListDueForReturn(ctx, pvzID, now)
+	if err != nil {
+		return domain.ReturnManifest{}, fmt.Errorf("repo.ListDueForReturn: %w", err)
+	}
+
+	manifest := domain.ReturnManifest{
+		PVZID:     pvzID,
+		Status:    domain.ManifestOpen,
+		CreatedAt: now,
+	}
+	for _, o := range orders {
+		if _, err := s.checkAction(ctx, o, domain.ActionReturnToCourier, now); err != nil {
+			continue
+		}
+		manifest.Items = append(manifest.Items, domain.ReturnManifestItem{
+			OrderID:      o.OrderID,
+			ReceiverID:   o.ReceiverID,
+			Status:       o.Status,
+			CellCode:     o.CellCode,
+			Weight:       o.Weight,
+			StorageUntil: o.StorageUntil,
+		})
+	}
+	if len(manifest.Items) == 0 {
+		return manifest, nil
+	}
+
+	saved, err := s.orderRepo.SaveReturnManifest(ctx, manifest)
+	if err != nil {
+		return domain.ReturnManifest{}, fmt.Errorf("repo.SaveReturnManifest: %w", err)
+	}
+	return saved, nil
+}
+
+func (s *PVZService) GetReturnManifest(ctx context.Context, id uint64) (domain.ReturnManifest, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	m, err := s.orderRepo.GetReturnManifest(ctx, id)
+	if err != nil {
+		return domain.ReturnManifest{}, fmt.Errorf("repo.GetReturnManifest: %w", err)
+	}
+	if m.PVZID != pvzID {
+		return domain.ReturnManifest{}, fmt.Errorf("validation: %w", domain.ValidationFailedError(
+			fmt.Sprintf("return manifest %d belongs to pickup point %d", id, m.PVZID)))
+	}
+	return m, nil
+}
+
+func (s *PVZService) ListReturnManifests(ctx context.Context) ([]domain.ReturnManifest, error) {
+	list, err := s.orderRepo.ListReturnManifests(ctx, domain.PVZIDFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListReturnManifests: %w", err)
+	}
+	return list, nil
+}
+
+func (s *PVZService) ExportReturnManifest(ctx context.Context, id uint64, format string) ([]byte, error) {
+	m, err := s.GetReturnManifest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := m.Export(strings.ToLower(strings.TrimSpace(format)))
+	if err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+	return data, nil
+}
+
+type manifestReturn struct {
+	order   domain.Order
+	cellID  uint64
+	history domain.OrderHistory
+	event   domain.Event
+}
+
+// HandOverReturnManifest передает курьеру все заказы ведомости одной транзакцией.
+// Заказы, которые после сборки успели выдать или продлить, из ведомости убираются
+func (s *PVZService) HandOverReturnManifest(ctx context.Context, id uint64) (domain.ReturnManifest, error) {
+	m, err := s.GetReturnManifest(ctx, id)
+	if err != nil {
+		return domain.ReturnManifest{}, err
+	}
+	if m.Status != domain.ManifestOpen {
+		return domain.ReturnManifest{}, fmt.Errorf("validation: %w", domain.ValidationFailedError(
+			fmt.Sprintf("return manifest %d is already handed over", id)))
+	}
+
+	now := s.nowFn()
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeSystem})
+	var (
+		returns []manifestReturn
+		kept    []domain.ReturnManifestItem
+		dropped []uint64
+	)
+	for _, it := range m.Items {
+		order, err := s.orderRepo.GetByID(ctx, it.OrderID)
+		if err != nil {
+			return domain.ReturnManifest{}, fmt.Errorf("repo.GetByID: %w", err)
+		}
+		next, err := s.checkAction(ctx, order, domain.ActionReturnToCourier, now)
+		if err != nil {
+			dropped = append(dropped, it.OrderID)
+			continue
+		}
+
+		cellID := order.CellID
+		prev := order.Status
+		order.Status = next
+		order.LastUpdateTime = now
+		order.CellID, order.CellCode = 0, ""
+
+		returns = append(returns, manifestReturn{
+			order:  order,
+			cellID: cellID,
+			history: domain.OrderHistory{
+				OrderID:    order.OrderID,
+				PVZID:      order.PVZID,
+				PrevStatus: &prev,
+				Status:     next,
+				ChangedAt:  now,
+				Actor:      actor,
+				Reason:     domain.ReturnReason(prev),
+				Comment:    fmt.Sprintf("return manifest %d", m.ID),
+			},
+			event: domain.NewEvent(
+				domain.EventTypeOrderReturnedToCourier,
+				order.PVZID,
+				actor,
+				domain.OrderInfo{
+					ID:         order.OrderID,
+					UserID:     order.ReceiverID,
+					Status:     "returned_to_courier",
+					ManifestID: m.ID,
+				},
+			),
+		})
+		kept = append(kept, it)
+	}
+
+	if s.dbClient == nil {
+		for _, r := range returns {
+			if err := s.orderRepo.Update(ctx, r.order); err != nil {
+				return domain.ReturnManifest{}, fmt.Errorf("repo.Update: %w", err)
+			}
+			if r.cellID != 0 {
+				if err := s.orderRepo.ReleaseCell(ctx, r.cellID); err != nil {
+					return domain.ReturnManifest{}, fmt.Errorf("repo.ReleaseCell: %w", err)
+				}
+			}
+			if err := s.orderRepo.SaveHistory(ctx, r.history); err != nil {
+				return domain.ReturnManifest{}, fmt.Errorf("repo.SaveHistory: %w", err)
+			}
+		}
+		for _, orderID := range dropped {
+			if err := s.orderRepo.RemoveManifestOrder(ctx, m.ID, orderID); err != nil {
+				return domain.ReturnManifest{}, fmt.Errorf("repo.RemoveManifestOrder: %w", err)
+			}
+		}
+		if err := s.orderRepo.MarkManifestHandedOver(ctx, m.ID, now); err != nil {
+			return domain.ReturnManifest{}, fmt.Errorf("repo.MarkManifestHandedOver: %w", err)
+		}
+	} else {
+		err = s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+			// статус ведомости меняем первым: параллельная передача той же ведомости упадет здесь
+			if err := s.orderRepo.MarkManifestHandedOverInTx(ctx, tx, m.ID, now); err != nil {
+				return fmt.Errorf("mark manifest: %w", err)
+			}
+
+			for _, r := range returns {
+				if err := s.orderRepo.UpdateOrderInTx(ctx, tx, r.order); err != nil {
+					return fmt.Errorf("update order: %w", err)
+				}
+
+				if r.cellID != 0 {
+					if err := s.orderRepo.ReleaseCellInTx(ctx, tx, r.cellID); err != nil {
+						return fmt.Errorf("release cell: %w", err)
+					}
+				}
+
+				if err := s.orderRepo.SaveHistoryInTx(ctx, tx, r.history); err != nil {
+					return fmt.Errorf("save history: %w", err)
+				}
+
+				if err := s.saveEvent(ctx, tx, r.event); err != nil {
+					return fmt.Errorf("save event: %w", err)
+				}
+			}
+
+			for _, orderID := range dropped {
+				if err := s.orderRepo.RemoveManifestOrderInTx(ctx, tx, m.ID, orderID); err != nil {
+					return fmt.Errorf("remove manifest order: %w", err)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return domain.ReturnManifest{}, err
+		}
+	}
+
+	s.metricsProvider.OrdersReturned("to_courier", uint64(len(returns)))
+	s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, m.PVZID)
+
+	m.Items = kept
+	m.Status = domain.ManifestHandedOver
+	m.HandedOverAt = now
+	return m, nil
+}
@@ -0,0 +1,184 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func ManifestOf(id uint64, status domain.ManifestStatus, orders ...domain.Order) domain.ReturnManifest {
+	m := domain.ReturnManifest{
+		ID:        id,
+		PVZID:     domain.DefaultPVZID,
+		Status:    status,
+		CreatedAt: someConstTime.Add(-time.Hour),
+	}
+	for _, o := range orders {
+		m.Items = append(m.Items, domain.ReturnManifestItem{
+			OrderID:      o.OrderID,
+			ReceiverID:   o.ReceiverID,
+			Status:       o.Status,
+			StorageUntil: o.StorageUntil,
+		})
+	}
+	return m
+}
+
+func TestPVZService_SweepExpiredOrders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		due       []domain.Order
+		setup     func(*mock.OrderRepositoryMock)
+		wantItems []uint64
+		assertE   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			due:  []domain.Order{OrderInStorage(1, -time.Hour), BuildOrder(2, domain.StatusReturnedFromClient, -time.Hour, 0)},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.SaveReturnManifestMock.Set(func(_ context.Context, m domain.ReturnManifest) (domain.ReturnManifest, error) {
+					if m.Status != domain.ManifestOpen || !m.CreatedAt.Equal(someConstTime) {
+						return m, fmt.Errorf("unexpected manifest %+v", m)
+					}
+					m.ID = 10
+					return m, nil
+				})
+			},
+			wantItems: []uint64{1, 2},
+			assertE:   assert.NoError,
+		},
+		{
+			// параллельная сборка уже забрала все заказы в свою ведомость
+			name: "Success_TakenConcurrently",
+			due:  []domain.Order{OrderInStorage(1, -time.Hour)},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.SaveReturnManifestMock.Set(func(_ context.Context, m domain.ReturnManifest) (domain.ReturnManifest, error) {
+					m.Items = nil
+					return m, nil
+				})
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name:    "Success_NothingDue",
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.NoError,
+		},
+		{
+			name: "Fail_Save",
+			due:  []domain.Order{OrderInStorage(1, -time.Hour)},
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.SaveReturnManifestMock.Return(domain.ReturnManifest{}, fmt.Errorf("db down"))
+			},
+			assertE: assert.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.ListDueForReturnMock.Expect(contextBack, domain.DefaultPVZID, someConstTime).Return(tc.due, nil)
+			tc.setup(repo)
+
+			got, err := svc.SweepExpiredOrders(context.Background())
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, tc.wantItems, IdsOfManifest(got))
+			}
+		})
+	}
+}
+
+func TestPVZService_HandOverReturnManifest(t *testing.T) {
+	t.Parallel()
+
+	expired := OrderInStorage(1, -time.Hour)
+	expired.CellID = 7
+	extended := OrderInStorage(2, 48*time.Hour)
+
+	tests := []struct {
+		name      string
+		manifest  domain.ReturnManifest
+		setup     func(*mock.OrderRepositoryMock)
+		wantItems []uint64
+		assertE   assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "Success",
+			manifest: ManifestOf(10, domain.ManifestOpen, expired),
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetByIDMock.Expect(contextBack, 1).Return(expired, nil)
+				returned := expired
+				returned.Status = domain.StatusReturnedWithoutClient
+				returned.LastUpdateTime = someConstTime
+				returned.CellID = 0
+				r.UpdateMock.Expect(contextBack, returned).Return(nil)
+				r.ReleaseCellMock.Expect(contextBack, 7).Return(nil)
+				hist := HistoryBy(History(1, domain.StatusReturnedWithoutClient, 0),
+					domain.Actor{Type: domain.ActorTypeSystem})
+				r.SaveHistoryMock.Expect(contextBack, Transition(hist, domain.StatusInStorage,
+					domain.ReasonStorageExpired, "return manifest 10")).Return(nil)
+				r.MarkManifestHandedOverMock.Expect(contextBack, 10, someConstTime).Return(nil)
+			},
+			wantItems: []uint64{1},
+			assertE:   assert.NoError,
+		},
+		{
+			name:     "Success_DropsNoLongerExpired",
+			manifest: ManifestOf(11, domain.ManifestOpen, extended),
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.GetByIDMock.Expect(contextBack, 2).Return(extended, nil)
+				r.RemoveManifestOrderMock.Expect(contextBack, 11, 2).Return(nil)
+				r.MarkManifestHandedOverMock.Expect(contextBack, 11, someConstTime).Return(nil)
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name:     "Fail_AlreadyHandedOver",
+			manifest: ManifestOf(12, domain.ManifestHandedOver, expired),
+			setup:    func(*mock.OrderRepositoryMock) {},
+			assertE:  assert.Error,
+		},
+		{
+			name: "Fail_OtherPVZ",
+			manifest: func() domain.ReturnManifest {
+				m := ManifestOf(13, domain.ManifestOpen, expired)
+				m.PVZID = 99
+				return m
+			}(),
+			setup:   func(*mock.OrderRepositoryMock) {},
+			assertE: assert.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.GetReturnManifestMock.Expect(contextBack, tc.manifest.ID).Return(tc.manifest, nil)
+			tc.setup(repo)
+
+			got, err := svc.HandOverReturnManifest(context.Background(), tc.manifest.ID)
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, domain.ManifestHandedOver, got.Status)
+				assert.Equal(t, tc.wantItems, IdsOfManifest(got))
+			}
+		})
+	}
+}
+
+func IdsOfManifest(m domain.ReturnManifest) []uint64 {
+	if len(m.Items) == 0 {
+		return nil
+	}
+	return m.OrderIDs()
+}
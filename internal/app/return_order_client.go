@@ -9,83 +9,159 @@ import (
 	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
-func (s *PVZService) returnSingle(ctx context.Context, receiverID uint64, orderID uint64, now time.Time) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
-	if err != nil {
-		return fmt.Errorf("repo.GetByID: %w", err)
-	}
+// returnPlan — проверенный возврат одного заказа от клиента, готовый к записи
+type returnPlan struct {
+	order       domain.Order
+	hist        domain.OrderHistory
+	event       domain.Event
+	refund      domain.Payment
+	refundEvent domain.Event
+	refunded    bool
+}
 
-	if order.ReceiverID != receiverID {
-		return domain.BelongsToDifferentReceiverError(orderID, receiverID, order.ReceiverID)
+// planReturn проверяет, что прочитанный заказ можно принять от клиента, и готовит все изменения
+func (s *PVZService) planReturn(ctx context.Context, order domain.Order, receiverID uint64, now time.Time) (returnPlan, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	orderID := order.OrderID
+	if order.PVZID != pvzID {
+		return returnPlan{}, domain.BelongsToDifferentPVZError(orderID, pvzID, order.PVZID)
 	}
-	if order.Status == domain.StatusInStorage || order.Status == domain.StatusReturnedFromClient {
-		return domain.AlreadyInStorageError(orderID)
+	if order.ReceiverID != receiverID {
+		return returnPlan{}, domain.BelongsToDifferentReceiverError(orderID, receiverID, order.ReceiverID)
 	}
-	if now.Sub(order.LastUpdateTime) > 48*time.Hour {
-		return domain.ReturnPeriodExpiredError(orderID, now.Sub(order.LastUpdateTime).Hours())
+	next, err := s.checkAction(ctx, order, domain.ActionReturnFromClient, now)
+	if err != nil {
+		return returnPlan{}, err
 	}
 
-	order.Status = domain.StatusReturnedFromClient
+	prev := order.Status
+	order.Status = next
 	order.LastUpdateTime = now
 
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeClient, ID: receiverID})
+
+	// деньги за оплаченный заказ возвращаем клиенту вместе с приемкой возврата
+	refund, refundEvent, refunded := refundFor(&order, actor, now)
+
 	hist := domain.OrderHistory{
-		OrderID:   orderID,
-		Status:    domain.StatusReturnedFromClient,
-		ChangedAt: now,
+		OrderID:    orderID,
+		PVZID:      pvzID,
+		PrevStatus: &prev,
+		Status:     next,
+		ChangedAt:  now,
+		Actor:      actor,
+		Reason:     domain.ReasonClientRefusal,
 	}
 
 	event := domain.NewEvent(
 		domain.EventTypeOrderReturnedByClient,
-		domain.Actor{
-			Type: domain.ActorTypeClient,
-			ID:   receiverID,
-		},
+		pvzID,
+		actor,
 		domain.OrderInfo{
 			ID:     orderID,
 			UserID: receiverID,
 			Status: "returned_by_client",
 		},
 	)
-	if s.dbClient == nil {
-		if err := s.orderRepo.Update(ctx, order); err != nil {
-			return fmt.Errorf("failed to update order: %w", err)
-		}
-		history := domain.OrderHistory{
-			OrderID:   order.OrderID,
-			Status:    order.Status,
-			ChangedAt: order.AcceptTime,
-		}
-		return s.orderRepo.SaveHistory(ctx, history)
+	return returnPlan{
+		order:       order,
+		hist:        hist,
+		event:       event,
+		refund:      refund,
+		refundEvent: refundEvent,
+		refunded:    refunded,
+	}, nil
+}
+
+func (p returnPlan) result() domain.OrderResult {
+	return domain.OrderResult{OrderID: p.order.OrderID, Status: &p.order.Status}
+}
+
+func (s *PVZService) applyReturn(ctx context.Context, p returnPlan) error {
+	if err := s.orderRepo.Update(ctx, p.order); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
 	}
-	return s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
-		if err := s.orderRepo.UpdateOrderInTx(ctx, tx, order); err != nil {
-			return fmt.Errorf("update order: %w", err)
+	if err := s.orderRepo.SaveHistory(ctx, p.hist); err != nil {
+		return err
+	}
+	if p.refunded {
+		if _, err := s.orderRepo.SavePayment(ctx, p.refund); err != nil {
+			return fmt.Errorf("repo.SavePayment: %w", err)
 		}
+	}
+	return nil
+}
 
-		if err := s.orderRepo.SaveHistoryInTx(ctx, tx, hist); err != nil {
-			return fmt.Errorf("save history: %w", err)
-		}
+func (s *PVZService) applyReturnInTx(ctx context.Context, tx *db.Tx, p returnPlan) error {
+	if err := s.orderRepo.UpdateOrderInTx(ctx, tx, p.order); err != nil {
+		return fmt.Errorf("update order: %w", err)
+	}
+
+	if err := s.orderRepo.SaveHistoryInTx(ctx, tx, p.hist); err != nil {
+		return fmt.Errorf("save history: %w", err)
+	}
+
+	if err := s.saveEvent(ctx, tx, p.event); err != nil {
+		return fmt.Errorf("save event: %w", err)
+	}
 
-		if err := s.outboxRepo.Save(ctx, tx, event); err != nil {
-			return fmt.Errorf("save event: %w", err)
+	if p.refunded {
+		if _, err := s.orderRepo.SavePaymentInTx(ctx, tx, p.refund); err != nil {
+			return fmt.Errorf("save refund: %w", err)
 		}
+		if err := s.saveEvent(ctx, tx, p.refundEvent); err != nil {
+			return fmt.Errorf("save refund event: %w", err)
+		}
+	}
 
-		return nil
-	})
+	return nil
 }
 
+func (s *PVZService) returnSingle(ctx context.Context, receiverID uint64, orderID uint64, now time.Time) domain.OrderResult {
+	plan := func(order domain.Order) (returnPlan, error) {
+		return s.planReturn(ctx, order, receiverID, now)
+	}
+	return processOne(ctx, s, orderID, plan, s.applyReturn, s.applyReturnInTx)
+}
+
+// ReturnOrdersFromClient принимает возврат каждого заказа независимо и возвращает результат по каждому
 func (s *PVZService) ReturnOrdersFromClient(
 	ctx context.Context,
 	receiverID uint64,
 	orderIDs []uint64,
-) error {
+) ([]domain.OrderResult, error) {
 	now := s.nowFn()
-	processed, err := processConcurrently(ctx, orderIDs, s.workerLimit, func(c context.Context, id uint64) error {
-		return s.returnSingle(c, receiverID, id, now)
+	results := processEach(ctx, orderIDs, s.workerLimit, func(c context.Context, id uint64) domain.OrderResult {
+		var res domain.OrderResult
+		_ = retryOnConflict(c, func() error {
+			res = s.returnSingle(c, receiverID, id, now)
+			return res.Err
+		})
+		return res
 	})
+	s.finishReturn(ctx, results)
+	return results, nil
+}
 
-	s.metricsProvider.OrdersReturned("by_client", processed)
-	s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo)
+// ReturnOrdersFromClientAtomic принимает возвраты по принципу «все или ничего»: если хотя бы
+// один заказ вернуть нельзя, не принимается ни один
+func (s *PVZService) ReturnOrdersFromClientAtomic(
+	ctx context.Context,
+	receiverID uint64,
+	orderIDs []uint64,
+) ([]domain.OrderResult, error) {
+	plan := func(order domain.Order, now time.Time) (returnPlan, error) {
+		return s.planReturn(ctx, order, receiverID, now)
+	}
+	results, err := processAtomically(ctx, s, orderIDs, plan, s.applyReturn, s.applyReturnInTx)
+	if err != nil {
+		return nil, err
+	}
+	s.finishReturn(ctx, results)
+	return results, nil
+}
 
-	return err
+func (s *PVZService) finishReturn(ctx context.Context, results []domain.OrderResult) {
+	s.metricsProvider.OrdersReturned("by_client", uint64(len(domain.SucceededOrders(results))))
+	s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, domain.PVZIDFromContext(ctx))
 }
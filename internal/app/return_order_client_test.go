@@ -31,6 +31,7 @@ func TestPVZService_ReturnOrdersFromClient(t *testing.T) {
 			name:     "Success_MultipleOrders",
 			orderIDs: []uint64{1, 2},
 			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				DefaultReturnPolicy(r)
 				r.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
 					switch id {
 					case 1:
@@ -95,12 +96,57 @@ func TestPVZService_ReturnOrdersFromClient(t *testing.T) {
 					return domain.Order{}, fmt.Errorf("unexpected id %d", id)
 				})
 			},
-			assertE: errIs(domain.AlreadyInStorageError(5)),
+			assertE: errIs(domain.InvalidTransitionError(5, "In Storage", "return_from_client")),
+		},
+		{
+			name:     "Fail_ReturnWindowFromIssueTime",
+			orderIDs: []uint64{8},
+			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				r.GetByIDMock.Return(OrderGiven(8, -1*time.Hour), nil)
+				r.ListReturnPoliciesMock.Return(nil, nil)
+				r.GetHistoryByOrderIDMock.Return([]domain.OrderHistory{
+					History(8, domain.StatusGivenToClient, -50*time.Hour),
+					History(8, domain.StatusInStorage, -60*time.Hour),
+				}, nil)
+			},
+			assertE: errIs(domain.ReturnPeriodExpiredError(8, 50, "default", 48)),
+		},
+		{
+			name:     "Success_SellerPolicyWindow",
+			orderIDs: []uint64{9},
+			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				order := OrderGiven(9, -100*time.Hour)
+				order.SellerID = 42
+				order.PackageType = "box"
+				r.GetByIDMock.Return(order, nil)
+				r.ListReturnPoliciesMock.Return([]domain.ReturnPolicy{
+					{Name: "boxes", PackageType: "box", Window: 24 * time.Hour, Returnable: true},
+					{Name: "seller-42", SellerID: 42, Window: 14 * 24 * time.Hour, Returnable: true},
+				}, nil)
+				r.GetHistoryByOrderIDMock.Return(nil, nil)
+				r.UpdateMock.Return(nil)
+				r.SaveHistoryMock.Return(nil)
+			},
+			assertE: assert.NoError,
+		},
+		{
+			name:     "Fail_NotReturnable",
+			orderIDs: []uint64{10},
+			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				order := OrderGiven(10, -1*time.Hour)
+				order.PackageType = "film"
+				r.GetByIDMock.Return(order, nil)
+				r.ListReturnPoliciesMock.Return([]domain.ReturnPolicy{
+					{Name: "hygiene", PackageType: "film", Returnable: false},
+				}, nil)
+			},
+			assertE: errIs(domain.NotReturnableError(10, "hygiene")),
 		},
 		{
 			name:     "Fail_UpdateError",
 			orderIDs: []uint64{6},
 			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				DefaultReturnPolicy(r)
 				r.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
 					if id == 6 {
 						return OrderGiven(6, -2*time.Hour), nil
@@ -120,6 +166,7 @@ func TestPVZService_ReturnOrdersFromClient(t *testing.T) {
 			name:     "Fail_SaveHistoryError",
 			orderIDs: []uint64{7},
 			setup: func(r *mock.OrderRepositoryMock, ctx context.Context) {
+				DefaultReturnPolicy(r)
 				r.GetByIDMock.Set(func(_ context.Context, id uint64) (domain.Order, error) {
 					if id == 7 {
 						return OrderGiven(7, -3*time.Hour), nil
@@ -151,8 +198,7 @@ func TestPVZService_ReturnOrdersFromClient(t *testing.T) {
 			defer cancel()
 			tc.setup(repo, ctx)
 
-			err := svc.ReturnOrdersFromClient(ctx, someRecieverID, tc.orderIDs)
-			tc.assertE(t, err)
+			tc.assertE(t, resultsErr(svc.ReturnOrdersFromClient(ctx, someRecieverID, tc.orderIDs)))
 		})
 	}
 }
@@ -4,45 +4,54 @@ import (
 	"context"
 	"fmt"
 
-	"gitlab.ozon.dev/safariproxd/homework/internal/adapter/cli"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
 func (s *PVZService) ReturnOrderToDelivery(ctx context.Context, orderID uint64) error {
+	// повтор перечитает заказ: если его уже вернули, переход не пройдет проверку
+	return retryOnConflict(ctx, func() error {
+		return s.returnToDelivery(ctx, orderID)
+	})
+}
+
+func (s *PVZService) returnToDelivery(ctx context.Context, orderID uint64) error {
+	pvzID := domain.PVZIDFromContext(ctx)
 	order, err := s.orderRepo.GetByID(ctx, orderID)
 	if err != nil {
 		return fmt.Errorf("repo.GetByID: %w", err)
 	}
 
-	if order.Status != domain.StatusInStorage && order.Status != domain.StatusReturnedFromClient {
-		return fmt.Errorf("validation: %w", domain.ValidationFailedError(
-			fmt.Sprintf("order is not in storage (current status: %s)", order.GetStatusString())))
-	}
-	if s.nowFn().Before(order.StorageUntil) {
-		return fmt.Errorf("validation: %w", domain.StorageNotExpiredError(
-			orderID, cli.MapTimeToString(order.StorageUntil)))
+	if order.PVZID != pvzID {
+		return fmt.Errorf("validation: %w", domain.BelongsToDifferentPVZError(orderID, pvzID, order.PVZID))
 	}
 
-	newStatus := domain.StatusReturnedWithoutClient
-	if order.Status == domain.StatusReturnedFromClient {
-		newStatus = domain.StatusGivenToCourier
+	now := s.nowFn()
+	newStatus, err := s.checkAction(ctx, order, domain.ActionReturnToCourier, now)
+	if err != nil {
+		return fmt.Errorf("validation: %w", err)
 	}
+	cellID := order.CellID
+	prev := order.Status
 	order.Status = newStatus
-	order.LastUpdateTime = s.nowFn()
+	order.LastUpdateTime = now
+	order.CellID, order.CellCode = 0, ""
 
+	actor := actorOr(ctx, domain.Actor{Type: domain.ActorTypeSystem})
 	history := domain.OrderHistory{
-		OrderID:   orderID,
-		Status:    newStatus,
-		ChangedAt: order.LastUpdateTime,
+		OrderID:    orderID,
+		PVZID:      pvzID,
+		PrevStatus: &prev,
+		Status:     newStatus,
+		ChangedAt:  order.LastUpdateTime,
+		Actor:      actor,
+		Reason:     domain.ReturnReason(prev),
 	}
 
 	event := domain.NewEvent(
 		domain.EventTypeOrderReturnedToCourier,
-		domain.Actor{
-			Type: domain.ActorTypeSystem,
-			ID:   0,
-		},
+		pvzID,
+		actor,
 		domain.OrderInfo{
 			ID:     orderID,
 			UserID: order.ReceiverID,
@@ -54,13 +63,18 @@ func (s *PVZService) ReturnOrderToDelivery(ctx context.Context, orderID uint64)
 		if err := s.orderRepo.Update(ctx, order); err != nil {
 			return fmt.Errorf("failed to update order: %w", err)
 		}
+		if cellID != 0 {
+			if err := s.orderRepo.ReleaseCell(ctx, cellID); err != nil {
+				return fmt.Errorf("failed to release cell: %w", err)
+			}
+		}
 
 		if err := s.orderRepo.SaveHistory(ctx, history); err != nil {
 			return fmt.Errorf("failed to save history: %w", err)
 		}
 
 		s.metricsProvider.OrdersReturned("to_courier", 1)
-		s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo)
+		s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, pvzID)
 		return nil
 	}
 
@@ -69,16 +83,22 @@ func (s *PVZService) ReturnOrderToDelivery(ctx context.Context, orderID uint64)
 			return fmt.Errorf("update order: %w", err)
 		}
 
+		if cellID != 0 {
+			if err := s.orderRepo.ReleaseCellInTx(ctx, tx, cellID); err != nil {
+				return fmt.Errorf("release cell: %w", err)
+			}
+		}
+
 		if err := s.orderRepo.SaveHistoryInTx(ctx, tx, history); err != nil {
 			return fmt.Errorf("save history: %w", err)
 		}
 
-		if err := s.outboxRepo.Save(ctx, tx, event); err != nil {
+		if err := s.saveEvent(ctx, tx, event); err != nil {
 			return fmt.Errorf("save event: %w", err)
 		}
 
 		s.metricsProvider.OrdersReturned("to_courier", 1)
-		s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo)
+		s.metricsProvider.RefreshOrderStatusMetrics(s.orderRepo, pvzID)
 		return nil
 	})
 }
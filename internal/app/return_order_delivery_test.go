@@ -45,7 +45,8 @@ func TestPVZService_ReturnOrderToDelivery(t *testing.T) {
 					return errors.New("unexpected update params")
 				})
 				r.SaveHistoryMock.Set(func(ctx context.Context, h domain.OrderHistory) error {
-					if h.OrderID == 1 && h.Status == domain.StatusReturnedWithoutClient {
+					if h.OrderID == 1 && h.Status == domain.StatusReturnedWithoutClient &&
+						*h.PrevStatus == domain.StatusInStorage && h.Reason == domain.ReasonStorageExpired {
 						return nil
 					}
 					return errors.New("unexpected history params")
@@ -73,7 +74,8 @@ func TestPVZService_ReturnOrderToDelivery(t *testing.T) {
 					return errors.New("unexpected update params")
 				})
 				r.SaveHistoryMock.Set(func(ctx context.Context, h domain.OrderHistory) error {
-					if h.OrderID == 2 && h.Status == domain.StatusGivenToCourier {
+					if h.OrderID == 2 && h.Status == domain.StatusGivenToCourier &&
+						*h.PrevStatus == domain.StatusReturnedFromClient && h.Reason == domain.ReasonClientReturn {
 						return nil
 					}
 					return errors.New("unexpected history params")
@@ -168,3 +170,103 @@ func TestPVZService_ReturnOrderToDelivery(t *testing.T) {
 		})
 	}
 }
+
+func TestPVZService_ReturnOrderToDelivery_Actor(t *testing.T) {
+	t.Parallel()
+
+	operator := domain.Actor{Type: domain.ActorTypeOperator, ID: 42}
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		wantActor domain.Actor
+	}{
+		{
+			name:      "FromContext",
+			ctx:       domain.WithActor(context.Background(), operator),
+			wantActor: operator,
+		},
+		{
+			name:      "DefaultSystem",
+			ctx:       context.Background(),
+			wantActor: domain.Actor{Type: domain.ActorTypeSystem},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.GetByIDMock.Return(OrderInStorage(1, -1*time.Hour), nil)
+			repo.UpdateMock.Return(nil)
+			repo.SaveHistoryMock.Set(func(_ context.Context, h domain.OrderHistory) error {
+				assert.Equal(t, tc.wantActor, h.Actor)
+				return nil
+			})
+
+			err := svc.ReturnOrderToDelivery(tc.ctx, 1)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPVZService_ReturnOrderToDelivery_Conflict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		conflicts   int
+		reread      domain.Order
+		wantUpdates int
+		assertE     assert.ErrorAssertionFunc
+	}{
+		{
+			name:        "RetriedAfterConflict",
+			conflicts:   1,
+			reread:      OrderInStorage(1, -1*time.Hour),
+			wantUpdates: 2,
+			assertE:     assert.NoError,
+		},
+		{
+			name:        "GivesUpAfterAttempts",
+			conflicts:   conflictAttempts,
+			reread:      OrderInStorage(1, -1*time.Hour),
+			wantUpdates: conflictAttempts,
+			assertE:     errIs(domain.ConcurrentModificationError(1)),
+		},
+		{
+			name:        "AlreadyReturnedByOther",
+			conflicts:   1,
+			reread:      domain.Order{OrderID: 1, ReceiverID: someRecieverID, Status: domain.StatusReturnedWithoutClient},
+			wantUpdates: 1,
+			assertE:     assert.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+
+			reads, updates := 0, 0
+			repo.GetByIDMock.Set(func(_ context.Context, _ uint64) (domain.Order, error) {
+				reads++
+				if reads == 1 {
+					return OrderInStorage(1, -1*time.Hour), nil
+				}
+				return tc.reread, nil
+			})
+			repo.UpdateMock.Set(func(_ context.Context, _ domain.Order) error {
+				updates++
+				if updates <= tc.conflicts {
+					return domain.ConcurrentModificationError(1)
+				}
+				return nil
+			})
+			repo.SaveHistoryMock.Optional().Return(nil)
+
+			err := svc.ReturnOrderToDelivery(context.Background(), 1)
+			tc.assertE(t, err)
+			assert.Equal(t, tc.wantUpdates, updates)
+		})
+	}
+}
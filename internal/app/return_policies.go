@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func (s *PVZService) SetReturnPolicy(ctx context.Context, policy domain.ReturnPolicy) (domain.ReturnPolicy, error) {
+	policy.Name = strings.TrimSpace(policy.Name)
+	if policy.Name == "" {
+		return domain.ReturnPolicy{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("return policy name is required"))
+	}
+	if policy.Window < 0 || policy.Window%time.Hour != 0 {
+		return domain.ReturnPolicy{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("return window must be a non-negative whole number of hours"))
+	}
+	if policy.PackageType != "" {
+		if _, err := s.orderRepo.GetPackageRules(ctx, policy.PackageType); err != nil {
+			return domain.ReturnPolicy{}, fmt.Errorf("validation: %w", domain.InvalidPackageError(policy.PackageType))
+		}
+	}
+
+	saved, err := s.orderRepo.SaveReturnPolicy(ctx, policy)
+	if err != nil {
+		return domain.ReturnPolicy{}, fmt.Errorf("repo.SaveReturnPolicy: %w", err)
+	}
+	return saved, nil
+}
+
+func (s *PVZService) ListReturnPolicies(ctx context.Context) ([]domain.ReturnPolicy, error) {
+	policies, err := s.orderRepo.ListReturnPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListReturnPolicies: %w", err)
+	}
+	return policies, nil
+}
+
+func (s *PVZService) checkReturnWindow(ctx context.Context, order domain.Order, now time.Time) error {
+	policies, err := s.orderRepo.ListReturnPolicies(ctx)
+	if err != nil {
+		return fmt.Errorf("repo.ListReturnPolicies: %w", err)
+	}
+	policy := domain.ResolveReturnPolicy(policies, order)
+	if !policy.Returnable {
+		return domain.NotReturnableError(order.OrderID, policy.Name)
+	}
+
+	issuedAt, err := s.issuedAt(ctx, order)
+	if err != nil {
+		return err
+	}
+	if since := now.Sub(issuedAt); since > policy.Window {
+		return domain.ReturnPeriodExpiredError(order.OrderID, since.Hours(), policy.Name, policy.Window.Hours())
+	}
+	return nil
+}
+
+// момент последней выдачи клиенту по истории; для заказов без истории — время последнего изменения
+func (s *PVZService) issuedAt(ctx context.Context, order domain.Order) (time.Time, error) {
+	history, err := s.orderRepo.GetHistoryByOrderID(ctx, order.OrderID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("repo.GetHistoryByOrderID: %w", err)
+	}
+
+	var issuedAt time.Time
+	for _, h := range history {
+		if h.Status == domain.StatusGivenToClient && h.ChangedAt.After(issuedAt) {
+			issuedAt = h.ChangedAt
+		}
+	}
+	if issuedAt.IsZero() {
+		return order.LastUpdateTime, nil
+	}
+	return issuedAt, nil
+}
@@ -2,28 +2,98 @@ package app
 
 import (
 	"context"
-	"sync/atomic"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/internal/metrics"
 	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
-	"golang.org/x/sync/errgroup"
 )
 
 type OrderRepository interface {
 	Save(ctx context.Context, order domain.Order) error
 	GetByID(ctx context.Context, orderID uint64) (domain.Order, error)
 	Update(ctx context.Context, order domain.Order) error
-	GetByReceiverID(ctx context.Context, receiverID uint64) ([]domain.Order, error)
-	GetReturnedOrders(ctx context.Context) ([]domain.Order, error)
-	GetAllOrders(ctx context.Context) ([]domain.Order, error)
-	GetPackageRules(ctx context.Context, code string) ([]domain.PackageRules, error)
+	GetByReceiverID(ctx context.Context, pvzID, receiverID uint64) ([]domain.Order, error)
+	GetAllOrders(ctx context.Context, pvzID uint64) ([]domain.Order, error)
+	CountOrdersByStatus(ctx context.Context, pvzID uint64) (map[domain.OrderStatus]uint64, error)
+	ListOrdersByID(ctx context.Context, f domain.OrderListFilter, q domain.OrderPageQuery) ([]domain.Order, error)
+	ListOrdersByUpdate(ctx context.Context, f domain.OrderListFilter, q domain.OrderPageQuery) ([]domain.Order, error)
+	CountOrders(ctx context.Context, f domain.OrderListFilter) (uint64, error)
+	ExportOrders(ctx context.Context, f domain.OrderExportFilter, emit func(domain.Order) error) error
+	GetPackageRules(ctx context.Context, code string) (domain.PackageRules, error)
+	SavePackageType(ctx context.Context, p domain.PackageType) error
+	UpdatePackageType(ctx context.Context, p domain.PackageType) error
+	DeletePackageType(ctx context.Context, code string) error
+	ListPackageTypes(ctx context.Context) ([]domain.PackageType, error)
+	SaveStorageFee(ctx context.Context, f domain.StorageFee) error
+	SaveStorageFeeInTx(ctx context.Context, tx *db.Tx, f domain.StorageFee) error
+	SavePayment(ctx context.Context, p domain.Payment) (domain.Payment, error)
+	SavePaymentInTx(ctx context.Context, tx *db.Tx, p domain.Payment) (domain.Payment, error)
+	GetByShipmentID(ctx context.Context, pvzID uint64, shipmentID string) ([]domain.Order, error)
+	SaveDiscrepancy(ctx context.Context, d domain.ArrivalDiscrepancy) (bool, error)
+	SaveDiscrepancyInTx(ctx context.Context, tx *db.Tx, d domain.ArrivalDiscrepancy) (bool, error)
+	ResolveMissing(ctx context.Context, pvzID uint64, shipmentID string, orderID uint64) error
+	ResolveMissingInTx(ctx context.Context, tx *db.Tx, pvzID uint64, shipmentID string, orderID uint64) error
+	ListDiscrepancies(ctx context.Context, pvzID uint64, shipmentID string) ([]domain.ArrivalDiscrepancy, error)
+	ListDueForReturn(ctx context.Context, pvzID uint64, now time.Time) ([]domain.Order, error)
+	SaveReturnManifest(ctx context.Context, m domain.ReturnManifest) (domain.ReturnManifest, error)
+	GetReturnManifest(ctx context.Context, id uint64) (domain.ReturnManifest, error)
+	ListReturnManifests(ctx context.Context, pvzID uint64) ([]domain.ReturnManifest, error)
+	RemoveManifestOrder(ctx context.Context, manifestID, orderID uint64) error
+	RemoveManifestOrderInTx(ctx context.Context, tx *db.Tx, manifestID, orderID uint64) error
+	MarkManifestHandedOver(ctx context.Context, id uint64, at time.Time) error
+	MarkManifestHandedOverInTx(ctx context.Context, tx *db.Tx, id uint64, at time.Time) error
+	UpsertReceiver(ctx context.Context, rec domain.Receiver) (domain.Receiver, error)
+	GetReceiver(ctx context.Context, id uint64) (domain.Receiver, error)
+	GetReceiverInTx(ctx context.Context, tx *db.Tx, id uint64) (domain.Receiver, error)
+	GetReceiverByPhone(ctx context.Context, phone string) (domain.Receiver, error)
+	SearchReceivers(ctx context.Context, query string, limit uint64) ([]domain.Receiver, error)
+	AppendAuditRecord(ctx context.Context, rec domain.AuditRecord) (domain.AuditRecord, error)
+	SearchAuditLog(ctx context.Context, f domain.AuditFilter) ([]domain.AuditRecord, error)
+	ListAuditChain(ctx context.Context, afterID, limit uint64) ([]domain.AuditRecord, error)
+	ReserveIdempotencyKey(
+		ctx context.Context,
+		rec domain.IdempotencyRecord,
+		expiredBefore, staleBefore time.Time,
+	) (domain.IdempotencyRecord, bool, error)
+	CompleteIdempotencyKey(ctx context.Context, rec domain.IdempotencyRecord) (bool, error)
+	ReleaseIdempotencyKey(ctx context.Context, pvzID uint64, key, method string, claimedAt time.Time) error
+	PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
+	SaveImportJob(ctx context.Context, job domain.ImportJob, orders []domain.OrderToImport) (domain.ImportJob, error)
+	GetImportJob(ctx context.Context, id uint64) (domain.ImportJob, error)
+	ListUnfinishedImportJobs(ctx context.Context, staleBefore time.Time) ([]domain.ImportJob, error)
+	ClaimImportJob(ctx context.Context, id uint64, owner string, at, staleBefore time.Time) (bool, error)
+	ReleaseImportJob(ctx context.Context, id uint64, owner string) error
+	ListImportJobRows(ctx context.Context, jobID, afterRow, limit uint64) ([]domain.ImportRow, error)
+	UpdateImportJobStatus(ctx context.Context, id uint64, from []domain.ImportJobStatus, to domain.ImportJobStatus, errMsg string, at time.Time) (bool, error)
+	CommitImportJobProgress(ctx context.Context, p domain.ImportJobProgress) (bool, error)
 	SaveHistory(ctx context.Context, history domain.OrderHistory) error
 	GetHistoryByOrderID(ctx context.Context, orderID uint64) ([]domain.OrderHistory, error)
 	UpdateOrderInTx(ctx context.Context, tx *db.Tx, order domain.Order) error
 	SaveOrderInTx(ctx context.Context, tx *db.Tx, order domain.Order) error
 	SaveHistoryInTx(ctx context.Context, tx *db.Tx, history domain.OrderHistory) error
+	SavePickupPoint(ctx context.Context, point domain.PickupPoint) (domain.PickupPoint, error)
+	GetPickupPoint(ctx context.Context, pvzID uint64) (domain.PickupPoint, error)
+	ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error)
+	SaveReturnPolicy(ctx context.Context, policy domain.ReturnPolicy) (domain.ReturnPolicy, error)
+	ListReturnPolicies(ctx context.Context) ([]domain.ReturnPolicy, error)
+	SaveStorageCell(ctx context.Context, cell domain.StorageCell) (domain.StorageCell, error)
+	ListStorageCells(ctx context.Context, pvzID uint64) ([]domain.StorageCell, error)
+	OccupyCell(ctx context.Context, pvzID uint64, size domain.CellSize) (domain.StorageCell, error)
+	OccupyCellByCode(ctx context.Context, pvzID uint64, code string) (domain.StorageCell, error)
+	ReleaseCell(ctx context.Context, cellID uint64) error
+	OccupyCellInTx(ctx context.Context, tx *db.Tx, pvzID uint64, size domain.CellSize) (domain.StorageCell, error)
+	OccupyCellByCodeInTx(ctx context.Context, tx *db.Tx, pvzID uint64, code string) (domain.StorageCell, error)
+	ReleaseCellInTx(ctx context.Context, tx *db.Tx, cellID uint64) error
+	SavePickupCode(ctx context.Context, code domain.PickupCode) (bool, error)
+	SavePickupCodeInTx(ctx context.Context, tx *db.Tx, code domain.PickupCode) (bool, error)
+	GetPickupCode(ctx context.Context, pvzID, receiverID uint64) (domain.PickupCode, error)
+	RegisterPickupCodeFailure(ctx context.Context, pvzID, receiverID uint64, maxAttempts uint32, lockUntil time.Time) (domain.PickupCode, error)
+	ResetPickupCodeFailures(ctx context.Context, pvzID, receiverID uint64) error
+	DeletePickupCode(ctx context.Context, pvzID, receiverID uint64) error
 }
 
 type OutboxRepository interface {
@@ -37,6 +107,22 @@ type PVZService struct {
 	nowFn           func() time.Time
 	workerLimit     int
 	metricsProvider metrics.MetricsProvider
+
+	storageExtension domain.StorageExtensionPolicy
+	pickupCodes      domain.PickupCodePolicy
+	storageFees      domain.StorageFeePolicy
+	idempotencyTTL   time.Duration
+	// через сколько незавершенный запрос с ключом идемпотентности считается брошенным
+	idempotencyLock time.Duration
+
+	jobPool JobPool
+	// имя этого экземпляра как владельца задач импорта
+	instanceID string
+	// отмена задач импорта, которые выполняет этот экземпляр
+	importJobsMu sync.Mutex
+	importJobs   map[uint64]context.CancelFunc
+	// после остановки сервиса задачи импорта в пул больше не ставятся
+	importJobsStopped bool
 }
 
 func NewPVZService(
@@ -60,62 +146,217 @@ func NewPVZService(
 		nowFn:           nowFn,
 		workerLimit:     limit,
 		metricsProvider: metricsProvider,
+
+		storageExtension: domain.DefaultStorageExtensionPolicy,
+		pickupCodes:      domain.DefaultPickupCodePolicy,
+		idempotencyTTL:   domain.DefaultIdempotencyTTL,
+		idempotencyLock:  domain.DefaultIdempotencyLockTimeout,
+
+		instanceID: newInstanceID(),
+		importJobs: make(map[uint64]context.CancelFunc),
 	}
 }
 
-func Paginate[T any](items []T, currentPage, itemsPerPage uint64) []T {
-	totalItems := uint64(len(items))
+func (s *PVZService) SetStorageExtensionPolicy(policy domain.StorageExtensionPolicy) {
+	s.storageExtension = policy
+}
 
-	if itemsPerPage == 0 {
-		return []T{}
-	}
-	if currentPage == 0 {
-		currentPage = 1
-	}
+func (s *PVZService) SetPickupCodePolicy(policy domain.PickupCodePolicy) {
+	s.pickupCodes = policy
+}
 
-	startIndex := (currentPage - 1) * itemsPerPage
-	endIndex := startIndex + itemsPerPage
+func (s *PVZService) SetStorageFeePolicy(policy domain.StorageFeePolicy) {
+	s.storageFees = policy
+}
 
-	if startIndex >= totalItems {
-		return []T{}
-	}
-	if endIndex > totalItems {
-		endIndex = totalItems
-	}
+func (s *PVZService) SetIdempotencyTTL(ttl time.Duration) {
+	s.idempotencyTTL = ttl
+}
 
-	return items[startIndex:endIndex]
+func (s *PVZService) SetIdempotencyLockTimeout(timeout time.Duration) {
+	s.idempotencyLock = timeout
 }
 
-func processConcurrently[T any](
-	parentCtx context.Context,
+// processEach обрабатывает каждый элемент независимо: ошибка одного не останавливает остальные.
+// Результаты идут в порядке входных элементов
+func processEach[T any](
+	ctx context.Context,
 	items []T,
 	workerLimit int,
-	fn func(context.Context, T) error,
-) (uint64, error) {
-	g, ctx := errgroup.WithContext(parentCtx)
+	fn func(context.Context, T) domain.OrderResult,
+) []domain.OrderResult {
+	results := make([]domain.OrderResult, len(items))
 	sem := make(chan struct{}, workerLimit)
 
-	var processed uint64
-
-	for _, item := range items {
-		g.Go(func() error {
-			select {
-			case sem <- struct{}{}:
-			case <-ctx.Done():
-				return ctx.Err()
-			}
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
 			defer func() {
 				<-sem
 			}()
 
-			if err := fn(ctx, item); err != nil {
-				return err
+			results[i] = fn(ctx, item)
+		}()
+	}
+
+	wg.Wait()
+	return results
+}
+
+// orderPlan — проверенное изменение одного заказа, готовое к записи
+type orderPlan interface {
+	result() domain.OrderResult
+}
+
+// processOne читает заказ, проверяет переход через plan и записывает изменения отдельной транзакцией
+func processOne[P orderPlan](
+	ctx context.Context,
+	s *PVZService,
+	orderID uint64,
+	plan func(domain.Order) (P, error),
+	apply func(context.Context, P) error,
+	applyInTx func(context.Context, *db.Tx, P) error,
+) domain.OrderResult {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return domain.FailedOrderResult(orderID, nil, fmt.Errorf("repo.GetByID: %w", err))
+	}
+	p, err := plan(order)
+	if err != nil {
+		return domain.FailedOrderResult(orderID, &order.Status, err)
+	}
+
+	if s.dbClient == nil {
+		err = apply(ctx, p)
+	} else {
+		err = s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+			return applyInTx(ctx, tx, p)
+		})
+	}
+	if err != nil {
+		return domain.FailedOrderResult(orderID, &order.Status, err)
+	}
+	return p.result()
+}
+
+// processAtomically проверяет все заказы и записывает их одной транзакцией. Если хотя бы один
+// не прошел проверку, не пишется ни один, а прошедшие получают BatchAbortedError.
+// Ошибка записи относится ко всей пачке и возвращается отдельно от результатов
+func processAtomically[P orderPlan](
+	ctx context.Context,
+	s *PVZService,
+	orderIDs []uint64,
+	plan func(domain.Order, time.Time) (P, error),
+	apply func(context.Context, P) error,
+	applyInTx func(context.Context, *db.Tx, P) error,
+) ([]domain.OrderResult, error) {
+	if err := checkDistinctOrders(orderIDs); err != nil {
+		return nil, err
+	}
+
+	var results []domain.OrderResult
+	// откатывается вся пачка, поэтому при конфликте версий ее можно целиком проверить и записать заново
+	err := retryOnConflict(ctx, func() error {
+		now := s.nowFn()
+		results = make([]domain.OrderResult, len(orderIDs))
+		statuses := make([]domain.OrderStatus, len(orderIDs))
+		plans := make([]P, 0, len(orderIDs))
+		failed := false
+		for i, id := range orderIDs {
+			order, err := s.orderRepo.GetByID(ctx, id)
+			if err != nil {
+				results[i] = domain.FailedOrderResult(id, nil, fmt.Errorf("repo.GetByID: %w", err))
+				failed = true
+				continue
+			}
+			statuses[i] = order.Status
+			p, err := plan(order, now)
+			if err != nil {
+				results[i] = domain.FailedOrderResult(id, &statuses[i], err)
+				failed = true
+				continue
+			}
+			results[i] = p.result()
+			plans = append(plans, p)
+		}
+		if failed {
+			for i, r := range results {
+				if r.OK() {
+					results[i] = domain.FailedOrderResult(r.OrderID, &statuses[i], domain.BatchAbortedError(r.OrderID))
+				}
+			}
+			return nil
+		}
+
+		if s.dbClient == nil {
+			for _, p := range plans {
+				if err := apply(ctx, p); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+			for _, p := range plans {
+				if err := applyInTx(ctx, tx, p); err != nil {
+					return err
+				}
 			}
-			atomic.AddUint64(&processed, 1)
 			return nil
 		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// actorOr возвращает исполнителя из контекста запроса, а без него — исполнителя операции по умолчанию
+func actorOr(ctx context.Context, fallback domain.Actor) domain.Actor {
+	if actor, ok := domain.ActorFromContext(ctx); ok {
+		return actor
+	}
+	return fallback
+}
+
+const (
+	conflictAttempts = 3
+	conflictBackoff  = 10 * time.Millisecond
+)
+
+// retryOnConflict повторяет операцию, пока заказ меняют параллельно. fn должна сама
+// перечитывать заказ и заново проверять переход: повтор со старой копией упрется в ту же версию
+func retryOnConflict(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		var domainErr domain.Error
+		if !errors.As(err, &domainErr) || domainErr.Code != domain.ErrorCodeConcurrentModification ||
+			attempt == conflictAttempts {
+			return err
+		}
+
+		select {
+		case <-time.After(time.Duration(attempt) * conflictBackoff):
+		case <-ctx.Done():
+			return err
+		}
 	}
+}
 
-	err := g.Wait()
-	return processed, err
+// checkDistinctOrders не дает указать заказ дважды: в одной транзакции второе изменение
+// того же заказа упрется в уже увеличенную версию
+func checkDistinctOrders(orderIDs []uint64) error {
+	seen := make(map[uint64]struct{}, len(orderIDs))
+	for _, id := range orderIDs {
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("validation: %w", domain.ValidationFailedError(
+				fmt.Sprintf("Order %d is listed more than once", id)))
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
 }
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (s *PVZService) CreateStorageCell(ctx context.Context, code string, size domain.CellSize, capacity uint32) (domain.StorageCell, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return domain.StorageCell{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("storage cell code is required"))
+	}
+	if capacity == 0 {
+		return domain.StorageCell{}, fmt.Errorf("validation: %w", domain.ValidationFailedError("storage cell capacity must be positive"))
+	}
+
+	cell, err := s.orderRepo.SaveStorageCell(ctx, domain.StorageCell{
+		PVZID:    domain.PVZIDFromContext(ctx),
+		Code:     code,
+		Size:     size,
+		Capacity: capacity,
+	})
+	if err != nil {
+		return domain.StorageCell{}, fmt.Errorf("repo.SaveStorageCell: %w", err)
+	}
+	return cell, nil
+}
+
+func (s *PVZService) ListStorageCells(ctx context.Context) ([]domain.StorageCell, error) {
+	cells, err := s.orderRepo.ListStorageCells(ctx, domain.PVZIDFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListStorageCells: %w", err)
+	}
+	return cells, nil
+}
+
+// MoveOrder перекладывает заказ, который физически лежит в ПВЗ, в другую ячейку
+func (s *PVZService) MoveOrder(ctx context.Context, orderID uint64, cellCode string) (domain.Order, error) {
+	pvzID := domain.PVZIDFromContext(ctx)
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("repo.GetByID: %w", err)
+	}
+
+	if order.PVZID != pvzID {
+		return domain.Order{}, domain.BelongsToDifferentPVZError(orderID, pvzID, order.PVZID)
+	}
+	if order.Status != domain.StatusInStorage && order.Status != domain.StatusReturnedFromClient {
+		return domain.Order{}, fmt.Errorf("validation: %w", domain.ValidationFailedError(
+			fmt.Sprintf("order %d is not at the pickup point (current status: %s)", orderID, order.GetStatusString())))
+	}
+	if order.CellCode == cellCode {
+		return order, nil
+	}
+
+	oldCellID := order.CellID
+	if s.dbClient == nil {
+		cell, err := s.orderRepo.OccupyCellByCode(ctx, pvzID, cellCode)
+		if err != nil {
+			return domain.Order{}, fmt.Errorf("repo.OccupyCellByCode: %w", err)
+		}
+		order.CellID, order.CellCode = cell.ID, cell.Code
+		if err := s.orderRepo.Update(ctx, order); err != nil {
+			_ = s.orderRepo.ReleaseCell(ctx, cell.ID)
+			return domain.Order{}, fmt.Errorf("repo.Update: %w", err)
+		}
+		if oldCellID != 0 {
+			if err := s.orderRepo.ReleaseCell(ctx, oldCellID); err != nil {
+				return domain.Order{}, fmt.Errorf("repo.ReleaseCell: %w", err)
+			}
+		}
+		return order, nil
+	}
+
+	err = s.dbClient.WithTransaction(ctx, func(tx *db.Tx) error {
+		cell, err := s.orderRepo.OccupyCellByCodeInTx(ctx, tx, pvzID, cellCode)
+		if err != nil {
+			return fmt.Errorf("occupy cell: %w", err)
+		}
+		order.CellID, order.CellCode = cell.ID, cell.Code
+
+		if oldCellID != 0 {
+			if err := s.orderRepo.ReleaseCellInTx(ctx, tx, oldCellID); err != nil {
+				return fmt.Errorf("release cell: %w", err)
+			}
+		}
+
+		if err := s.orderRepo.UpdateOrderInTx(ctx, tx, order); err != nil {
+			return fmt.Errorf("update order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+	return order, nil
+}
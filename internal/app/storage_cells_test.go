@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+)
+
+func TestPVZService_MoveOrder(t *testing.T) {
+	t.Parallel()
+
+	inCell := func(o domain.Order, id uint64, code string) domain.Order {
+		o.CellID, o.CellCode = id, code
+		return o
+	}
+
+	tests := []struct {
+		name     string
+		order    domain.Order
+		cellCode string
+		setup    func(*mock.OrderRepositoryMock)
+		wantCell string
+		assertE  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "Success_ReleasesOldCell",
+			order:    inCell(OrderInStorage(1, 24*time.Hour), 3, "S-03"),
+			cellCode: "M-01",
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.OccupyCellByCodeMock.Expect(contextBack, domain.DefaultPVZID, "M-01").
+					Return(domain.StorageCell{ID: 21, Code: "M-01"}, nil)
+				r.UpdateMock.Expect(contextBack, inCell(OrderInStorage(1, 24*time.Hour), 21, "M-01")).Return(nil)
+				r.ReleaseCellMock.Expect(contextBack, 3).Return(nil)
+			},
+			wantCell: "M-01",
+			assertE:  assert.NoError,
+		},
+		{
+			name:     "Success_SameCell",
+			order:    inCell(OrderInStorage(2, 24*time.Hour), 3, "S-03"),
+			cellCode: "S-03",
+			setup:    func(*mock.OrderRepositoryMock) {},
+			wantCell: "S-03",
+			assertE:  assert.NoError,
+		},
+		{
+			name:     "Fail_CellFull",
+			order:    inCell(OrderInStorage(3, 24*time.Hour), 3, "S-03"),
+			cellCode: "S-04",
+			setup: func(r *mock.OrderRepositoryMock) {
+				r.OccupyCellByCodeMock.Expect(contextBack, domain.DefaultPVZID, "S-04").
+					Return(domain.StorageCell{}, domain.CellUnavailableError("S-04"))
+			},
+			assertE: errIs(domain.CellUnavailableError("S-04")),
+		},
+		{
+			name:     "Fail_NotAtPickupPoint",
+			order:    OrderGiven(4, 0),
+			cellCode: "S-01",
+			setup:    func(*mock.OrderRepositoryMock) {},
+			assertE: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorContains(t, err, fmt.Sprintf("order %d is not at the pickup point", 4))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, svc := NewEnv(t)
+			repo.GetByIDMock.Expect(contextBack, tc.order.OrderID).Return(tc.order, nil)
+			tc.setup(repo)
+
+			got, err := svc.MoveOrder(context.Background(), tc.order.OrderID, tc.cellCode)
+			tc.assertE(t, err)
+			if err == nil {
+				assert.Equal(t, tc.wantCell, got.CellCode)
+			}
+		})
+	}
+}
@@ -11,18 +11,21 @@ import (
 	"gitlab.ozon.dev/safariproxd/homework/internal/app/mock"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/internal/metrics"
+	"go.uber.org/multierr"
 )
 
 var (
 	someConstTime  = time.Date(2025, time.June, 28, 3, 26, 0, 0, time.UTC)
 	contextBack    = context.Background()
 	someRecieverID = uint64(100)
+	somePickupCode = "123456"
 )
 
 func Stored(id uint64, status domain.OrderStatus) domain.Order {
 	return domain.Order{
 		OrderID:        id,
 		ReceiverID:     someRecieverID,
+		PVZID:          domain.DefaultPVZID,
 		StorageUntil:   someConstTime.Add(24 * time.Hour),
 		Status:         status,
 		LastUpdateTime: someConstTime.Add(time.Duration(int64(id)) * time.Minute),
@@ -79,11 +82,27 @@ func History(orderID uint64, status domain.OrderStatus,
 
 	return domain.OrderHistory{
 		OrderID:   orderID,
+		PVZID:     domain.DefaultPVZID,
 		Status:    status,
 		ChangedAt: someConstTime.Add(changedOff),
 	}
 }
 
+func HistoryBy(h domain.OrderHistory, actor domain.Actor) domain.OrderHistory {
+	h.Actor = actor
+	return h
+}
+
+// Transition дополняет запись истории подробностями перехода
+func Transition(h domain.OrderHistory, prev domain.OrderStatus,
+	reason domain.HistoryReason, comment string) domain.OrderHistory {
+
+	h.PrevStatus = &prev
+	h.Reason = reason
+	h.Comment = comment
+	return h
+}
+
 func TimesOf(h []domain.OrderHistory) (ts []time.Time) {
 	for _, rec := range h {
 		ts = append(ts, rec.ChangedAt)
@@ -97,13 +116,45 @@ func DTO(id uint64, pkg string, off time.Duration) domain.OrderToImport {
 		ReceiverID:   someRecieverID,
 		StorageUntil: DateString(off),
 		PackageType:  pkg,
-		Weight:       5,
-		Price:        100,
+		Weight:       5 * domain.Kilogram,
+		Price:        100 * domain.Ruble,
 	}
 }
 
+// политик возврата нет, истории выдачи тоже: окно считается от LastUpdateTime по умолчанию
+func DefaultReturnPolicy(r *mock.OrderRepositoryMock) {
+	r.ListReturnPoliciesMock.Return(nil, nil)
+	r.GetHistoryByOrderIDMock.Return(nil, nil)
+}
+
+func StoredPickupCode(failedAttempts uint32, lockedUntil time.Time) domain.PickupCode {
+	return domain.PickupCode{
+		PVZID:          domain.DefaultPVZID,
+		ReceiverID:     someRecieverID,
+		CodeHash:       domain.DefaultPickupCodePolicy.Hash(domain.DefaultPVZID, someRecieverID, somePickupCode),
+		FailedAttempts: failedAttempts,
+		LockedUntil:    lockedUntil,
+	}
+}
+
+// у получателя есть действующий код somePickupCode без неудачных попыток
+func ValidPickupCode(r *mock.OrderRepositoryMock) {
+	r.GetPickupCodeMock.Return(StoredPickupCode(0, time.Time{}), nil)
+}
+
 func errIs(target error) assert.ErrorAssertionFunc {
 	return func(t assert.TestingT, err error, _ ...interface{}) bool {
 		return assert.ErrorIs(t, err, target)
 	}
 }
+
+// resultsErr — ошибка всего вызова, а без нее ошибки отдельных заказов одной multierr
+func resultsErr(results []domain.OrderResult, err error) error {
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		err = multierr.Append(err, r.Err)
+	}
+	return err
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/caarlos0/env/v10"
 	"github.com/pkg/errors"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/internal/infra/telegram"
 	"gopkg.in/yaml.v3"
 )
@@ -20,6 +21,47 @@ type Config struct {
 		Timeout        time.Duration `yaml:"timeout"`
 		WorkerLimit    int           `yaml:"worker_limit"`
 		QueueSize      int           `yaml:"queue_size"`
+		DefaultPVZID   uint64        `yaml:"default_pvz_id"`
+
+		StorageExtension struct {
+			MaxDays   uint32       `yaml:"max_days"`
+			FeePerDay domain.Money `yaml:"fee_per_day"`
+		} `yaml:"storage_extension"`
+
+		PickupCode struct {
+			MaxAttempts     uint32        `yaml:"max_attempts"`
+			LockoutDuration time.Duration `yaml:"lockout_duration"`
+			Secret          string        `yaml:"-" env:"PICKUP_CODE_SECRET"`
+		} `yaml:"pickup_code"`
+
+		StorageFee struct {
+			FreeDays      uint32       `yaml:"free_days"`
+			DefaultPerDay domain.Money `yaml:"default_per_day"`
+			Rates         []struct {
+				PackageType string        `yaml:"package"`
+				MinWeight   domain.Weight `yaml:"min_weight"`
+				PerDay      domain.Money  `yaml:"per_day"`
+			} `yaml:"rates"`
+		} `yaml:"storage_fee"`
+
+		// 0 — автоматическая сборка ведомостей возврата выключена
+		ReturnSweep struct {
+			Interval time.Duration `yaml:"interval"`
+		} `yaml:"return_sweep"`
+
+		// фоновые задачи импорта выполняются на своем пуле и не занимают воркеры запросов;
+		// задача, не поместившаяся в очередь, ждет в базе следующего ResumeImportJobs
+		ImportJobs struct {
+			Workers   int `yaml:"workers"`
+			QueueSize int `yaml:"queue_size"`
+		} `yaml:"import_jobs"`
+
+		// сколько хранятся ответы на запросы с ключом идемпотентности
+		// и через сколько незавершенный запрос уступает ключ повтору
+		Idempotency struct {
+			TTL         time.Duration `yaml:"ttl"`
+			LockTimeout time.Duration `yaml:"lock_timeout"`
+		} `yaml:"idempotency"`
 	} `yaml:"service"`
 
 	DB struct {
@@ -103,6 +145,32 @@ func Load(path string) (*Config, error) {
 		cfg.Cache.CleanupInterval = 10 * time.Minute
 	}
 
+	if cfg.Service.DefaultPVZID == 0 {
+		cfg.Service.DefaultPVZID = domain.DefaultPVZID
+	}
+
+	if cfg.Service.StorageExtension.MaxDays == 0 {
+		cfg.Service.StorageExtension.MaxDays = domain.DefaultStorageExtensionPolicy.MaxDays
+	}
+
+	if cfg.Service.PickupCode.MaxAttempts == 0 {
+		cfg.Service.PickupCode.MaxAttempts = domain.DefaultPickupCodePolicy.MaxAttempts
+	}
+	if cfg.Service.PickupCode.LockoutDuration == 0 {
+		cfg.Service.PickupCode.LockoutDuration = domain.DefaultPickupCodePolicy.LockoutDuration
+	}
+
+	if cfg.Service.ImportJobs.Workers == 0 {
+		cfg.Service.ImportJobs.Workers = 2
+	}
+
+	if cfg.Service.Idempotency.TTL == 0 {
+		cfg.Service.Idempotency.TTL = domain.DefaultIdempotencyTTL
+	}
+	if cfg.Service.Idempotency.LockTimeout == 0 {
+		cfg.Service.Idempotency.LockTimeout = domain.DefaultIdempotencyLockTimeout
+	}
+
 	if cfg.Tracing.Endpoint == "" {
 		cfg.Tracing.Endpoint = "http://jaeger:4318"
 	}
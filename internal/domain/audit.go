@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuditRecord — запись журнала изменяющих вызовов API. Записи сцеплены хешами:
+// правка или удаление любой из них ломает цепочку у всех последующих
+type AuditRecord struct {
+	ID     uint64
+	Method string
+	PVZID  uint64
+	Actor  Actor
+	// отправитель из метаданных sender и адрес, с которого пришел запрос
+	Sender string
+	Peer   string
+	// тело запроса в JSON без секретов (кодов выдачи)
+	Payload  []byte
+	OrderIDs []uint64
+	// gRPC-код результата и текст ошибки, если вызов не удался
+	ResultCode string
+	Error      string
+	CreatedAt  time.Time
+	PrevHash   string
+	Hash       string
+}
+
+// AuditFilter — условия поиска по журналу; нулевые поля, кроме пункта, не ограничивают выборку
+type AuditFilter struct {
+	PVZID     uint64
+	ActorType ActorType
+	ActorID   uint64
+	OrderID   uint64
+	From      time.Time
+	To        time.Time
+	Limit     uint64
+}
+
+// ComputeHash считает хеш записи вместе с хешем предыдущей
+func (r AuditRecord) ComputeHash() string {
+	ids := make([]string, len(r.OrderIDs))
+	for i, id := range r.OrderIDs {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+	parts := []string{
+		r.PrevHash,
+		r.Method,
+		strconv.FormatUint(r.PVZID, 10),
+		string(r.Actor.Type),
+		strconv.FormatUint(r.Actor.ID, 10),
+		r.Sender,
+		r.Peer,
+		string(r.Payload),
+		strings.Join(ids, ","),
+		r.ResultCode,
+		r.Error,
+		r.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+
+	h := sha256.New()
+	for _, p := range parts {
+		// длина перед каждым полем, чтобы разные наборы полей не склеивались в одну строку
+		h.Write([]byte(strconv.Itoa(len(p)) + ":" + p))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// VerifyAuditChain проверяет подряд идущие записи (по возрастанию ID) и возвращает
+// ID первой записи, у которой не сходится хеш или ссылка на предыдущую
+func VerifyAuditChain(records []AuditRecord) (uint64, bool) {
+	for i, r := range records {
+		if i > 0 && r.PrevHash != records[i-1].Hash {
+			return r.ID, false
+		}
+		if r.ComputeHash() != r.Hash {
+			return r.ID, false
+		}
+	}
+	return 0, true
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 type ErrorCode int64
 
@@ -18,6 +21,21 @@ const (
 	ErrorCodeNilOrder               ErrorCode = 11
 	ErrorCodeInvalidPackage         ErrorCode = 12
 	ErrorCodeWeightTooHeavy         ErrorCode = 13
+	ErrorCodeBelongsToOtherPVZ      ErrorCode = 14
+	ErrorCodeInvalidTransition      ErrorCode = 15
+	ErrorCodeCellUnavailable        ErrorCode = 16
+	ErrorCodeNotReturnable          ErrorCode = 17
+	ErrorCodeExtensionLimit         ErrorCode = 18
+	ErrorCodeInvalidPickupCode      ErrorCode = 19
+	ErrorCodePickupCodeLocked       ErrorCode = 20
+	ErrorCodePaymentRequired        ErrorCode = 21
+	ErrorCodeIdempotencyKeyReused   ErrorCode = 22
+	ErrorCodeIdempotencyInProgress  ErrorCode = 23
+	ErrorCodeConcurrentModification ErrorCode = 24
+	ErrorCodeBatchAborted           ErrorCode = 25
+	// ErrorCodeInternal — не доменная ошибка (база, сеть); своего конструктора у нее нет
+	ErrorCodeInternal          ErrorCode = 26
+	ErrorCodeStorageDateInPast ErrorCode = 27
 )
 
 type Error struct {
@@ -57,13 +75,6 @@ func ValidationFailedError(message string) error {
 	}
 }
 
-func OrderAlreadyGivenError(orderID uint64) error {
-	return Error{
-		Code:    ErrorCodeAlreadyGiven,
-		Message: fmt.Sprintf("Order %d already given to client", orderID),
-	}
-}
-
 func BelongsToDifferentReceiverError(orderID, expectedReceiverID, actualReceiverID uint64) error {
 	return Error{
 		Code:    ErrorCodeBelongsToOtherReceiver,
@@ -71,17 +82,10 @@ func BelongsToDifferentReceiverError(orderID, expectedReceiverID, actualReceiver
 	}
 }
 
-func AlreadyInStorageError(orderID uint64) error {
-	return Error{
-		Code:    ErrorCodeAlreadyInStorage,
-		Message: fmt.Sprintf("Order %d is already in storage", orderID),
-	}
-}
-
-func ReturnPeriodExpiredError(orderID uint64, hoursSinceGiven float64) error {
+func ReturnPeriodExpiredError(orderID uint64, hoursSinceGiven float64, policy string, windowHours float64) error {
 	return Error{
 		Code:    ErrorCodeReturnPeriodExpired,
-		Message: fmt.Sprintf("Order %d return period expired (%.1f hours)", orderID, hoursSinceGiven),
+		Message: fmt.Sprintf("Order %d return period expired (%.1f hours since issue, policy %q allows %.0f hours)", orderID, hoursSinceGiven, policy, windowHours),
 	}
 }
 
@@ -92,13 +96,6 @@ func StorageNotExpiredError(orderID uint64, storageUntil string) error {
 	}
 }
 
-func UnavaliableReturnedOrderError(orderID uint64) error {
-	return Error{
-		Code:    ErrorCodeUnavaliableReturned,
-		Message: fmt.Sprintf("Order %d is an unavailable returned order", orderID),
-	}
-}
-
 func NilOrderError(orderID uint64) error {
 	return Error{
 		Code:    ErrorCodeNilOrder,
@@ -113,9 +110,127 @@ func InvalidPackageError(packageType string) error {
 	}
 }
 
-func WeightTooHeavyError(packageType string, weight float64, maxWeight float64) error {
+func WeightTooHeavyError(packageType string, weight, maxWeight Weight) error {
 	return Error{
 		Code:    ErrorCodeWeightTooHeavy,
-		Message: fmt.Sprintf("Weight %.2f kg exceeds maximum allowed for %s (%.2f kg)", weight, packageType, maxWeight),
+		Message: fmt.Sprintf("Weight %s kg exceeds maximum allowed for %s (%s kg)", weight, packageType, maxWeight),
+	}
+}
+
+func BelongsToDifferentPVZError(orderID, expectedPVZID, actualPVZID uint64) error {
+	return Error{
+		Code:    ErrorCodeBelongsToOtherPVZ,
+		Message: fmt.Sprintf("Order %d is stored at a different pickup point (expected %d, got %d)", orderID, expectedPVZID, actualPVZID),
+	}
+}
+
+func InvalidTransitionError(orderID uint64, status, action string) error {
+	return Error{
+		Code:    ErrorCodeInvalidTransition,
+		Message: fmt.Sprintf("Order %d: action %s is not allowed in status %q", orderID, action, status),
+	}
+}
+
+func NoFreeCellError(pvzID uint64, size CellSize) error {
+	return Error{
+		Code:    ErrorCodeCellUnavailable,
+		Message: fmt.Sprintf("No free %s (or larger) storage cell at pickup point %d", size, pvzID),
+	}
+}
+
+func CellUnavailableError(code string) error {
+	return Error{
+		Code:    ErrorCodeCellUnavailable,
+		Message: fmt.Sprintf("Storage cell %q not found or full", code),
+	}
+}
+
+func NotReturnableError(orderID uint64, policy string) error {
+	return Error{
+		Code:    ErrorCodeNotReturnable,
+		Message: fmt.Sprintf("Order %d cannot be returned (blocked by return policy %q)", orderID, policy),
+	}
+}
+
+func StorageExtensionLimitError(orderID uint64, maxDays, remainingDays uint32) error {
+	return Error{
+		Code:    ErrorCodeExtensionLimit,
+		Message: fmt.Sprintf("Order %d storage can be extended by at most %d days in total (%d days left)", orderID, maxDays, remainingDays),
+	}
+}
+
+func InvalidPickupCodeError(receiverID uint64) error {
+	return Error{
+		Code:    ErrorCodeInvalidPickupCode,
+		Message: fmt.Sprintf("Invalid pickup code for receiver %d", receiverID),
+	}
+}
+
+func PickupCodeRequiredError(receiverID uint64) error {
+	return Error{
+		Code:    ErrorCodeInvalidPickupCode,
+		Message: fmt.Sprintf("Pickup code is required to issue orders to receiver %d", receiverID),
+	}
+}
+
+func PickupCodeLockedError(receiverID uint64, lockedUntil string) error {
+	return Error{
+		Code:    ErrorCodePickupCodeLocked,
+		Message: fmt.Sprintf("Too many wrong pickup codes for receiver %d, locked until %s", receiverID, lockedUntil),
+	}
+}
+
+func PaymentRequiredError(orderID uint64, amount Money) error {
+	return Error{
+		Code:    ErrorCodePaymentRequired,
+		Message: fmt.Sprintf("Order %d is cash on delivery: payment of %s is not confirmed", orderID, amount),
+	}
+}
+
+func IdempotencyKeyReusedError(key string) error {
+	return Error{
+		Code:    ErrorCodeIdempotencyKeyReused,
+		Message: fmt.Sprintf("Idempotency key %q was already used with a different request", key),
+	}
+}
+
+func IdempotencyInProgressError(key string) error {
+	return Error{
+		Code:    ErrorCodeIdempotencyInProgress,
+		Message: fmt.Sprintf("Request with idempotency key %q is still in progress, retry later", key),
+	}
+}
+
+func ConcurrentModificationError(orderID uint64) error {
+	return Error{
+		Code:    ErrorCodeConcurrentModification,
+		Message: fmt.Sprintf("Order %d was modified concurrently, retry the operation", orderID),
+	}
+}
+
+// BatchAbortedError — заказ прошел проверку, но не обработан, потому что в атомарной пачке упал другой
+func BatchAbortedError(orderID uint64) error {
+	return Error{
+		Code:    ErrorCodeBatchAborted,
+		Message: fmt.Sprintf("Order %d was not processed: another order in the atomic batch failed", orderID),
+	}
+}
+
+func StorageDateInPastError(orderID uint64, storageUntil string) error {
+	return Error{
+		Code:    ErrorCodeStorageDateInPast,
+		Message: fmt.Sprintf("Order %d storage date %s is already in the past", orderID, storageUntil),
+	}
+}
+
+// ErrorCodeOf возвращает код доменной ошибки, ErrorCodeInternal для прочих ошибок и 0 для nil
+func ErrorCodeOf(err error) ErrorCode {
+	if err == nil {
+		return 0
+	}
+	var domainErr Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
 	}
+	return ErrorCodeInternal
 }
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +14,13 @@ const (
 	EventTypeOrderReturnedToCourier EventType = "order_returned_to_courier"
 	EventTypeOrderIssued            EventType = "order_issued"
 	EventTypeOrderReturnedByClient  EventType = "order_returned_by_client"
+	EventTypeOrderStorageExtended   EventType = "order_storage_extended"
+	EventTypeOrderPaid              EventType = "order_paid"
+	EventTypeOrderRefunded          EventType = "order_refunded"
+	EventTypeOrderAnnounced         EventType = "order_announced"
+	EventTypeOrderArrived           EventType = "order_arrived"
+	EventTypeOrderMissing           EventType = "order_missing"
+	EventTypeOrderUnexpected        EventType = "order_unexpected"
 )
 
 type ActorType string
@@ -21,12 +29,24 @@ const (
 	ActorTypeCourier ActorType = "courier"
 	ActorTypeClient  ActorType = "client"
 	ActorTypeSystem  ActorType = "system"
+	// ActorTypeOperator — сотрудник пункта выдачи
+	ActorTypeOperator ActorType = "operator"
 )
 
+func ParseActorType(s string) (ActorType, bool) {
+	switch t := ActorType(s); t {
+	case ActorTypeCourier, ActorTypeClient, ActorTypeSystem, ActorTypeOperator:
+		return t, true
+	default:
+		return "", false
+	}
+}
+
 type Event struct {
 	EventID   string    `json:"event_id"`
 	EventType EventType `json:"event_type"`
 	Timestamp time.Time `json:"timestamp"`
+	PVZID     uint64    `json:"pvz_id,string"`
 	Actor     Actor     `json:"actor"`
 	Order     OrderInfo `json:"order"`
 	Source    string    `json:"source"`
@@ -37,17 +57,44 @@ type Actor struct {
 	ID   uint64    `json:"id,string"`
 }
 
+type actorKey struct{}
+
+// WithActor кладет в контекст того, кто выполняет операцию; его записываем в историю и события
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok && actor.Type != ""
+}
+
 type OrderInfo struct {
 	ID     uint64 `json:"id,string"`
 	UserID uint64 `json:"user_id,string"`
 	Status string `json:"status"`
+	// код выдачи передается в order_accepted и order_arrived, только когда получателю выпущен новый код;
+	// пустой код значит, что заказ выдается по коду, отправленному раньше
+	PickupCode string `json:"pickup_code,omitempty"`
+	// плата за хранение сверх бесплатного срока, только в order_issued
+	StorageFee     Money  `json:"storage_fee,omitempty"`
+	StorageFeeDays uint32 `json:"storage_fee_days,omitempty"`
+	// сумма оплаты или возврата в order_paid и order_refunded
+	Amount Money `json:"amount,omitempty"`
+	// поставка из предварительного уведомления, в событиях приемки по анонсу
+	ShipmentID string `json:"shipment_id,omitempty"`
+	// ведомость возврата, по которой заказ передан курьеру
+	ManifestID uint64 `json:"manifest_id,omitempty,string"`
+	// контакты из справочника получателей, если получатель там есть
+	Receiver *ReceiverContact `json:"receiver,omitempty"`
 }
 
-func NewEvent(eventType EventType, actor Actor, order OrderInfo) Event {
+func NewEvent(eventType EventType, pvzID uint64, actor Actor, order OrderInfo) Event {
 	return Event{
 		EventID:   uuid.New().String(),
 		EventType: eventType,
 		Timestamp: time.Now(),
+		PVZID:     pvzID,
 		Actor:     actor,
 		Order:     order,
 		Source:    "pvz-api",
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// DefaultIdempotencyTTL — сколько хранится ответ по ключу идемпотентности
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// DefaultIdempotencyLockTimeout — сколько ключ остается за незавершенным запросом.
+// Если процесс упал посреди запроса, по истечении этого срока ключ может занять повтор
+const DefaultIdempotencyLockTimeout = time.Minute
+
+// IdempotencyRecord — запрос, выполненный с ключом идемпотентности, и его исходный ответ.
+// Ключ уникален в пределах пункта и метода. Пока Completed=false, запрос с этим ключом еще выполняется
+type IdempotencyRecord struct {
+	PVZID       uint64
+	Key         string
+	Method      string
+	RequestHash string
+	// полное имя proto-сообщения ответа и сам ответ в бинарном виде
+	ResponseType string
+	Response     []byte
+	Completed    bool
+	CreatedAt    time.Time
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type ImportJobStatus uint8
+
+// ImportJobLeaseTTL — через сколько без отметки владельца задачу импорта может забрать другой экземпляр
+const ImportJobLeaseTTL = 2 * time.Minute
+
+const (
+	// ImportJobPending — задача сохранена и ждет свободного воркера
+	ImportJobPending ImportJobStatus = iota
+	ImportJobRunning
+	ImportJobCompleted
+	// ImportJobCancelled — задача остановлена по запросу; принятые до отмены заказы остаются
+	ImportJobCancelled
+	// ImportJobFailed — задача остановлена из-за сбоя, причина в Error
+	ImportJobFailed
+)
+
+// ImportJobFailure — строка задачи импорта, которую не удалось принять
+type ImportJobFailure struct {
+	Row       uint64
+	OrderID   uint64
+	ErrorCode ErrorCode
+	Message   string
+}
+
+// ImportJob — фоновый импорт заказов. Строки задачи хранятся в базе вместе с ней,
+// а LastRow — последняя строка, результат которой зафиксирован: после перезапуска
+// сервиса задача продолжается со следующей
+type ImportJob struct {
+	ID     uint64
+	PVZID  uint64
+	Actor  Actor
+	Status ImportJobStatus
+	// Owner — экземпляр сервиса, который держит задачу; пустой у задачи, которую никто не выполняет
+	Owner string
+	Total uint64
+	// LastRow — число обработанных строк, строки нумеруются с 1
+	LastRow   uint64
+	Imported  uint64
+	Failed    uint64
+	Failures  []ImportJobFailure
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ImportJobProgress — итог обработанной пачки строк, который фиксируется одной транзакцией.
+// Owner и PrevRow — владелец задачи и LastRow, после которой начиналась пачка: итог пишется,
+// только пока они совпадают с записанными в задаче
+type ImportJobProgress struct {
+	JobID    uint64
+	Owner    string
+	PrevRow  uint64
+	LastRow  uint64
+	Imported uint64
+	Failed   uint64
+	Failures []ImportJobFailure
+	At       time.Time
+}
+
+func (s ImportJobStatus) String() string {
+	switch s {
+	case ImportJobPending:
+		return "pending"
+	case ImportJobRunning:
+		return "running"
+	case ImportJobCompleted:
+		return "completed"
+	case ImportJobCancelled:
+		return "cancelled"
+	case ImportJobFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Finished — задача больше не будет выполняться
+func (s ImportJobStatus) Finished() bool {
+	return s == ImportJobCompleted || s == ImportJobCancelled || s == ImportJobFailed
+}
+
+// NewImportJobProgress собирает итог пачки строк; results идут в том же порядке, что и rows
+func NewImportJobProgress(jobID uint64, rows []ImportRow, results []OrderResult, at time.Time) ImportJobProgress {
+	p := ImportJobProgress{JobID: jobID, At: at}
+	for i, r := range results {
+		p.LastRow = max(p.LastRow, rows[i].Row)
+		if r.OK() {
+			p.Imported++
+			continue
+		}
+		p.Failed++
+		p.Failures = append(p.Failures, ImportJobFailure{
+			Row:       rows[i].Row,
+			OrderID:   r.OrderID,
+			ErrorCode: r.ErrorCode(),
+			Message:   r.Err.Error(),
+		})
+	}
+	return p
+}
+
+// ImportJobRowComment — комментарий к приемке заказа строкой задачи импорта. По нему повтор пачки
+// после сбоя узнает заказы, которые эта строка уже успела принять
+func ImportJobRowComment(jobID, row uint64) string {
+	return fmt.Sprintf("import job %d, row %d", jobID, row)
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money — сумма в копейках; дробные рубли не храним, чтобы не ловить ошибки округления float
+type Money int64
+
+// Weight — вес в граммах
+type Weight int64
+
+const (
+	Kopeck Money = 1
+	Ruble  Money = 100
+
+	Gram     Weight = 1
+	Kilogram Weight = 1000
+)
+
+const (
+	moneyScale  = 2
+	weightScale = 3
+)
+
+func ParseMoney(s string) (Money, error) {
+	v, err := parseFixed(s, moneyScale)
+	if err != nil {
+		return 0, fmt.Errorf("parse money %q: %w", s, err)
+	}
+	return Money(v), nil
+}
+
+// MoneyFromFloat нужен только на границе со старыми клиентами, которые присылают float
+func MoneyFromFloat(f float64) Money {
+	return Money(math.Round(f * float64(Ruble)))
+}
+
+func (m Money) Float() float64 {
+	return float64(m) / float64(Ruble)
+}
+
+func (m Money) String() string {
+	return formatFixed(int64(m), moneyScale)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// принимает и число, и строку: 100, 99.95, "99.95"
+func (m *Money) UnmarshalJSON(data []byte) error {
+	v, err := ParseMoney(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+func (m *Money) UnmarshalText(text []byte) error {
+	return m.UnmarshalJSON(text)
+}
+
+// ParseWeight разбирает вес в килограммах с точностью до грамма
+func ParseWeight(s string) (Weight, error) {
+	v, err := parseFixed(s, weightScale)
+	if err != nil {
+		return 0, fmt.Errorf("parse weight %q: %w", s, err)
+	}
+	return Weight(v), nil
+}
+
+func WeightFromFloat(kg float64) Weight {
+	return Weight(math.Round(kg * float64(Kilogram)))
+}
+
+func (w Weight) Float() float64 {
+	return float64(w) / float64(Kilogram)
+}
+
+func (w Weight) String() string {
+	return formatFixed(int64(w), weightScale)
+}
+
+func (w Weight) MarshalJSON() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+func (w *Weight) UnmarshalJSON(data []byte) error {
+	v, err := ParseWeight(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*w = v
+	return nil
+}
+
+func (w *Weight) UnmarshalText(text []byte) error {
+	return w.UnmarshalJSON(text)
+}
+
+// разбирает десятичную строку в целое число минимальных единиц без промежуточного float
+func parseFixed(s string, scale int) (int64, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return 0, fmt.Errorf("empty value")
+	}
+	if len(fracPart) > scale {
+		return 0, fmt.Errorf("more than %d digits after the decimal point", scale)
+	}
+	fracPart += strings.Repeat("0", scale-len(fracPart))
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	v, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil || strings.ContainsAny(intPart+fracPart, "+-") {
+		return 0, fmt.Errorf("not a decimal number")
+	}
+	if negative {
+		v = -v
+	}
+	return v, nil
+}
+
+func formatFixed(v int64, scale int) string {
+	sign := ""
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	unit := int64(math.Pow10(scale))
+	return fmt.Sprintf("%s%d.%0*d", sign, v/unit, scale, v%unit)
+}
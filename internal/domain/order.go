@@ -12,61 +12,129 @@ const (
 	StatusReturnedFromClient
 	StatusReturnedWithoutClient
 	StatusGivenToCourier
+	// StatusExpected — заказ анонсирован, но физически в пункт еще не поступил
+	StatusExpected
 )
 
 type Order struct {
 	OrderID        uint64
 	ReceiverID     uint64
+	PVZID          uint64
+	SellerID       uint64
 	StorageUntil   time.Time
 	Status         OrderStatus
 	AcceptTime     time.Time
 	LastUpdateTime time.Time
 	PackageType    string
-	Weight         float64
-	Price          float64
-}
-
-type OrderHistory struct {
-	OrderID   uint64
-	Status    OrderStatus
-	ChangedAt time.Time
+	Weight         Weight
+	Price          Money
+	CellID         uint64
+	CellCode       string
+	ExtendedDays   uint32
+	CashOnDelivery bool
+	PaymentStatus  PaymentStatus
+	ShipmentID     string
+	// Version растет с каждым изменением заказа; обновление с устаревшей версией отклоняется
+	Version uint64
 }
 
 type OrderToImport struct {
-	OrderID      uint64  `json:"order_id"`
-	ReceiverID   uint64  `json:"receiver_id"`
-	StorageUntil string  `json:"storage_until"`
-	PackageType  string  `json:"package_type"`
-	Weight       float64 `json:"weight"`
-	Price        float64 `json:"price"`
+	OrderID        uint64 `json:"order_id"`
+	ReceiverID     uint64 `json:"receiver_id"`
+	StorageUntil   string `json:"storage_until"`
+	PackageType    string `json:"package_type"`
+	Weight         Weight `json:"weight"`
+	Price          Money  `json:"price"`
+	SellerID       uint64 `json:"seller_id,omitempty"`
+	CashOnDelivery bool   `json:"cash_on_delivery,omitempty"`
 }
 
 var OrdersToImport []OrderToImport
 
+// ImportRow — строка потокового импорта. Err — ошибка разбора или проверки строки:
+// такая строка не принимается, но ее ошибка попадает в результат
+type ImportRow struct {
+	Row   uint64
+	Order OrderToImport
+	Err   error
+}
+
+type ImportRowResult struct {
+	Row    uint64
+	Result OrderResult
+}
+
 type AcceptOrderRequest struct {
-	ReceiverID   uint64
-	OrderID      uint64
-	StorageUntil time.Time
-	Weight       float64
-	Price        float64
-	PackageType  string
+	ReceiverID     uint64
+	OrderID        uint64
+	StorageUntil   time.Time
+	Weight         Weight
+	Price          Money
+	PackageType    string
+	SellerID       uint64
+	CashOnDelivery bool
+	// комментарий к записи истории о приемке
+	Comment string
 }
 
 type ReceiverOrdersRequest struct {
 	ReceiverID uint64
-	InPVZ      bool
-	LastN      uint64
-	Page       uint64
-	Limit      uint64
+	// телефон из справочника получателей, если ReceiverID не указан
+	Phone string
+	InPVZ bool
+	LastN uint64
+	Page  uint64
+	Limit uint64
+	// токен следующей страницы из предыдущего ответа; если задан, Page не учитывается
+	PageToken string
+}
+
+// OrderExportFilter — условия выгрузки заказов пункта; нулевые поля не ограничивают выборку
+type OrderExportFilter struct {
+	PVZID      uint64
+	Statuses   []OrderStatus
+	ReceiverID uint64
+	// телефон из справочника получателей, если ReceiverID не указан
+	Phone string
+	// интервал времени приемки, To не включается
+	AcceptedFrom time.Time
+	AcceptedTo   time.Time
+	PackageType  string
+}
+
+// StorageExtensionPolicy ограничивает суммарное продление хранения одного заказа
+type StorageExtensionPolicy struct {
+	MaxDays   uint32
+	FeePerDay Money
+}
+
+var DefaultStorageExtensionPolicy = StorageExtensionPolicy{MaxDays: 7}
+
+// Charge считает плату за продление хранения заказа на days дней; цена заказа от нее не меняется
+func (p StorageExtensionPolicy) Charge(order Order, days uint32, now time.Time) StorageFee {
+	return StorageFee{
+		Kind:       StorageFeeExtension,
+		OrderID:    order.OrderID,
+		PVZID:      order.PVZID,
+		ReceiverID: order.ReceiverID,
+		PaidDays:   days,
+		DailyRate:  p.FeePerDay,
+		Amount:     p.FeePerDay * Money(days),
+		ChargedAt:  now,
+	}
 }
 
 type PackageRules struct {
-	MaxWeight float64 `json:"max_weight"`
-	Price     float64 `json:"price"`
+	MaxWeight Weight `json:"max_weight"`
+	Price     Money  `json:"price"`
 }
 
 func (o Order) GetStatusString() string {
-	switch o.Status {
+	return o.Status.String()
+}
+
+func (s OrderStatus) String() string {
+	switch s {
 	case StatusInStorage:
 		return "In Storage"
 	case StatusGivenToClient:
@@ -77,7 +145,39 @@ func (o Order) GetStatusString() string {
 		return "Given to courier"
 	case StatusReturnedWithoutClient:
 		return "Given to courier without client"
+	case StatusExpected:
+		return "Expected"
 	default:
 		return "Unknown Status"
 	}
 }
+
+// Code — короткий код статуса для фильтров и выгрузок
+func (s OrderStatus) Code() string {
+	switch s {
+	case StatusInStorage:
+		return "in_storage"
+	case StatusGivenToClient:
+		return "given_to_client"
+	case StatusReturnedFromClient:
+		return "returned_from_client"
+	case StatusReturnedWithoutClient:
+		return "returned_without_client"
+	case StatusGivenToCourier:
+		return "given_to_courier"
+	case StatusExpected:
+		return "expected"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseOrderStatus(s string) (OrderStatus, bool) {
+	for _, st := range []OrderStatus{StatusInStorage, StatusGivenToClient, StatusReturnedFromClient,
+		StatusReturnedWithoutClient, StatusGivenToCourier, StatusExpected} {
+		if st.Code() == s {
+			return st, true
+		}
+	}
+	return 0, false
+}
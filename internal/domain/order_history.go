@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// HistoryReason — код причины перехода заказа в новый статус
+type HistoryReason string
+
+const (
+	ReasonAccepted        HistoryReason = "accepted"
+	ReasonAnnounced       HistoryReason = "announced"
+	ReasonArrived         HistoryReason = "arrived"
+	ReasonIssued          HistoryReason = "issued"
+	ReasonStorageExtended HistoryReason = "storage_extended"
+	// ReasonClientRefusal — клиент отказался от заказа и вернул его в пункт
+	ReasonClientRefusal HistoryReason = "client_refusal"
+	// ReasonStorageExpired — заказ не забрали до конца срока хранения
+	ReasonStorageExpired HistoryReason = "storage_expired"
+	// ReasonClientReturn — возвращенный клиентом заказ уходит курьеру
+	ReasonClientReturn HistoryReason = "client_return"
+)
+
+type OrderHistory struct {
+	OrderID uint64
+	PVZID   uint64
+	// статус до перехода; nil у первой записи заказа
+	PrevStatus *OrderStatus
+	Status     OrderStatus
+	ChangedAt  time.Time
+	// кто перевел заказ в этот статус
+	Actor   Actor
+	Reason  HistoryReason
+	Comment string
+}
+
+// ReturnReason — причина передачи заказа курьеру в зависимости от текущего статуса
+func ReturnReason(status OrderStatus) HistoryReason {
+	if status == StatusReturnedFromClient {
+		return ReasonClientReturn
+	}
+	return ReasonStorageExpired
+}
@@ -0,0 +1,46 @@
+package domain
+
+// OrderResult — итог обработки одного заказа в пакетной операции
+type OrderResult struct {
+	OrderID uint64
+	// статус заказа после операции; nil, если заказ не удалось прочитать
+	Status *OrderStatus
+	// плата за хранение по выданному заказу
+	StorageFee StorageFee
+	Err        error
+}
+
+func (r OrderResult) OK() bool {
+	return r.Err == nil
+}
+
+func (r OrderResult) ErrorCode() ErrorCode {
+	return ErrorCodeOf(r.Err)
+}
+
+// FailedOrderResult — результат заказа, который не удалось обработать; status — текущий статус, если известен
+func FailedOrderResult(orderID uint64, status *OrderStatus, err error) OrderResult {
+	return OrderResult{OrderID: orderID, Status: status, Err: err}
+}
+
+// SucceededOrders возвращает ID успешно обработанных заказов в исходном порядке
+func SucceededOrders(results []OrderResult) []uint64 {
+	var ids []uint64
+	for _, r := range results {
+		if r.OK() {
+			ids = append(ids, r.OrderID)
+		}
+	}
+	return ids
+}
+
+// FailedOrders возвращает ID заказов, которые обработать не удалось
+func FailedOrders(results []OrderResult) []uint64 {
+	var ids []uint64
+	for _, r := range results {
+		if !r.OK() {
+			ids = append(ids, r.OrderID)
+		}
+	}
+	return ids
+}
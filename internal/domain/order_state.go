@@ -0,0 +1,78 @@
+package domain
+
+type OrderAction uint8
+
+const (
+	ActionIssue OrderAction = iota
+	ActionReturnFromClient
+	ActionReturnToCourier
+	ActionExtendStorage
+	ActionConfirmArrival
+)
+
+// порядок действий, в котором их отдает AllowedActions
+var orderActions = []OrderAction{
+	ActionIssue,
+	ActionReturnFromClient,
+	ActionReturnToCourier,
+	ActionExtendStorage,
+	ActionConfirmArrival,
+}
+
+// таблица переходов: из какого статуса какое действие в какой статус переводит заказ
+var orderTransitions = map[OrderStatus]map[OrderAction]OrderStatus{
+	StatusInStorage: {
+		ActionIssue:           StatusGivenToClient,
+		ActionReturnToCourier: StatusReturnedWithoutClient,
+		ActionExtendStorage:   StatusInStorage,
+	},
+	StatusGivenToClient: {
+		ActionReturnFromClient: StatusReturnedFromClient,
+	},
+	StatusReturnedFromClient: {
+		ActionReturnToCourier: StatusGivenToCourier,
+	},
+	StatusExpected: {
+		ActionConfirmArrival: StatusInStorage,
+	},
+}
+
+func (a OrderAction) String() string {
+	switch a {
+	case ActionIssue:
+		return "issue"
+	case ActionReturnFromClient:
+		return "return_from_client"
+	case ActionReturnToCourier:
+		return "return_to_courier"
+	case ActionExtendStorage:
+		return "extend_storage"
+	case ActionConfirmArrival:
+		return "confirm_arrival"
+	default:
+		return "unknown"
+	}
+}
+
+func (s OrderStatus) Next(action OrderAction) (OrderStatus, bool) {
+	next, ok := orderTransitions[s][action]
+	return next, ok
+}
+
+func (s OrderStatus) AllowedActions() []OrderAction {
+	actions := make([]OrderAction, 0, len(orderTransitions[s]))
+	for _, a := range orderActions {
+		if _, ok := orderTransitions[s][a]; ok {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+func (o Order) NextStatus(action OrderAction) (OrderStatus, error) {
+	next, ok := o.Status.Next(action)
+	if !ok {
+		return o.Status, InvalidTransitionError(o.OrderID, o.GetStatusString(), action.String())
+	}
+	return next, nil
+}
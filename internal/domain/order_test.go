@@ -1,7 +1,12 @@
 package domain
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -40,7 +45,8 @@ func Test_Domain_ErrorHelpers(t *testing.T) {
 		{"NotFound", EntityNotFoundError("order", "42"), ErrorCodeNotFound, "42"},
 		{"AlreadyExists", OrderAlreadyExistsError(7), ErrorCodeAlreadyExists, "7"},
 		{"StorageExpired", StorageExpiredError(9, "2025-06-30"), ErrorCodeStorageExpired, "2025-06-30"},
-		{"WeightTooHeavy", WeightTooHeavyError("box", 12.5, 10), ErrorCodeWeightTooHeavy, "12.50"},
+		{"WeightTooHeavy", WeightTooHeavyError("box", 12500*Gram, 10*Kilogram), ErrorCodeWeightTooHeavy, "12.500"},
+		{"StorageDateInPast", StorageDateInPastError(5, "2025-06-27"), ErrorCodeStorageDateInPast, "2025-06-27"},
 	}
 
 	for _, tt := range tests {
@@ -49,3 +55,395 @@ func Test_Domain_ErrorHelpers(t *testing.T) {
 		assert.Contains(t, e.Message, tt.substr, tt.name)
 	}
 }
+
+func Test_OrderStatus_Transitions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from   OrderStatus
+		action OrderAction
+		want   OrderStatus
+		ok     bool
+	}{
+		{StatusInStorage, ActionIssue, StatusGivenToClient, true},
+		{StatusInStorage, ActionReturnToCourier, StatusReturnedWithoutClient, true},
+		{StatusInStorage, ActionReturnFromClient, StatusInStorage, false},
+		{StatusGivenToClient, ActionReturnFromClient, StatusReturnedFromClient, true},
+		{StatusGivenToClient, ActionIssue, StatusGivenToClient, false},
+		{StatusReturnedFromClient, ActionReturnToCourier, StatusGivenToCourier, true},
+		{StatusReturnedFromClient, ActionIssue, StatusReturnedFromClient, false},
+		{StatusGivenToCourier, ActionReturnToCourier, StatusGivenToCourier, false},
+		{StatusReturnedWithoutClient, ActionIssue, StatusReturnedWithoutClient, false},
+	}
+
+	for _, tt := range tests {
+		got, err := Order{OrderID: 1, Status: tt.from}.NextStatus(tt.action)
+		assert.Equal(t, tt.want, got)
+		if tt.ok {
+			assert.NoError(t, err)
+			continue
+		}
+		var domainErr Error
+		assert.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, ErrorCodeInvalidTransition, domainErr.Code)
+	}
+}
+
+func Test_OrderStatus_AllowedActions(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []OrderAction{ActionIssue, ActionReturnToCourier, ActionExtendStorage}, StatusInStorage.AllowedActions())
+	assert.Equal(t, []OrderAction{ActionReturnFromClient}, StatusGivenToClient.AllowedActions())
+	assert.Equal(t, []OrderAction{ActionReturnToCourier}, StatusReturnedFromClient.AllowedActions())
+	assert.Empty(t, StatusGivenToCourier.AllowedActions())
+	assert.Empty(t, StatusReturnedWithoutClient.AllowedActions())
+	assert.Equal(t, []OrderAction{ActionConfirmArrival}, StatusExpected.AllowedActions())
+}
+
+func Test_ResolveReturnPolicy(t *testing.T) {
+	t.Parallel()
+
+	policies := []ReturnPolicy{
+		{Name: "any", Window: 72 * time.Hour, Returnable: true},
+		{Name: "box", PackageType: "box", Window: 24 * time.Hour, Returnable: true},
+		{Name: "seller", SellerID: 7, Window: 240 * time.Hour, Returnable: true},
+		{Name: "seller-film", SellerID: 7, PackageType: "film", Returnable: false},
+	}
+
+	tests := []struct {
+		name     string
+		policies []ReturnPolicy
+		order    Order
+		want     string
+	}{
+		{"NoPolicies", nil, Order{PackageType: "box"}, "default"},
+		{"Catchall", policies, Order{PackageType: "bag"}, "any"},
+		{"PackageType", policies, Order{PackageType: "box"}, "box"},
+		{"SellerBeatsPackage", policies, Order{PackageType: "box", SellerID: 7}, "seller"},
+		{"SellerAndPackage", policies, Order{PackageType: "film", SellerID: 7}, "seller-film"},
+		{"CompositePackage", policies, Order{PackageType: "film+box"}, "box"},
+		{"CompositeSellerAndPackage", policies, Order{PackageType: "box+film", SellerID: 7}, "seller-film"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ResolveReturnPolicy(tt.policies, tt.order).Name, tt.name)
+	}
+}
+
+func Test_PickupCode(t *testing.T) {
+	t.Parallel()
+
+	code, err := GeneratePickupCode()
+	assert.NoError(t, err)
+	assert.Regexp(t, `^[0-9]{6}$`, code)
+
+	policy := PickupCodePolicy{Secret: []byte("secret")}
+	stored := PickupCode{PVZID: 1, ReceiverID: 100, CodeHash: policy.Hash(1, 100, code)}
+	assert.NotContains(t, stored.CodeHash, code)
+	assert.True(t, policy.Matches(stored, code))
+	assert.False(t, policy.Matches(stored, code+"0"))
+
+	// тот же код другого получателя дает другой хэш
+	assert.NotEqual(t, stored.CodeHash, policy.Hash(1, 101, code))
+	// без секрета сервера хэш не воспроизвести
+	assert.False(t, PickupCodePolicy{Secret: []byte("other")}.Matches(stored, code))
+
+	now := time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)
+	assert.False(t, stored.IsLocked(now))
+	stored.LockedUntil = now.Add(time.Minute)
+	assert.True(t, stored.IsLocked(now))
+	assert.False(t, stored.IsLocked(now.Add(time.Minute)))
+}
+
+func Test_ParseMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr bool
+	}{
+		{"100", 100 * Ruble, false},
+		{"99.9", 9990 * Kopeck, false},
+		{"0.05", 5 * Kopeck, false},
+		{".5", 50 * Kopeck, false},
+		{"-1.25", -125 * Kopeck, false},
+		{"1.005", 0, true},
+		{"", 0, true},
+		{"1e3", 0, true},
+		{"1.-5", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMoney(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func Test_Money_NoFloatArtifacts(t *testing.T) {
+	t.Parallel()
+
+	// 0.1 + 0.2 во float дает 0.30000000000000004, в копейках — ровно 30
+	a, _ := ParseMoney("0.1")
+	b, _ := ParseMoney("0.2")
+	assert.Equal(t, "0.30", (a + b).String())
+	assert.Equal(t, Money(10550), MoneyFromFloat(105.5))
+	assert.Equal(t, "-0.05", Money(-5).String())
+
+	w, err := ParseWeight("2.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 2500*Gram, w)
+	assert.Equal(t, "2.500", w.String())
+	assert.Equal(t, CellSizeMedium, CellSizeForWeight(w+5*Kilogram))
+}
+
+func Test_Money_JSON(t *testing.T) {
+	t.Parallel()
+
+	var o OrderToImport
+	err := json.Unmarshal([]byte(`{"order_id":1,"weight":1.25,"price":"99.99"}`), &o)
+	assert.NoError(t, err)
+	assert.Equal(t, 1250*Gram, o.Weight)
+	assert.Equal(t, 9999*Kopeck, o.Price)
+
+	out, err := json.Marshal(PackageRules{MaxWeight: 10 * Kilogram, Price: 5 * Ruble})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"max_weight":10.000,"price":5.00}`, string(out))
+}
+
+func Test_ComposePackageRules(t *testing.T) {
+	t.Parallel()
+
+	catalogue := []PackageType{
+		{Code: "bag", Kind: PackageKindContainer, MaxWeight: 10 * Kilogram, Price: 5 * Ruble},
+		{Code: "box", Kind: PackageKindContainer, MaxWeight: 30 * Kilogram, Price: 20 * Ruble},
+		{Code: "film", Kind: PackageKindWrapping, Price: 1 * Ruble},
+		{Code: "bubble", Kind: PackageKindWrapping, MaxWeight: 3 * Kilogram, Price: 3 * Ruble},
+	}
+
+	tests := []struct {
+		code    string
+		want    PackageRules
+		wantErr bool
+	}{
+		{"bag", PackageRules{MaxWeight: 10 * Kilogram, Price: 5 * Ruble}, false},
+		{"box+film", PackageRules{MaxWeight: 30 * Kilogram, Price: 21 * Ruble}, false},
+		{"film+box", PackageRules{MaxWeight: 30 * Kilogram, Price: 21 * Ruble}, false},
+		{"box+film+bubble", PackageRules{MaxWeight: 30 * Kilogram, Price: 24 * Ruble}, false},
+		{"film", PackageRules{Price: 1 * Ruble}, false},
+		{"film+bubble", PackageRules{MaxWeight: 3 * Kilogram, Price: 4 * Ruble}, false},
+		{"bag+box", PackageRules{}, true},
+		{"film+film", PackageRules{}, true},
+		{"crate", PackageRules{}, true},
+		{"box+", PackageRules{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ComposePackageRules(tt.code, catalogue)
+		if tt.wantErr {
+			assert.ErrorIs(t, err, InvalidPackageError(tt.code), tt.code)
+			continue
+		}
+		assert.NoError(t, err, tt.code)
+		assert.Equal(t, tt.want, got, tt.code)
+	}
+}
+
+func Test_PackageType_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, PackageType{Code: "crate", Kind: PackageKindContainer, MaxWeight: 50 * Kilogram}.Validate())
+	assert.Error(t, PackageType{Code: "box+film"}.Validate())
+	assert.Error(t, PackageType{Code: "Box"}.Validate())
+	assert.Error(t, PackageType{Code: "crate", Kind: PackageKind(7)}.Validate())
+	assert.Error(t, PackageType{Code: "crate", Price: -1}.Validate())
+}
+
+func Test_StorageFeePolicy_DailyRate(t *testing.T) {
+	t.Parallel()
+
+	policy := StorageFeePolicy{
+		DefaultPerDay: 10 * Ruble,
+		Rates: []StorageFeeRate{
+			{MinWeight: 15 * Kilogram, PerDay: 30 * Ruble},
+			{PackageType: "box", PerDay: 20 * Ruble},
+			{PackageType: "box", MinWeight: 20 * Kilogram, PerDay: 40 * Ruble},
+		},
+	}
+
+	assert.Equal(t, 10*Ruble, policy.DailyRate("bag", 1*Kilogram))
+	assert.Equal(t, 30*Ruble, policy.DailyRate("bag", 16*Kilogram))
+	assert.Equal(t, 20*Ruble, policy.DailyRate("box+film", 16*Kilogram))
+	assert.Equal(t, 40*Ruble, policy.DailyRate("box", 25*Kilogram))
+}
+
+func Test_ReturnManifest_Export(t *testing.T) {
+	t.Parallel()
+
+	until := time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)
+	m := ReturnManifest{
+		ID:    3,
+		PVZID: 1,
+		Items: []ReturnManifestItem{
+			{OrderID: 10, ReceiverID: 5, Status: StatusInStorage, CellCode: "A-1", Weight: 1500 * Gram, StorageUntil: until},
+			{OrderID: 11, ReceiverID: 6, Status: StatusReturnedFromClient, Weight: Kilogram, StorageUntil: until},
+		},
+	}
+
+	csvData, err := m.Export(ManifestFormatCSV)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"manifest_id,order_id,receiver_id,cell_code,weight,storage_until,reason\n"+
+			"3,10,5,A-1,1.500,2025-06-28T00:00:00Z,storage_expired\n"+
+			"3,11,6,,1.000,2025-06-28T00:00:00Z,client_return\n",
+		string(csvData))
+
+	jsonData, err := m.Export(ManifestFormatJSON)
+	assert.NoError(t, err)
+	assert.Contains(t, string(jsonData), `"reason": "client_return"`)
+	assert.NotContains(t, string(jsonData), "handed_over_at")
+
+	_, err = m.Export("xml")
+	assert.Error(t, err)
+}
+
+func Test_NormalizePhone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"+7 (999) 123-45-67", "+79991234567", true},
+		{"89991234567", "+79991234567", true},
+		{"9991234567", "+79991234567", true},
+		{"+44 20 7946 0958", "+442079460958", true},
+		{"12345", "", false},
+		{"+7 999 abc", "", false},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizePhone(tt.in)
+		assert.Equal(t, tt.ok, err == nil, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func Test_ActorFromContext(t *testing.T) {
+	t.Parallel()
+
+	_, ok := ActorFromContext(context.Background())
+	assert.False(t, ok)
+
+	operator := Actor{Type: ActorTypeOperator, ID: 7}
+	got, ok := ActorFromContext(WithActor(context.Background(), operator))
+	assert.True(t, ok)
+	assert.Equal(t, operator, got)
+
+	typ, ok := ParseActorType("operator")
+	assert.True(t, ok)
+	assert.Equal(t, ActorTypeOperator, typ)
+	_, ok = ParseActorType("admin")
+	assert.False(t, ok)
+}
+
+func Test_ParseOrderStatus(t *testing.T) {
+	t.Parallel()
+
+	for _, st := range []OrderStatus{StatusInStorage, StatusGivenToClient, StatusReturnedFromClient,
+		StatusReturnedWithoutClient, StatusGivenToCourier, StatusExpected} {
+		got, ok := ParseOrderStatus(st.Code())
+		assert.True(t, ok)
+		assert.Equal(t, st, got)
+	}
+	_, ok := ParseOrderStatus("lost")
+	assert.False(t, ok)
+}
+
+func Test_VerifyAuditChain(t *testing.T) {
+	t.Parallel()
+
+	first := AuditRecord{ID: 1, Method: "/orders.v2.OrdersService/AcceptOrder", Payload: []byte(`{"order_id":"1"}`)}
+	first.Hash = first.ComputeHash()
+	second := AuditRecord{ID: 2, Method: "/orders.v2.OrdersService/ReturnOrder", PrevHash: first.Hash}
+	second.Hash = second.ComputeHash()
+
+	_, ok := VerifyAuditChain([]AuditRecord{first, second})
+	assert.True(t, ok)
+
+	edited := first
+	edited.Payload = []byte(`{"order_id":"2"}`)
+	brokenID, ok := VerifyAuditChain([]AuditRecord{edited, second})
+	assert.False(t, ok)
+	assert.Equal(t, uint64(1), brokenID)
+
+	// пересчитанный хеш измененной записи не совпадает со ссылкой в следующей
+	edited.Hash = edited.ComputeHash()
+	brokenID, ok = VerifyAuditChain([]AuditRecord{edited, second})
+	assert.False(t, ok)
+	assert.Equal(t, uint64(2), brokenID)
+}
+
+func Test_ErrorCodeOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ErrorCode(0), ErrorCodeOf(nil))
+	assert.Equal(t, ErrorCodeNotFound, ErrorCodeOf(fmt.Errorf("repo.GetByID: %w", EntityNotFoundError("Order", "1"))))
+	assert.Equal(t, ErrorCodeInternal, ErrorCodeOf(errors.New("connection reset")))
+
+	results := []OrderResult{{OrderID: 1}, FailedOrderResult(2, nil, BatchAbortedError(2))}
+	assert.Equal(t, []uint64{1}, SucceededOrders(results))
+	assert.Equal(t, []uint64{2}, FailedOrders(results))
+	assert.Equal(t, ErrorCodeBatchAborted, results[1].ErrorCode())
+}
+
+func Test_NewImportJobProgress(t *testing.T) {
+	t.Parallel()
+
+	rows := []ImportRow{{Row: 11}, {Row: 12}, {Row: 13}}
+	results := []OrderResult{
+		{OrderID: 1},
+		FailedOrderResult(2, nil, OrderAlreadyExistsError(2)),
+		{OrderID: 3},
+	}
+	p := NewImportJobProgress(5, rows, results, time.Time{})
+
+	assert.Equal(t, uint64(13), p.LastRow)
+	assert.Equal(t, uint64(2), p.Imported)
+	assert.Equal(t, uint64(1), p.Failed)
+	assert.Equal(t, []ImportJobFailure{{Row: 12, OrderID: 2, ErrorCode: ErrorCodeAlreadyExists, Message: "Order 2 already exists"}}, p.Failures)
+}
+
+func Test_PageToken(t *testing.T) {
+	t.Parallel()
+
+	f := OrderListFilter{PVZID: DefaultPVZID, Statuses: []OrderStatus{StatusReturnedFromClient}}
+	scope := PageScope(PageOrderByUpdate, f)
+	cursor := PageCursor{Time: time.Date(2026, 3, 1, 10, 0, 0, 123456000, time.UTC), ID: 42, Scope: scope}
+	got, err := ParsePageToken(cursor.Token(), scope)
+	assert.NoError(t, err)
+	assert.True(t, cursor.Time.Equal(got.Time))
+	assert.Equal(t, cursor.ID, got.ID)
+
+	for _, token := range []string{"not-a-token", "e30", PageCursor{Scope: scope}.Token()} {
+		_, err := ParsePageToken(token, scope)
+		assert.Equal(t, ErrorCodeValidationFailed, ErrorCodeOf(err))
+	}
+
+	// токен другого порядка или фильтра
+	for _, other := range []string{
+		PageScope(PageOrderByID, f),
+		PageScope(PageOrderByUpdate, OrderListFilter{PVZID: DefaultPVZID}),
+		PageScope(PageOrderByUpdate, OrderListFilter{PVZID: DefaultPVZID + 1, Statuses: f.Statuses}),
+	} {
+		assert.NotEqual(t, scope, other)
+		_, err := ParsePageToken(cursor.Token(), other)
+		assert.Equal(t, ErrorCodeValidationFailed, ErrorCodeOf(err))
+	}
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type PackageKind uint8
+
+const (
+	// PackageKindContainer — внешняя упаковка (пакет, коробка), задает предельный вес
+	PackageKindContainer PackageKind = iota
+	// PackageKindWrapping — дополнительная обертка (пленка), только добавляет цену
+	PackageKindWrapping
+)
+
+// разделитель частей в составном коде упаковки: "box+film"
+const PackageCodeSeparator = "+"
+
+var packageCodeRe = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// PackageType — элемент справочника упаковок; комбинации в справочнике не хранятся
+type PackageType struct {
+	Code      string
+	Kind      PackageKind
+	MaxWeight Weight
+	Price     Money
+}
+
+func (k PackageKind) String() string {
+	switch k {
+	case PackageKindContainer:
+		return "container"
+	case PackageKindWrapping:
+		return "wrapping"
+	default:
+		return "unknown"
+	}
+}
+
+func ParsePackageKind(s string) (PackageKind, bool) {
+	switch s {
+	case "container":
+		return PackageKindContainer, true
+	case "wrapping":
+		return PackageKindWrapping, true
+	default:
+		return 0, false
+	}
+}
+
+func (p PackageType) Validate() error {
+	if !packageCodeRe.MatchString(p.Code) {
+		return ValidationFailedError(fmt.Sprintf(
+			"package code %q must be lowercase latin letters, digits, '_' or '-'", p.Code))
+	}
+	if p.Kind != PackageKindContainer && p.Kind != PackageKindWrapping {
+		return ValidationFailedError("unknown package kind")
+	}
+	if p.MaxWeight < 0 {
+		return ValidationFailedError("package max weight must not be negative")
+	}
+	if p.Price < 0 {
+		return ValidationFailedError("package price must not be negative")
+	}
+	return nil
+}
+
+func SplitPackageCode(code string) []string {
+	return strings.Split(code, PackageCodeSeparator)
+}
+
+// ComposePackageRules собирает правила составной упаковки из частей справочника:
+// вес ограничивает внешняя упаковка, цены частей складываются
+func ComposePackageRules(code string, catalogue []PackageType) (PackageRules, error) {
+	byCode := make(map[string]PackageType, len(catalogue))
+	for _, p := range catalogue {
+		byCode[p.Code] = p
+	}
+
+	var (
+		rules     PackageRules
+		container *PackageType
+		seen      = make(map[string]struct{})
+	)
+	for _, part := range SplitPackageCode(code) {
+		p, ok := byCode[part]
+		if !ok {
+			return PackageRules{}, InvalidPackageError(code)
+		}
+		if _, dup := seen[part]; dup {
+			return PackageRules{}, InvalidPackageError(code)
+		}
+		seen[part] = struct{}{}
+
+		if p.Kind == PackageKindContainer {
+			// две внешние упаковки одна в другой не принимаем
+			if container != nil {
+				return PackageRules{}, InvalidPackageError(code)
+			}
+			container = &p
+		}
+		rules.Price += p.Price
+	}
+
+	if container != nil {
+		rules.MaxWeight = container.MaxWeight
+		return rules, nil
+	}
+	// без внешней упаковки берем самое строгое ограничение оберток (0 — без ограничения)
+	for part := range seen {
+		if w := byCode[part].MaxWeight; w > 0 && (rules.MaxWeight == 0 || w < rules.MaxWeight) {
+			rules.MaxWeight = w
+		}
+	}
+	return rules, nil
+}
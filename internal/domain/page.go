@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"time"
+)
+
+// PageRequest — страница списка: по номеру Page (с 1) или, если задан Token, сразу после
+// последнего элемента предыдущей страницы. Limit — размер страницы
+type PageRequest struct {
+	Page  uint64
+	Limit uint64
+	Token string
+}
+
+// PageCursor — ключ сортировки последнего отданного элемента; клиенту уходит как непрозрачный токен.
+// Scope — отпечаток порядка и фильтра списка: курсор другого списка указывает не туда и отвергается
+type PageCursor struct {
+	Time  time.Time `json:"t"`
+	ID    uint64    `json:"id"`
+	Scope string    `json:"s"`
+}
+
+// PageOrder — порядок, в котором отдается список заказов
+type PageOrder string
+
+const (
+	PageOrderByID     PageOrder = "id"
+	PageOrderByUpdate PageOrder = "update"
+)
+
+// OrderPageQuery — страница заказов для запроса в базу: после After (keyset) или с пропуском Offset.
+// Offset учитывается только без After
+type OrderPageQuery struct {
+	After  *PageCursor
+	Offset uint64
+	Limit  uint64
+}
+
+// OrderListFilter — какие заказы пункта попадают в список; нулевые поля не ограничивают выборку
+type OrderListFilter struct {
+	PVZID      uint64
+	ReceiverID uint64
+	Statuses   []OrderStatus
+}
+
+// OrderPage — страница заказов и их общее число; NextPageToken пуст на последней странице
+type OrderPage struct {
+	Orders        []Order
+	Total         uint64
+	NextPageToken string
+}
+
+func (c PageCursor) Token() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParsePageToken разбирает токен и проверяет, что он выдан для списка с тем же scope
+func ParsePageToken(token, scope string) (PageCursor, error) {
+	var c PageCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || json.Unmarshal(data, &c) != nil || c.ID == 0 {
+		return PageCursor{}, ValidationFailedError("invalid page token")
+	}
+	if c.Scope != scope {
+		return PageCursor{}, ValidationFailedError("page token belongs to another list")
+	}
+	return c, nil
+}
+
+// PageScope — отпечаток порядка и фильтра для PageCursor.Scope
+func PageScope(order PageOrder, f OrderListFilter) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s:%d:%d:", order, f.PVZID, f.ReceiverID)
+	for _, st := range f.Statuses {
+		fmt.Fprintf(h, "%d,", st)
+	}
+	return strconv.FormatUint(h.Sum64(), 36)
+}
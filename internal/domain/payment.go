@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+type PaymentStatus uint8
+
+const (
+	PaymentStatusUnpaid PaymentStatus = iota
+	PaymentStatusPaid
+	PaymentStatusRefunded
+)
+
+type PaymentKind uint8
+
+const (
+	PaymentKindCharge PaymentKind = iota
+	PaymentKindRefund
+)
+
+// Payment — запись журнала платежей по заказу: оплата при получении или возврат денег клиенту
+type Payment struct {
+	ID        uint64
+	OrderID   uint64
+	PVZID     uint64
+	Kind      PaymentKind
+	Amount    Money
+	CreatedAt time.Time
+}
+
+func (s PaymentStatus) String() string {
+	switch s {
+	case PaymentStatusUnpaid:
+		return "unpaid"
+	case PaymentStatusPaid:
+		return "paid"
+	case PaymentStatusRefunded:
+		return "refunded"
+	default:
+		return "unknown"
+	}
+}
+
+// InitialPaymentStatus — предоплаченный заказ приходит уже оплаченным, наложенный платеж ждет оплаты
+func InitialPaymentStatus(cashOnDelivery bool) PaymentStatus {
+	if cashOnDelivery {
+		return PaymentStatusUnpaid
+	}
+	return PaymentStatusPaid
+}
+
+// AwaitsPayment — заказ с оплатой при получении, по которому деньги еще не приняты
+func (o Order) AwaitsPayment() bool {
+	return o.CashOnDelivery && o.PaymentStatus == PaymentStatusUnpaid
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const PickupCodeLength = 6
+
+type PickupCodePolicy struct {
+	MaxAttempts     uint32
+	LockoutDuration time.Duration
+	// ключ HMAC для хэшей кодов: без него шестизначный код по хэшу из базы подбирается перебором
+	Secret []byte
+}
+
+var DefaultPickupCodePolicy = PickupCodePolicy{
+	MaxAttempts:     5,
+	LockoutDuration: 15 * time.Minute,
+}
+
+// PickupCode — действующий код получателя в пункте; открытый код не хранится, только его хэш
+type PickupCode struct {
+	PVZID          uint64
+	ReceiverID     uint64
+	CodeHash       string
+	FailedAttempts uint32
+	LockedUntil    time.Time
+	CreatedAt      time.Time
+}
+
+func GeneratePickupCode() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < PickupCodeLength; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", fmt.Errorf("rand.Int: %w", err)
+	}
+	return fmt.Sprintf("%0*d", PickupCodeLength, n), nil
+}
+
+// Hash считает HMAC кода под секретом сервера. Хэш привязан к пункту и получателю,
+// чтобы одинаковые коды разных людей не совпадали в базе
+func (p PickupCodePolicy) Hash(pvzID, receiverID uint64, code string) string {
+	mac := hmac.New(sha256.New, p.Secret)
+	fmt.Fprintf(mac, "%d:%d:%s", pvzID, receiverID, code)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (p PickupCodePolicy) Matches(stored PickupCode, code string) bool {
+	hash := p.Hash(stored.PVZID, stored.ReceiverID, code)
+	return hmac.Equal([]byte(hash), []byte(stored.CodeHash))
+}
+
+func (c PickupCode) IsLocked(now time.Time) bool {
+	return now.Before(c.LockedUntil)
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultPVZID — пункт, созданный миграцией; используется, если вызывающий не указал свой
+const DefaultPVZID uint64 = 1
+
+type PickupPoint struct {
+	ID        uint64
+	Name      string
+	Address   string
+	CreatedAt time.Time
+}
+
+type pvzIDKey struct{}
+
+func WithPVZID(ctx context.Context, pvzID uint64) context.Context {
+	return context.WithValue(ctx, pvzIDKey{}, pvzID)
+}
+
+func PVZIDFromContext(ctx context.Context) uint64 {
+	if pvzID, ok := ctx.Value(pvzIDKey{}).(uint64); ok && pvzID != 0 {
+		return pvzID
+	}
+	return DefaultPVZID
+}
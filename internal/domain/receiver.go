@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Receiver — получатель из справочника: по телефону или имени его ищут на выдаче
+type Receiver struct {
+	ID        uint64
+	Phone     string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ReceiverContact — контакты получателя, которые уходят в события для уведомлений
+type ReceiverContact struct {
+	Name  string `json:"name,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// NormalizePhone приводит номер к виду +<цифры>; российские 8XXXXXXXXXX и XXXXXXXXXX
+// дополняются до +7XXXXXXXXXX, чтобы один человек не заводился дважды
+func NormalizePhone(phone string) (string, error) {
+	var digits strings.Builder
+	for _, r := range phone {
+		switch {
+		case unicode.IsDigit(r):
+			digits.WriteRune(r)
+		case r == '+' || r == ' ' || r == '-' || r == '(' || r == ')':
+		default:
+			return "", ValidationFailedError(fmt.Sprintf("phone %q contains invalid characters", phone))
+		}
+	}
+
+	d := digits.String()
+	switch {
+	case len(d) == 10:
+		d = "7" + d
+	case len(d) == 11 && d[0] == '8':
+		d = "7" + d[1:]
+	}
+	if len(d) < 11 || len(d) > 15 {
+		return "", ValidationFailedError(fmt.Sprintf("phone %q must contain 10 to 15 digits", phone))
+	}
+	return "+" + d, nil
+}
+
+func (r Receiver) Validate() error {
+	if r.ID == 0 {
+		return ValidationFailedError("receiver id is required")
+	}
+	if r.Phone == "" {
+		return ValidationFailedError("receiver phone is required")
+	}
+	return nil
+}
+
+func (r Receiver) Contact() *ReceiverContact {
+	return &ReceiverContact{Name: r.Name, Phone: r.Phone}
+}
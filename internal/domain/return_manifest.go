@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type ManifestStatus uint8
+
+const (
+	// ManifestOpen — ведомость собрана, заказы еще лежат в пункте
+	ManifestOpen ManifestStatus = iota
+	// ManifestHandedOver — заказы из ведомости переданы курьеру
+	ManifestHandedOver
+)
+
+const (
+	ManifestFormatCSV  = "csv"
+	ManifestFormatJSON = "json"
+)
+
+// ReturnManifestItem — заказ в ведомости возврата; ячейку и срок запоминаем на момент сборки,
+// после передачи курьеру ячейка освобождается
+type ReturnManifestItem struct {
+	OrderID      uint64
+	ReceiverID   uint64
+	Status       OrderStatus
+	CellCode     string
+	Weight       Weight
+	StorageUntil time.Time
+}
+
+// ReturnManifest — ведомость возврата курьеру: истекшие и возвращенные клиентами заказы пункта
+type ReturnManifest struct {
+	ID           uint64
+	PVZID        uint64
+	Status       ManifestStatus
+	Items        []ReturnManifestItem
+	CreatedAt    time.Time
+	HandedOverAt time.Time
+}
+
+type manifestItemJSON struct {
+	OrderID      uint64    `json:"order_id"`
+	ReceiverID   uint64    `json:"receiver_id"`
+	CellCode     string    `json:"cell_code,omitempty"`
+	Weight       Weight    `json:"weight"`
+	StorageUntil time.Time `json:"storage_until"`
+	Reason       string    `json:"reason"`
+}
+
+type manifestJSON struct {
+	ID           uint64             `json:"id"`
+	PVZID        uint64             `json:"pvz_id"`
+	Status       string             `json:"status"`
+	CreatedAt    time.Time          `json:"created_at"`
+	HandedOverAt *time.Time         `json:"handed_over_at,omitempty"`
+	Items        []manifestItemJSON `json:"items"`
+}
+
+func (s ManifestStatus) String() string {
+	switch s {
+	case ManifestOpen:
+		return "open"
+	case ManifestHandedOver:
+		return "handed_over"
+	default:
+		return "unknown"
+	}
+}
+
+func (m ReturnManifest) OrderIDs() []uint64 {
+	ids := make([]uint64, len(m.Items))
+	for i, it := range m.Items {
+		ids[i] = it.OrderID
+	}
+	return ids
+}
+
+// Export выгружает ведомость для курьерской службы в csv или json
+func (m ReturnManifest) Export(format string) ([]byte, error) {
+	switch format {
+	case ManifestFormatJSON:
+		out := manifestJSON{
+			ID:        m.ID,
+			PVZID:     m.PVZID,
+			Status:    m.Status.String(),
+			CreatedAt: m.CreatedAt,
+			Items:     make([]manifestItemJSON, len(m.Items)),
+		}
+		if !m.HandedOverAt.IsZero() {
+			out.HandedOverAt = &m.HandedOverAt
+		}
+		for i, it := range m.Items {
+			out.Items[i] = manifestItemJSON{
+				OrderID:      it.OrderID,
+				ReceiverID:   it.ReceiverID,
+				CellCode:     it.CellCode,
+				Weight:       it.Weight,
+				StorageUntil: it.StorageUntil,
+				Reason:       it.Reason(),
+			}
+		}
+		return json.MarshalIndent(out, "", "  ")
+	case ManifestFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		_ = w.Write([]string{"manifest_id", "order_id", "receiver_id", "cell_code", "weight", "storage_until", "reason"})
+		for _, it := range m.Items {
+			_ = w.Write([]string{
+				strconv.FormatUint(m.ID, 10),
+				strconv.FormatUint(it.OrderID, 10),
+				strconv.FormatUint(it.ReceiverID, 10),
+				it.CellCode,
+				it.Weight.String(),
+				it.StorageUntil.Format(time.RFC3339),
+				it.Reason(),
+			})
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, fmt.Errorf("csv.Write: %w", err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, ValidationFailedError(fmt.Sprintf("unknown manifest format %q, expected csv or json", format))
+	}
+}
+
+// Reason — почему заказ едет обратно: истек срок хранения или клиент вернул заказ
+func (it ReturnManifestItem) Reason() string {
+	return string(ReturnReason(it.Status))
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// DefaultReturnWindow — срок возврата, если ни одна политика не подошла
+const DefaultReturnWindow = 48 * time.Hour
+
+// ReturnPolicy задает окно возврата для типа упаковки и/или продавца.
+// Пустой PackageType или нулевой SellerID означают "любой"; у составной упаковки
+// политика подходит к любой ее части.
+type ReturnPolicy struct {
+	ID          uint64
+	Name        string
+	PackageType string
+	SellerID    uint64
+	Window      time.Duration
+	Returnable  bool
+}
+
+var DefaultReturnPolicy = ReturnPolicy{
+	Name:       "default",
+	Window:     DefaultReturnWindow,
+	Returnable: true,
+}
+
+func (p ReturnPolicy) matches(order Order) bool {
+	if p.SellerID != 0 && p.SellerID != order.SellerID {
+		return false
+	}
+	if p.PackageType != "" && !slices.Contains(SplitPackageCode(order.PackageType), p.PackageType) {
+		return false
+	}
+	return true
+}
+
+// продавец важнее типа упаковки, политика на обоих важнее всех
+func (p ReturnPolicy) specificity() int {
+	score := 0
+	if p.SellerID != 0 {
+		score += 2
+	}
+	if p.PackageType != "" {
+		score++
+	}
+	return score
+}
+
+func ResolveReturnPolicy(policies []ReturnPolicy, order Order) ReturnPolicy {
+	best, bestScore := DefaultReturnPolicy, -1
+	for _, p := range policies {
+		if !p.matches(order) {
+			continue
+		}
+		if score := p.specificity(); score > bestScore {
+			best, bestScore = p, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+type DiscrepancyKind uint8
+
+const (
+	// DiscrepancyMissing — заказ анонсирован, но при приемке поставки его не оказалось
+	DiscrepancyMissing DiscrepancyKind = iota
+	// DiscrepancyUnexpected — посылка пришла, но в анонсе поставки ее не было
+	DiscrepancyUnexpected
+)
+
+// ArrivalDiscrepancy — расхождение между анонсом поставки и фактической приемкой
+type ArrivalDiscrepancy struct {
+	ID         uint64
+	PVZID      uint64
+	ShipmentID string
+	OrderID    uint64
+	Kind       DiscrepancyKind
+	DetectedAt time.Time
+}
+
+// ArrivalReport — итог приемки поставки: что подтверждено и какие нашлись расхождения.
+// В Results — результат по каждому заказу, который затронула приемка, включая неудачные
+type ArrivalReport struct {
+	ShipmentID    string
+	Confirmed     []uint64
+	Discrepancies []ArrivalDiscrepancy
+	Results       []OrderResult
+}
+
+func (k DiscrepancyKind) String() string {
+	switch k {
+	case DiscrepancyMissing:
+		return "missing"
+	case DiscrepancyUnexpected:
+		return "unexpected"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,61 @@
+package domain
+
+type CellSize uint8
+
+const (
+	CellSizeSmall CellSize = iota
+	CellSizeMedium
+	CellSizeLarge
+)
+
+// пороги веса для подбора ячейки; все, что тяжелее, едет в большую
+const (
+	smallCellMaxWeight  = 5 * Kilogram
+	mediumCellMaxWeight = 15 * Kilogram
+)
+
+type StorageCell struct {
+	ID       uint64
+	PVZID    uint64
+	Code     string
+	Size     CellSize
+	Capacity uint32
+	Occupied uint32
+}
+
+func CellSizeForWeight(weight Weight) CellSize {
+	switch {
+	case weight <= smallCellMaxWeight:
+		return CellSizeSmall
+	case weight <= mediumCellMaxWeight:
+		return CellSizeMedium
+	default:
+		return CellSizeLarge
+	}
+}
+
+func (s CellSize) String() string {
+	switch s {
+	case CellSizeSmall:
+		return "small"
+	case CellSizeMedium:
+		return "medium"
+	case CellSizeLarge:
+		return "large"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseCellSize(s string) (CellSize, bool) {
+	switch s {
+	case "small":
+		return CellSizeSmall, true
+	case "medium":
+		return CellSizeMedium, true
+	case "large":
+		return CellSizeLarge, true
+	default:
+		return 0, false
+	}
+}
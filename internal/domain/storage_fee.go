@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// StorageFeeRate — суточная ставка платного хранения; пустая упаковка подходит к любой,
+// ставка действует для заказов не легче MinWeight
+type StorageFeeRate struct {
+	PackageType string
+	MinWeight   Weight
+	PerDay      Money
+}
+
+// StorageFeePolicy — первые FreeDays суток хранение бесплатно, дальше берем ставку за каждые начатые сутки
+type StorageFeePolicy struct {
+	FreeDays      uint32
+	DefaultPerDay Money
+	Rates         []StorageFeeRate
+}
+
+// StorageFeeKind — за что начислена плата за хранение
+type StorageFeeKind int16
+
+const (
+	// StorageFeeOverdue — хранение сверх бесплатного срока, начисляется при выдаче
+	StorageFeeOverdue StorageFeeKind = iota
+	// StorageFeeExtension — продление срока хранения
+	StorageFeeExtension
+)
+
+// StorageFee — начисление за хранение, запись журнала платежей
+type StorageFee struct {
+	Kind       StorageFeeKind
+	OrderID    uint64
+	PVZID      uint64
+	ReceiverID uint64
+	PaidDays   uint32
+	DailyRate  Money
+	Amount     Money
+	ChargedAt  time.Time
+}
+
+// DailyRate выбирает самую конкретную ставку: сначала по упаковке, затем с наибольшим порогом веса
+func (p StorageFeePolicy) DailyRate(packageType string, weight Weight) Money {
+	parts := SplitPackageCode(packageType)
+
+	var best *StorageFeeRate
+	for i := range p.Rates {
+		r := &p.Rates[i]
+		if r.PackageType != "" && !slices.Contains(parts, r.PackageType) {
+			continue
+		}
+		if weight < r.MinWeight {
+			continue
+		}
+		if best == nil || rateMoreSpecific(*r, *best) {
+			best = r
+		}
+	}
+	if best == nil {
+		return p.DefaultPerDay
+	}
+	return best.PerDay
+}
+
+func rateMoreSpecific(a, b StorageFeeRate) bool {
+	if (a.PackageType != "") != (b.PackageType != "") {
+		return a.PackageType != ""
+	}
+	return a.MinWeight > b.MinWeight
+}
+
+// Charge считает плату за хранение заказа с момента приемки до now. Дни продления хранения
+// уже оплачены отдельным начислением и вместе с бесплатными днями в плату не входят
+func (p StorageFeePolicy) Charge(order Order, now time.Time) StorageFee {
+	fee := StorageFee{
+		OrderID:    order.OrderID,
+		PVZID:      order.PVZID,
+		ReceiverID: order.ReceiverID,
+		ChargedAt:  now,
+	}
+
+	stored := now.Sub(order.AcceptTime)
+	if stored <= 0 {
+		return fee
+	}
+	days := uint32((stored + 24*time.Hour - 1) / (24 * time.Hour))
+	covered := p.FreeDays + order.ExtendedDays
+	if days <= covered {
+		return fee
+	}
+
+	fee.PaidDays = days - covered
+	fee.DailyRate = p.DailyRate(order.PackageType, order.Weight)
+	fee.Amount = fee.DailyRate * Money(fee.PaidDays)
+	return fee
+}
@@ -3,7 +3,9 @@ package telegram
 import (
 	"context"
 	"fmt"
+	"html"
 	"log/slog"
+	"strings"
 	"time"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
@@ -57,48 +59,150 @@ func (f *MessageFormatter) FormatEvent(event *domain.Event) string {
 		return fmt.Sprintf(
 			"📦 <b>Заказ принят</b>\n\n"+
 				"🆔 Заказ: <code>%d</code>\n"+
-				"👤 Клиент: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
 				"👨‍💼 Курьер: <code>%d</code>\n"+
-				"🕐 Время: %s\n\n"+
+				"🕐 Время: %s\n"+
+				"🔑 Код выдачи: %s\n\n"+
 				"✅ Заказ успешно принят от курьера и размещен в ПВЗ",
-			event.Order.ID, event.Order.UserID, event.Actor.ID, timestamp)
+			event.Order.ID, formatReceiver(event.Order), event.Actor.ID, timestamp, formatPickupCode(event.Order.PickupCode))
 
 	case domain.EventTypeOrderIssued:
+		fee := ""
+		if event.Order.StorageFee > 0 {
+			fee = fmt.Sprintf("💰 Платное хранение: %s ₽ за %d сут.\n",
+				event.Order.StorageFee, event.Order.StorageFeeDays)
+		}
 		return fmt.Sprintf(
 			"✅ <b>Заказ выдан клиенту</b>\n\n"+
 				"🆔 Заказ: <code>%d</code>\n"+
-				"👤 Клиент: <code>%d</code>\n"+
-				"🕐 Время: %s\n\n"+
+				"👤 Клиент: %s\n"+
+				"🕐 Время: %s\n"+
+				"%s\n"+
 				"🎉 Клиент получил свой заказ!",
-			event.Order.ID, event.Order.UserID, timestamp)
+			event.Order.ID, formatReceiver(event.Order), timestamp, fee)
 
 	case domain.EventTypeOrderReturnedByClient:
 		return fmt.Sprintf(
 			"↩️ <b>Возврат от клиента</b>\n\n"+
 				"🆔 Заказ: <code>%d</code>\n"+
-				"👤 Клиент: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
 				"🕐 Время: %s\n\n"+
 				"📥 Клиент вернул заказ в ПВЗ",
-			event.Order.ID, event.Order.UserID, timestamp)
+			event.Order.ID, formatReceiver(event.Order), timestamp)
 
 	case domain.EventTypeOrderReturnedToCourier:
 		return fmt.Sprintf(
 			"📮 <b>Возврат курьеру</b>\n\n"+
 				"🆔 Заказ: <code>%d</code>\n"+
-				"👤 Клиент: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
 				"🕐 Время: %s\n\n"+
 				"⚠️ Заказ возвращен курьеру (истек срок хранения или возврат от клиента)",
-			event.Order.ID, event.Order.UserID, timestamp)
+			event.Order.ID, formatReceiver(event.Order), timestamp)
+
+	case domain.EventTypeOrderStorageExtended:
+		return fmt.Sprintf(
+			"⏳ <b>Хранение продлено</b>\n\n"+
+				"🆔 Заказ: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
+				"🕐 Время: %s\n\n"+
+				"📦 Заказ останется в ПВЗ дольше",
+			event.Order.ID, formatReceiver(event.Order), timestamp)
+
+	case domain.EventTypeOrderPaid:
+		return fmt.Sprintf(
+			"💳 <b>Заказ оплачен</b>\n\n"+
+				"🆔 Заказ: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
+				"💰 Сумма: %s ₽\n"+
+				"🕐 Время: %s\n\n"+
+				"✅ Оплата при получении принята, заказ можно выдать",
+			event.Order.ID, formatReceiver(event.Order), event.Order.Amount, timestamp)
+
+	case domain.EventTypeOrderRefunded:
+		return fmt.Sprintf(
+			"💸 <b>Возврат денег</b>\n\n"+
+				"🆔 Заказ: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
+				"💰 Сумма: %s ₽\n"+
+				"🕐 Время: %s\n\n"+
+				"↩️ Деньги за возвращенный заказ возвращаются клиенту",
+			event.Order.ID, formatReceiver(event.Order), event.Order.Amount, timestamp)
+
+	case domain.EventTypeOrderAnnounced:
+		return fmt.Sprintf(
+			"🚚 <b>Заказ в пути</b>\n\n"+
+				"🆔 Заказ: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
+				"📋 Поставка: <code>%s</code>\n"+
+				"🕐 Время: %s\n\n"+
+				"⏳ Заказ анонсирован и ожидает поступления в ПВЗ",
+			event.Order.ID, formatReceiver(event.Order), event.Order.ShipmentID, timestamp)
+
+	case domain.EventTypeOrderArrived:
+		return fmt.Sprintf(
+			"📦 <b>Заказ прибыл</b>\n\n"+
+				"🆔 Заказ: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
+				"📋 Поставка: <code>%s</code>\n"+
+				"🕐 Время: %s\n"+
+				"🔑 Код выдачи: %s\n\n"+
+				"✅ Заказ поступил в ПВЗ и готов к выдаче",
+			event.Order.ID, formatReceiver(event.Order), event.Order.ShipmentID, timestamp, formatPickupCode(event.Order.PickupCode))
+
+	case domain.EventTypeOrderMissing:
+		return fmt.Sprintf(
+			"❗ <b>Недостача в поставке</b>\n\n"+
+				"🆔 Заказ: <code>%d</code>\n"+
+				"📋 Поставка: <code>%s</code>\n"+
+				"🕐 Время: %s\n\n"+
+				"⚠️ Заказ был анонсирован, но не прибыл с поставкой",
+			event.Order.ID, event.Order.ShipmentID, timestamp)
+
+	case domain.EventTypeOrderUnexpected:
+		return fmt.Sprintf(
+			"❓ <b>Посылка без анонса</b>\n\n"+
+				"🆔 Заказ: <code>%d</code>\n"+
+				"📋 Поставка: <code>%s</code>\n"+
+				"🕐 Время: %s\n\n"+
+				"⚠️ Посылка прибыла, но ее не было в анонсе поставки",
+			event.Order.ID, event.Order.ShipmentID, timestamp)
 
 	default:
 		return fmt.Sprintf(
 			"❓ <b>Неизвестное событие</b>\n\n"+
 				"🔤 Тип: <code>%s</code>\n"+
 				"🆔 Заказ: <code>%d</code>\n"+
-				"👤 Клиент: <code>%d</code>\n"+
+				"👤 Клиент: %s\n"+
 				"🕐 Время: %s",
-			event.EventType, event.Order.ID, event.Order.UserID, timestamp)
+			event.EventType, event.Order.ID, formatReceiver(event.Order), timestamp)
+	}
+}
+
+// formatReceiver добавляет к id получателя имя и телефон из справочника, если они пришли в событии
+// новый код выпускается, только когда у получателя нет других заказов на хранении
+func formatPickupCode(code string) string {
+	if code == "" {
+		return "прежний, из уведомления о предыдущем заказе"
+	}
+	return fmt.Sprintf("<code>%s</code>", code)
+}
+
+func formatReceiver(order domain.OrderInfo) string {
+	id := fmt.Sprintf("<code>%d</code>", order.UserID)
+	if order.Receiver == nil {
+		return id
+	}
+	var contact []string
+	if order.Receiver.Name != "" {
+		contact = append(contact, html.EscapeString(order.Receiver.Name))
+	}
+	if order.Receiver.Phone != "" {
+		contact = append(contact, order.Receiver.Phone)
+	}
+	if len(contact) == 0 {
+		return id
 	}
+	return id + " " + strings.Join(contact, ", ")
 }
 
 func (f *MessageFormatter) FormatStatistics(processedCount uint64, eventType domain.EventType) string {
@@ -24,7 +24,7 @@ var (
 	OrdersByStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "pvz_orders_by_status",
 		Help: "Number of orders by status",
-	}, []string{"status"})
+	}, []string{"pvz_id", "status"})
 
 	GRPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "pvz_grpc_duration_seconds",
@@ -2,20 +2,21 @@ package metrics
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 )
 
 type OrderRepository interface {
-	GetAllOrders(ctx context.Context) ([]domain.Order, error)
+	CountOrdersByStatus(ctx context.Context, pvzID uint64) (map[domain.OrderStatus]uint64, error)
 }
 
 type MetricsProvider interface {
 	OrderAccepted()
 	OrdersIssued(count uint64)
 	OrdersReturned(returnType string, count uint64)
-	UpdateOrderStatusMetrics(statusCounts map[string]int)
+	UpdateOrderStatusMetrics(pvzID uint64, statusCounts map[string]int)
 
 	RecordGRPCDuration(method, status string, duration float64)
 
@@ -26,7 +27,7 @@ type MetricsProvider interface {
 	UpdateCacheMetrics(stats map[string]int)
 	RecordCacheHit(cacheType, result string)
 
-	RefreshOrderStatusMetrics(repo OrderRepository)
+	RefreshOrderStatusMetrics(repo OrderRepository, pvzID uint64)
 }
 
 type PrometheusProvider struct{}
@@ -47,9 +48,10 @@ func (p *PrometheusProvider) OrdersReturned(returnType string, count uint64) {
 	OrdersReturnedTotal.WithLabelValues(returnType).Add(float64(count))
 }
 
-func (p *PrometheusProvider) UpdateOrderStatusMetrics(statusCounts map[string]int) {
+func (p *PrometheusProvider) UpdateOrderStatusMetrics(pvzID uint64, statusCounts map[string]int) {
+	pvzLabel := strconv.FormatUint(pvzID, 10)
 	for status, count := range statusCounts {
-		OrdersByStatus.WithLabelValues(status).Set(float64(count))
+		OrdersByStatus.WithLabelValues(pvzLabel, status).Set(float64(count))
 	}
 }
 
@@ -76,22 +78,23 @@ func (p *PrometheusProvider) RecordCacheHit(cacheType, result string) {
 	CacheHits.WithLabelValues(cacheType, result).Inc()
 }
 
-func (p *PrometheusProvider) RefreshOrderStatusMetrics(repo OrderRepository) {
+func (p *PrometheusProvider) RefreshOrderStatusMetrics(repo OrderRepository, pvzID uint64) {
 	go func() {
 		metricsCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		orders, err := repo.GetAllOrders(metricsCtx)
+		counts, err := repo.CountOrdersByStatus(metricsCtx, pvzID)
 		if err != nil {
 			return
 		}
 
+		// статусы без заказов тоже выставляем, иначе gauge залипнет на последнем ненулевом значении
 		statusCounts := make(map[string]int)
-		for _, order := range orders {
-			statusCounts[order.GetStatusString()]++
+		for st := domain.StatusInStorage; st <= domain.StatusExpected; st++ {
+			statusCounts[st.String()] = int(counts[st])
 		}
 
-		p.UpdateOrderStatusMetrics(statusCounts)
+		p.UpdateOrderStatusMetrics(pvzID, statusCounts)
 	}()
 }
 
@@ -104,10 +107,10 @@ func NewNoOpProvider() *NoOpProvider {
 func (p *NoOpProvider) OrderAccepted()                                                      {}
 func (p *NoOpProvider) OrdersIssued(count uint64)                                           {}
 func (p *NoOpProvider) OrdersReturned(returnType string, count uint64)                      {}
-func (p *NoOpProvider) UpdateOrderStatusMetrics(statusCounts map[string]int)                {}
+func (p *NoOpProvider) UpdateOrderStatusMetrics(pvzID uint64, statusCounts map[string]int)  {}
 func (p *NoOpProvider) RecordGRPCDuration(method, status string, duration float64)          {}
 func (p *NoOpProvider) UpdateWorkerPoolMetrics(active, total, queueSize, queueCapacity int) {}
 func (p *NoOpProvider) KafkaMessageProcessed(status string)                                 {}
 func (p *NoOpProvider) UpdateCacheMetrics(stats map[string]int)                             {}
 func (p *NoOpProvider) RecordCacheHit(cacheType, result string)                             {}
-func (p *NoOpProvider) RefreshOrderStatusMetrics(repo OrderRepository)                      {}
+func (p *NoOpProvider) RefreshOrderStatusMetrics(repo OrderRepository, pvzID uint64)        {}
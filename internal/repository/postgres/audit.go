@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+// ключ advisory-блокировки: записи журнала добавляются строго по одной, иначе две
+// параллельные вставки сошлются на один и тот же prev_hash
+const auditChainLockKey = 7_301_001
+
+const selectAuditQuery = `
+        SELECT id, method, pvz_id, actor_type, actor_id, sender, peer, payload, order_ids,
+               result_code, error, created_at, prev_hash, hash
+        FROM audit_log`
+
+// AppendAuditRecord дописывает запись в конец цепочки: берет хеш последней записи и считает свой
+func (r *OrderRepository) AppendAuditRecord(ctx context.Context, rec domain.AuditRecord) (domain.AuditRecord, error) {
+	const insert = `
+        INSERT INTO audit_log (method, pvz_id, actor_type, actor_id, sender, peer, payload, order_ids,
+                               result_code, error, created_at, prev_hash, hash)
+        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)
+        RETURNING id`
+
+	orderIDs := make([]int64, len(rec.OrderIDs))
+	for i, id := range rec.OrderIDs {
+		orderIDs[i] = int64(id)
+	}
+
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, auditChainLockKey); err != nil {
+			return fmt.Errorf("lock audit chain: %w", err)
+		}
+
+		err := tx.QueryRow(ctx, `SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1`).Scan(&rec.PrevHash)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("select last hash: %w", err)
+		}
+		rec.Hash = rec.ComputeHash()
+
+		return tx.QueryRow(ctx, insert,
+			rec.Method, rec.PVZID, rec.Actor.Type, rec.Actor.ID, rec.Sender, rec.Peer, string(rec.Payload),
+			pq.Array(orderIDs), rec.ResultCode, rec.Error, rec.CreatedAt, rec.PrevHash, rec.Hash,
+		).Scan(&rec.ID)
+	})
+	if err != nil {
+		return domain.AuditRecord{}, fmt.Errorf("exec insert audit record: %w", err)
+	}
+	return rec, nil
+}
+
+// SearchAuditLog возвращает записи пункта по фильтру, новые первыми
+func (r *OrderRepository) SearchAuditLog(ctx context.Context, f domain.AuditFilter) ([]domain.AuditRecord, error) {
+	query := selectAuditQuery + `
+        WHERE pvz_id = $1
+          AND ($2::text = '' OR actor_type = $2)
+          AND ($3::bigint = 0 OR actor_id = $3)
+          AND ($4::bigint = 0 OR $4 = ANY(order_ids))
+          AND ($5::timestamptz IS NULL OR created_at >= $5)
+          AND ($6::timestamptz IS NULL OR created_at < $6)
+        ORDER BY id DESC
+        LIMIT $7`
+
+	from := sql.NullTime{Time: f.From, Valid: !f.From.IsZero()}
+	to := sql.NullTime{Time: f.To, Valid: !f.To.IsZero()}
+	rows, err := r.client.Query(ctx, query, f.PVZID, f.ActorType, f.ActorID, f.OrderID, from, to, f.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAuditRecords(rows)
+}
+
+// ListAuditChain читает цепочку по возрастанию ID, начиная после afterID
+func (r *OrderRepository) ListAuditChain(ctx context.Context, afterID, limit uint64) ([]domain.AuditRecord, error) {
+	query := selectAuditQuery + `
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2`
+
+	rows, err := r.client.Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAuditRecords(rows)
+}
+
+func scanAuditRecords(rows *sql.Rows) ([]domain.AuditRecord, error) {
+	var list []domain.AuditRecord
+	for rows.Next() {
+		var (
+			rec      domain.AuditRecord
+			payload  string
+			orderIDs pq.Int64Array
+		)
+		err := rows.Scan(&rec.ID, &rec.Method, &rec.PVZID, &rec.Actor.Type, &rec.Actor.ID, &rec.Sender, &rec.Peer,
+			&payload, &orderIDs, &rec.ResultCode, &rec.Error, &rec.CreatedAt, &rec.PrevHash, &rec.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		rec.Payload = []byte(payload)
+		for _, id := range orderIDs {
+			rec.OrderIDs = append(rec.OrderIDs, uint64(id))
+		}
+		list = append(list, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+	return list, nil
+}
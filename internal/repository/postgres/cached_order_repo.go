@@ -2,7 +2,9 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
 	"gitlab.ozon.dev/safariproxd/homework/internal/metrics"
@@ -10,12 +12,17 @@ import (
 	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
+// политик немного, поэтому кешируем весь список под одним ключом
+const returnPoliciesKey = "return_policies"
+
 type CachedOrderRepository struct {
 	repo              *OrderRepository
 	orderCache        *cache.LRUCache[string, domain.Order]
 	receiverCache     *cache.LRUCache[string, []domain.Order]
 	historyCache      *cache.LRUCache[string, []domain.OrderHistory]
-	packageRulesCache *cache.LRUCache[string, []domain.PackageRules]
+	packageRulesCache *cache.LRUCache[string, domain.PackageRules]
+	pickupPointCache  *cache.LRUCache[string, domain.PickupPoint]
+	returnPolicyCache *cache.LRUCache[string, []domain.ReturnPolicy]
 	metricsProvider   metrics.MetricsProvider
 }
 
@@ -27,7 +34,9 @@ func NewCachedOrderRepository(client *db.Client, cacheConfig cache.Config, metri
 		orderCache:        cache.New[string, domain.Order](cacheConfig),
 		receiverCache:     cache.New[string, []domain.Order](cacheConfig),
 		historyCache:      cache.New[string, []domain.OrderHistory](cacheConfig),
-		packageRulesCache: cache.New[string, []domain.PackageRules](cacheConfig),
+		packageRulesCache: cache.New[string, domain.PackageRules](cacheConfig),
+		pickupPointCache:  cache.New[string, domain.PickupPoint](cacheConfig),
+		returnPolicyCache: cache.New[string, []domain.ReturnPolicy](cacheConfig),
 		metricsProvider:   metricsProvider,
 	}
 }
@@ -47,11 +56,14 @@ func (r *CachedOrderRepository) Save(ctx context.Context, order domain.Order) er
 	if err := r.repo.Save(ctx, order); err != nil {
 		return err
 	}
-	r.invalidateOrderCaches(order.OrderID, order.ReceiverID)
+	r.invalidateOrderCaches(order)
 	r.orderCache.Set(r.orderKey(order.OrderID), order)
 	return nil
 }
 
+// GetByID отдает заказ из кэша или с мастера. Кэш заполняется только версиями с мастера, поэтому
+// отставание реплики не попадает в проверку версии при Update; устаревший после чужого изменения
+// заказ сбрасывается на конфликте, и повтор перечитывает его с мастера
 func (r *CachedOrderRepository) GetByID(ctx context.Context, orderID uint64) (domain.Order, error) {
 	key := r.orderKey(orderID)
 
@@ -72,15 +84,18 @@ func (r *CachedOrderRepository) GetByID(ctx context.Context, orderID uint64) (do
 
 func (r *CachedOrderRepository) Update(ctx context.Context, order domain.Order) error {
 	if err := r.repo.Update(ctx, order); err != nil {
+		r.invalidateOnConflict(order, err)
 		return err
 	}
-	r.invalidateOrderCaches(order.OrderID, order.ReceiverID)
+	r.invalidateOrderCaches(order)
+	// в базе версия уже увеличена, в кэше должна быть такая же
+	order.Version++
 	r.orderCache.Set(r.orderKey(order.OrderID), order)
 	return nil
 }
 
-func (r *CachedOrderRepository) GetByReceiverID(ctx context.Context, receiverID uint64) ([]domain.Order, error) {
-	key := r.receiverKey(receiverID)
+func (r *CachedOrderRepository) GetByReceiverID(ctx context.Context, pvzID, receiverID uint64) ([]domain.Order, error) {
+	key := r.receiverKey(pvzID, receiverID)
 
 	if orders, found := r.receiverCache.Get(key); found {
 		r.metricsProvider.RecordCacheHit("receivers", "hit")
@@ -88,7 +103,7 @@ func (r *CachedOrderRepository) GetByReceiverID(ctx context.Context, receiverID
 	}
 
 	r.metricsProvider.RecordCacheHit("receivers", "miss")
-	orders, err := r.repo.GetByReceiverID(ctx, receiverID)
+	orders, err := r.repo.GetByReceiverID(ctx, pvzID, receiverID)
 	if err != nil {
 		return orders, err
 	}
@@ -97,26 +112,22 @@ func (r *CachedOrderRepository) GetByReceiverID(ctx context.Context, receiverID
 	return orders, nil
 }
 
-func (r *CachedOrderRepository) GetReturnedOrders(ctx context.Context) ([]domain.Order, error) {
-	key := "returned_orders"
+// страницы списков не кешируются: ключей слишком много, а запросы и так читают только одну страницу
 
-	if orders, found := r.receiverCache.Get(key); found {
-		r.metricsProvider.RecordCacheHit("receivers", "hit")
-		return orders, nil
-	}
+func (r *CachedOrderRepository) ListOrdersByID(ctx context.Context, f domain.OrderListFilter, q domain.OrderPageQuery) ([]domain.Order, error) {
+	return r.repo.ListOrdersByID(ctx, f, q)
+}
 
-	r.metricsProvider.RecordCacheHit("receivers", "miss")
-	orders, err := r.repo.GetReturnedOrders(ctx)
-	if err != nil {
-		return orders, err
-	}
+func (r *CachedOrderRepository) ListOrdersByUpdate(ctx context.Context, f domain.OrderListFilter, q domain.OrderPageQuery) ([]domain.Order, error) {
+	return r.repo.ListOrdersByUpdate(ctx, f, q)
+}
 
-	r.receiverCache.Set(key, orders)
-	return orders, nil
+func (r *CachedOrderRepository) CountOrders(ctx context.Context, f domain.OrderListFilter) (uint64, error) {
+	return r.repo.CountOrders(ctx, f)
 }
 
-func (r *CachedOrderRepository) GetAllOrders(ctx context.Context) ([]domain.Order, error) {
-	key := "all_orders"
+func (r *CachedOrderRepository) GetAllOrders(ctx context.Context, pvzID uint64) ([]domain.Order, error) {
+	key := r.allOrdersKey(pvzID)
 
 	if orders, found := r.receiverCache.Get(key); found {
 		r.metricsProvider.RecordCacheHit("receivers", "hit")
@@ -124,7 +135,7 @@ func (r *CachedOrderRepository) GetAllOrders(ctx context.Context) ([]domain.Orde
 	}
 
 	r.metricsProvider.RecordCacheHit("receivers", "miss")
-	orders, err := r.repo.GetAllOrders(ctx)
+	orders, err := r.repo.GetAllOrders(ctx, pvzID)
 	if err != nil {
 		return orders, err
 	}
@@ -133,7 +144,16 @@ func (r *CachedOrderRepository) GetAllOrders(ctx context.Context) ([]domain.Orde
 	return orders, nil
 }
 
-func (r *CachedOrderRepository) GetPackageRules(ctx context.Context, code string) ([]domain.PackageRules, error) {
+func (r *CachedOrderRepository) CountOrdersByStatus(ctx context.Context, pvzID uint64) (map[domain.OrderStatus]uint64, error) {
+	return r.repo.CountOrdersByStatus(ctx, pvzID)
+}
+
+// ExportOrders не кешируется: выгрузка идет курсором мимо кеша
+func (r *CachedOrderRepository) ExportOrders(ctx context.Context, f domain.OrderExportFilter, emit func(domain.Order) error) error {
+	return r.repo.ExportOrders(ctx, f, emit)
+}
+
+func (r *CachedOrderRepository) GetPackageRules(ctx context.Context, code string) (domain.PackageRules, error) {
 	key := fmt.Sprintf("package_rules:%s", code)
 
 	if rules, found := r.packageRulesCache.Get(key); found {
@@ -151,6 +171,312 @@ func (r *CachedOrderRepository) GetPackageRules(ctx context.Context, code string
 	return rules, nil
 }
 
+// правила комбинаций зависят от частей, поэтому любое изменение справочника сбрасывает весь кэш
+func (r *CachedOrderRepository) SavePackageType(ctx context.Context, p domain.PackageType) error {
+	err := r.repo.SavePackageType(ctx, p)
+	if err == nil {
+		r.packageRulesCache.Clear()
+	}
+	return err
+}
+
+func (r *CachedOrderRepository) UpdatePackageType(ctx context.Context, p domain.PackageType) error {
+	err := r.repo.UpdatePackageType(ctx, p)
+	if err == nil {
+		r.packageRulesCache.Clear()
+	}
+	return err
+}
+
+func (r *CachedOrderRepository) DeletePackageType(ctx context.Context, code string) error {
+	err := r.repo.DeletePackageType(ctx, code)
+	if err == nil {
+		r.packageRulesCache.Clear()
+	}
+	return err
+}
+
+func (r *CachedOrderRepository) ListPackageTypes(ctx context.Context) ([]domain.PackageType, error) {
+	return r.repo.ListPackageTypes(ctx)
+}
+
+func (r *CachedOrderRepository) SaveStorageFee(ctx context.Context, f domain.StorageFee) error {
+	return r.repo.SaveStorageFee(ctx, f)
+}
+
+func (r *CachedOrderRepository) SaveStorageFeeInTx(ctx context.Context, tx *db.Tx, f domain.StorageFee) error {
+	return r.repo.SaveStorageFeeInTx(ctx, tx, f)
+}
+
+func (r *CachedOrderRepository) SavePayment(ctx context.Context, p domain.Payment) (domain.Payment, error) {
+	return r.repo.SavePayment(ctx, p)
+}
+
+func (r *CachedOrderRepository) SavePaymentInTx(ctx context.Context, tx *db.Tx, p domain.Payment) (domain.Payment, error) {
+	return r.repo.SavePaymentInTx(ctx, tx, p)
+}
+
+func (r *CachedOrderRepository) GetByShipmentID(ctx context.Context, pvzID uint64, shipmentID string) ([]domain.Order, error) {
+	return r.repo.GetByShipmentID(ctx, pvzID, shipmentID)
+}
+
+func (r *CachedOrderRepository) SaveDiscrepancy(ctx context.Context, d domain.ArrivalDiscrepancy) (bool, error) {
+	return r.repo.SaveDiscrepancy(ctx, d)
+}
+
+func (r *CachedOrderRepository) SaveDiscrepancyInTx(ctx context.Context, tx *db.Tx, d domain.ArrivalDiscrepancy) (bool, error) {
+	return r.repo.SaveDiscrepancyInTx(ctx, tx, d)
+}
+
+func (r *CachedOrderRepository) ResolveMissing(ctx context.Context, pvzID uint64, shipmentID string, orderID uint64) error {
+	return r.repo.ResolveMissing(ctx, pvzID, shipmentID, orderID)
+}
+
+func (r *CachedOrderRepository) ResolveMissingInTx(
+	ctx context.Context,
+	tx *db.Tx,
+	pvzID uint64,
+	shipmentID string,
+	orderID uint64,
+) error {
+	return r.repo.ResolveMissingInTx(ctx, tx, pvzID, shipmentID, orderID)
+}
+
+func (r *CachedOrderRepository) ListDiscrepancies(ctx context.Context, pvzID uint64, shipmentID string) ([]domain.ArrivalDiscrepancy, error) {
+	return r.repo.ListDiscrepancies(ctx, pvzID, shipmentID)
+}
+
+func (r *CachedOrderRepository) ListDueForReturn(ctx context.Context, pvzID uint64, now time.Time) ([]domain.Order, error) {
+	return r.repo.ListDueForReturn(ctx, pvzID, now)
+}
+
+func (r *CachedOrderRepository) SaveReturnManifest(ctx context.Context, m domain.ReturnManifest) (domain.ReturnManifest, error) {
+	return r.repo.SaveReturnManifest(ctx, m)
+}
+
+func (r *CachedOrderRepository) GetReturnManifest(ctx context.Context, id uint64) (domain.ReturnManifest, error) {
+	return r.repo.GetReturnManifest(ctx, id)
+}
+
+func (r *CachedOrderRepository) ListReturnManifests(ctx context.Context, pvzID uint64) ([]domain.ReturnManifest, error) {
+	return r.repo.ListReturnManifests(ctx, pvzID)
+}
+
+func (r *CachedOrderRepository) RemoveManifestOrder(ctx context.Context, manifestID, orderID uint64) error {
+	return r.repo.RemoveManifestOrder(ctx, manifestID, orderID)
+}
+
+func (r *CachedOrderRepository) RemoveManifestOrderInTx(ctx context.Context, tx *db.Tx, manifestID, orderID uint64) error {
+	return r.repo.RemoveManifestOrderInTx(ctx, tx, manifestID, orderID)
+}
+
+func (r *CachedOrderRepository) MarkManifestHandedOver(ctx context.Context, id uint64, at time.Time) error {
+	return r.repo.MarkManifestHandedOver(ctx, id, at)
+}
+
+func (r *CachedOrderRepository) MarkManifestHandedOverInTx(ctx context.Context, tx *db.Tx, id uint64, at time.Time) error {
+	return r.repo.MarkManifestHandedOverInTx(ctx, tx, id, at)
+}
+
+func (r *CachedOrderRepository) UpsertReceiver(ctx context.Context, rec domain.Receiver) (domain.Receiver, error) {
+	return r.repo.UpsertReceiver(ctx, rec)
+}
+
+func (r *CachedOrderRepository) GetReceiver(ctx context.Context, id uint64) (domain.Receiver, error) {
+	return r.repo.GetReceiver(ctx, id)
+}
+
+func (r *CachedOrderRepository) GetReceiverInTx(ctx context.Context, tx *db.Tx, id uint64) (domain.Receiver, error) {
+	return r.repo.GetReceiverInTx(ctx, tx, id)
+}
+
+func (r *CachedOrderRepository) GetReceiverByPhone(ctx context.Context, phone string) (domain.Receiver, error) {
+	return r.repo.GetReceiverByPhone(ctx, phone)
+}
+
+func (r *CachedOrderRepository) SearchReceivers(ctx context.Context, query string, limit uint64) ([]domain.Receiver, error) {
+	return r.repo.SearchReceivers(ctx, query, limit)
+}
+
+func (r *CachedOrderRepository) AppendAuditRecord(ctx context.Context, rec domain.AuditRecord) (domain.AuditRecord, error) {
+	return r.repo.AppendAuditRecord(ctx, rec)
+}
+
+func (r *CachedOrderRepository) SearchAuditLog(ctx context.Context, f domain.AuditFilter) ([]domain.AuditRecord, error) {
+	return r.repo.SearchAuditLog(ctx, f)
+}
+
+func (r *CachedOrderRepository) ListAuditChain(ctx context.Context, afterID, limit uint64) ([]domain.AuditRecord, error) {
+	return r.repo.ListAuditChain(ctx, afterID, limit)
+}
+
+func (r *CachedOrderRepository) ReserveIdempotencyKey(ctx context.Context, rec domain.IdempotencyRecord,
+	expiredBefore, staleBefore time.Time) (domain.IdempotencyRecord, bool, error) {
+	return r.repo.ReserveIdempotencyKey(ctx, rec, expiredBefore, staleBefore)
+}
+
+func (r *CachedOrderRepository) CompleteIdempotencyKey(ctx context.Context, rec domain.IdempotencyRecord) (bool, error) {
+	return r.repo.CompleteIdempotencyKey(ctx, rec)
+}
+
+func (r *CachedOrderRepository) ReleaseIdempotencyKey(ctx context.Context, pvzID uint64, key, method string, claimedAt time.Time) error {
+	return r.repo.ReleaseIdempotencyKey(ctx, pvzID, key, method, claimedAt)
+}
+
+func (r *CachedOrderRepository) PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	return r.repo.PurgeIdempotencyKeys(ctx, before)
+}
+
+func (r *CachedOrderRepository) SaveImportJob(ctx context.Context, job domain.ImportJob,
+	orders []domain.OrderToImport) (domain.ImportJob, error) {
+	return r.repo.SaveImportJob(ctx, job, orders)
+}
+
+func (r *CachedOrderRepository) GetImportJob(ctx context.Context, id uint64) (domain.ImportJob, error) {
+	return r.repo.GetImportJob(ctx, id)
+}
+
+func (r *CachedOrderRepository) ListUnfinishedImportJobs(ctx context.Context, staleBefore time.Time) ([]domain.ImportJob, error) {
+	return r.repo.ListUnfinishedImportJobs(ctx, staleBefore)
+}
+
+func (r *CachedOrderRepository) ClaimImportJob(ctx context.Context, id uint64, owner string, at, staleBefore time.Time) (bool, error) {
+	return r.repo.ClaimImportJob(ctx, id, owner, at, staleBefore)
+}
+
+func (r *CachedOrderRepository) ReleaseImportJob(ctx context.Context, id uint64, owner string) error {
+	return r.repo.ReleaseImportJob(ctx, id, owner)
+}
+
+func (r *CachedOrderRepository) ListImportJobRows(ctx context.Context, jobID, afterRow, limit uint64) ([]domain.ImportRow, error) {
+	return r.repo.ListImportJobRows(ctx, jobID, afterRow, limit)
+}
+
+func (r *CachedOrderRepository) UpdateImportJobStatus(ctx context.Context, id uint64, from []domain.ImportJobStatus,
+	to domain.ImportJobStatus, errMsg string, at time.Time) (bool, error) {
+	return r.repo.UpdateImportJobStatus(ctx, id, from, to, errMsg, at)
+}
+
+func (r *CachedOrderRepository) CommitImportJobProgress(ctx context.Context, p domain.ImportJobProgress) (bool, error) {
+	return r.repo.CommitImportJobProgress(ctx, p)
+}
+
+func (r *CachedOrderRepository) SavePickupPoint(ctx context.Context, p domain.PickupPoint) (domain.PickupPoint, error) {
+	saved, err := r.repo.SavePickupPoint(ctx, p)
+	if err != nil {
+		return saved, err
+	}
+	r.pickupPointCache.Set(r.pickupPointKey(saved.ID), saved)
+	return saved, nil
+}
+
+func (r *CachedOrderRepository) GetPickupPoint(ctx context.Context, pvzID uint64) (domain.PickupPoint, error) {
+	key := r.pickupPointKey(pvzID)
+
+	if p, found := r.pickupPointCache.Get(key); found {
+		r.metricsProvider.RecordCacheHit("pickup_points", "hit")
+		return p, nil
+	}
+
+	r.metricsProvider.RecordCacheHit("pickup_points", "miss")
+	p, err := r.repo.GetPickupPoint(ctx, pvzID)
+	if err != nil {
+		return p, err
+	}
+
+	r.pickupPointCache.Set(key, p)
+	return p, nil
+}
+
+func (r *CachedOrderRepository) ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error) {
+	return r.repo.ListPickupPoints(ctx)
+}
+
+func (r *CachedOrderRepository) SaveReturnPolicy(ctx context.Context, p domain.ReturnPolicy) (domain.ReturnPolicy, error) {
+	saved, err := r.repo.SaveReturnPolicy(ctx, p)
+	if err != nil {
+		return saved, err
+	}
+	r.returnPolicyCache.Delete(returnPoliciesKey)
+	return saved, nil
+}
+
+func (r *CachedOrderRepository) ListReturnPolicies(ctx context.Context) ([]domain.ReturnPolicy, error) {
+	if policies, found := r.returnPolicyCache.Get(returnPoliciesKey); found {
+		r.metricsProvider.RecordCacheHit("return_policy", "hit")
+		return policies, nil
+	}
+
+	r.metricsProvider.RecordCacheHit("return_policy", "miss")
+	policies, err := r.repo.ListReturnPolicies(ctx)
+	if err != nil {
+		return policies, err
+	}
+
+	r.returnPolicyCache.Set(returnPoliciesKey, policies)
+	return policies, nil
+}
+
+func (r *CachedOrderRepository) SaveStorageCell(ctx context.Context, cell domain.StorageCell) (domain.StorageCell, error) {
+	return r.repo.SaveStorageCell(ctx, cell)
+}
+
+func (r *CachedOrderRepository) ListStorageCells(ctx context.Context, pvzID uint64) ([]domain.StorageCell, error) {
+	return r.repo.ListStorageCells(ctx, pvzID)
+}
+
+func (r *CachedOrderRepository) OccupyCell(ctx context.Context, pvzID uint64, size domain.CellSize) (domain.StorageCell, error) {
+	return r.repo.OccupyCell(ctx, pvzID, size)
+}
+
+func (r *CachedOrderRepository) OccupyCellByCode(ctx context.Context, pvzID uint64, code string) (domain.StorageCell, error) {
+	return r.repo.OccupyCellByCode(ctx, pvzID, code)
+}
+
+func (r *CachedOrderRepository) ReleaseCell(ctx context.Context, cellID uint64) error {
+	return r.repo.ReleaseCell(ctx, cellID)
+}
+
+func (r *CachedOrderRepository) OccupyCellInTx(ctx context.Context, tx *db.Tx, pvzID uint64, size domain.CellSize) (domain.StorageCell, error) {
+	return r.repo.OccupyCellInTx(ctx, tx, pvzID, size)
+}
+
+func (r *CachedOrderRepository) OccupyCellByCodeInTx(ctx context.Context, tx *db.Tx, pvzID uint64, code string) (domain.StorageCell, error) {
+	return r.repo.OccupyCellByCodeInTx(ctx, tx, pvzID, code)
+}
+
+func (r *CachedOrderRepository) ReleaseCellInTx(ctx context.Context, tx *db.Tx, cellID uint64) error {
+	return r.repo.ReleaseCellInTx(ctx, tx, cellID)
+}
+
+func (r *CachedOrderRepository) SavePickupCode(ctx context.Context, code domain.PickupCode) (bool, error) {
+	return r.repo.SavePickupCode(ctx, code)
+}
+
+func (r *CachedOrderRepository) SavePickupCodeInTx(
+	ctx context.Context,
+	tx *db.Tx,
+	code domain.PickupCode,
+) (bool, error) {
+	return r.repo.SavePickupCodeInTx(ctx, tx, code)
+}
+
+func (r *CachedOrderRepository) GetPickupCode(ctx context.Context, pvzID, receiverID uint64) (domain.PickupCode, error) {
+	return r.repo.GetPickupCode(ctx, pvzID, receiverID)
+}
+
+func (r *CachedOrderRepository) RegisterPickupCodeFailure(ctx context.Context, pvzID, receiverID uint64, maxAttempts uint32, lockUntil time.Time) (domain.PickupCode, error) {
+	return r.repo.RegisterPickupCodeFailure(ctx, pvzID, receiverID, maxAttempts, lockUntil)
+}
+
+func (r *CachedOrderRepository) ResetPickupCodeFailures(ctx context.Context, pvzID, receiverID uint64) error {
+	return r.repo.ResetPickupCodeFailures(ctx, pvzID, receiverID)
+}
+
+func (r *CachedOrderRepository) DeletePickupCode(ctx context.Context, pvzID, receiverID uint64) error {
+	return r.repo.DeletePickupCode(ctx, pvzID, receiverID)
+}
+
 func (r *CachedOrderRepository) SaveHistory(ctx context.Context, history domain.OrderHistory) error {
 	if err := r.repo.SaveHistory(ctx, history); err != nil {
 		return err
@@ -181,16 +507,17 @@ func (r *CachedOrderRepository) SaveOrderInTx(ctx context.Context, tx *db.Tx, or
 	if err := r.repo.SaveOrderInTx(ctx, tx, order); err != nil {
 		return err
 	}
-	r.invalidateOrderCaches(order.OrderID, order.ReceiverID)
+	r.invalidateOrderCaches(order)
 	return nil
 }
 
 func (r *CachedOrderRepository) UpdateOrderInTx(ctx context.Context, tx *db.Tx, order domain.Order) error {
 	if err := r.repo.UpdateOrderInTx(ctx, tx, order); err != nil {
+		r.invalidateOnConflict(order, err)
 		return err
 	}
 
-	r.invalidateOrderCaches(order.OrderID, order.ReceiverID)
+	r.invalidateOrderCaches(order)
 
 	return nil
 }
@@ -210,6 +537,8 @@ func (r *CachedOrderRepository) CleanupExpired() {
 	r.receiverCache.CleanupExpired()
 	r.historyCache.CleanupExpired()
 	r.packageRulesCache.CleanupExpired()
+	r.pickupPointCache.CleanupExpired()
+	r.returnPolicyCache.CleanupExpired()
 }
 
 func (r *CachedOrderRepository) ClearCache() {
@@ -217,6 +546,8 @@ func (r *CachedOrderRepository) ClearCache() {
 	r.receiverCache.Clear()
 	r.historyCache.Clear()
 	r.packageRulesCache.Clear()
+	r.pickupPointCache.Clear()
+	r.returnPolicyCache.Clear()
 }
 
 func (r *CachedOrderRepository) GetCacheStats() map[string]int {
@@ -225,6 +556,8 @@ func (r *CachedOrderRepository) GetCacheStats() map[string]int {
 		"receivers":     r.receiverCache.Size(),
 		"history":       r.historyCache.Size(),
 		"package_rules": r.packageRulesCache.Size(),
+		"pickup_points": r.pickupPointCache.Size(),
+		"return_policy": r.returnPolicyCache.Size(),
 	}
 
 	r.metricsProvider.UpdateCacheMetrics(stats)
@@ -236,20 +569,36 @@ func (r *CachedOrderRepository) orderKey(orderID uint64) string {
 	return fmt.Sprintf("order:%d", orderID)
 }
 
-func (r *CachedOrderRepository) receiverKey(receiverID uint64) string {
-	return fmt.Sprintf("receiver:%d", receiverID)
+func (r *CachedOrderRepository) receiverKey(pvzID, receiverID uint64) string {
+	return fmt.Sprintf("receiver:%d:%d", pvzID, receiverID)
+}
+
+func (r *CachedOrderRepository) allOrdersKey(pvzID uint64) string {
+	return fmt.Sprintf("all_orders:%d", pvzID)
+}
+
+func (r *CachedOrderRepository) pickupPointKey(pvzID uint64) string {
+	return fmt.Sprintf("pickup_point:%d", pvzID)
 }
 
 func (r *CachedOrderRepository) historyKey(orderID uint64) string {
 	return fmt.Sprintf("history:%d", orderID)
 }
 
-func (r *CachedOrderRepository) invalidateOrderCaches(orderID uint64, receiverID uint64) {
-	r.orderCache.Delete(r.orderKey(orderID))
-	r.receiverCache.Delete(r.receiverKey(receiverID))
+// конфликт версий чаще всего значит, что в кэше лежит устаревший заказ:
+// сбрасываем его, чтобы повторная попытка прочитала актуальную версию
+func (r *CachedOrderRepository) invalidateOnConflict(order domain.Order, err error) {
+	var domainErr domain.Error
+	if errors.As(err, &domainErr) && domainErr.Code == domain.ErrorCodeConcurrentModification {
+		r.invalidateOrderCaches(order)
+	}
+}
+
+func (r *CachedOrderRepository) invalidateOrderCaches(order domain.Order) {
+	r.orderCache.Delete(r.orderKey(order.OrderID))
+	r.receiverCache.Delete(r.receiverKey(order.PVZID, order.ReceiverID))
 
-	r.receiverCache.Delete("returned_orders")
-	r.receiverCache.Delete("all_orders")
+	r.receiverCache.Delete(r.allOrdersKey(order.PVZID))
 
-	r.historyCache.Delete(r.historyKey(orderID))
+	r.historyCache.Delete(r.historyKey(order.OrderID))
 }
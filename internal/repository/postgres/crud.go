@@ -29,14 +29,17 @@ func (r *OrderRepository) Exists(ctx context.Context, orderID uint64) (bool, err
 func (r *OrderRepository) Save(ctx context.Context, o domain.Order) error {
 	const query = `
         INSERT INTO orders (
-            id, receiver_id, expires_at, status,
-            accept_time, last_update_time, package_code, weight, price)
-        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+            id, receiver_id, pvz_id, expires_at, status,
+            accept_time, last_update_time, package_code, weight_grams, price_kopecks, cell_id, seller_id, extended_days,
+            cash_on_delivery, payment_status, shipment_id)
+        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)
         ON CONFLICT (id) DO NOTHING`
 
 	res, err := r.client.Exec(ctx, db.ModeWrite, query,
-		o.OrderID, o.ReceiverID, o.StorageUntil, o.Status,
+		o.OrderID, o.ReceiverID, o.PVZID, o.StorageUntil, o.Status,
 		o.AcceptTime, o.LastUpdateTime, o.PackageType, o.Weight, o.Price,
+		nullID(o.CellID), nullID(o.SellerID), o.ExtendedDays,
+		o.CashOnDelivery, o.PaymentStatus, nullString(o.ShipmentID),
 	)
 	if err != nil {
 		return fmt.Errorf("exec insert: %w", err)
@@ -48,31 +51,19 @@ func (r *OrderRepository) Save(ctx context.Context, o domain.Order) error {
 	return nil
 }
 
+// Update меняет заказ, только если его версия не изменилась с момента чтения
 func (r *OrderRepository) Update(ctx context.Context, o domain.Order) error {
-	const query = `
-        UPDATE orders
-        SET receiver_id = $2, expires_at = $3, status = $4,
-            accept_time = $5, last_update_time = $6,
-            package_code = $7, weight = $8, price = $9
-        WHERE id = $1`
-
-	res, err := r.client.Exec(ctx, db.ModeWrite, query,
-		o.OrderID, o.ReceiverID, o.StorageUntil, o.Status,
-		o.AcceptTime, o.LastUpdateTime, o.PackageType, o.Weight, o.Price,
-	)
-	if err != nil {
-		return fmt.Errorf("exec update: %w", err)
-	}
-	rows, _ := res.RowsAffected()
-	if rows == 0 {
-		return domain.EntityNotFoundError("Order", fmt.Sprintf("%d", o.OrderID))
-	}
-	return nil
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return r.UpdateOrderInTx(ctx, tx, o)
+	})
 }
 
 func (r *OrderRepository) SaveHistory(ctx context.Context, h domain.OrderHistory) error {
-	const query = `INSERT INTO order_history (order_id, status, changed_at) VALUES ($1,$2,$3)`
-	_, err := r.client.Exec(ctx, db.ModeWrite, query, h.OrderID, h.Status, h.ChangedAt)
+	const query = `
+        INSERT INTO order_history (order_id, pvz_id, status, changed_at, actor_type, actor_id, prev_status, reason, comment)
+        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`
+	_, err := r.client.Exec(ctx, db.ModeWrite, query, h.OrderID, h.PVZID, h.Status, h.ChangedAt,
+		h.Actor.Type, h.Actor.ID, h.PrevStatus, h.Reason, h.Comment)
 	if err != nil {
 		return fmt.Errorf("exec insert history: %w", err)
 	}
@@ -82,14 +73,17 @@ func (r *OrderRepository) SaveHistory(ctx context.Context, h domain.OrderHistory
 func (r *OrderRepository) SaveOrderInTx(ctx context.Context, tx *db.Tx, order domain.Order) error {
 	const query = `
         INSERT INTO orders (
-            id, receiver_id, expires_at, status,
-            accept_time, last_update_time, package_code, weight, price)
-        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+            id, receiver_id, pvz_id, expires_at, status,
+            accept_time, last_update_time, package_code, weight_grams, price_kopecks, cell_id, seller_id, extended_days,
+            cash_on_delivery, payment_status, shipment_id)
+        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)
         ON CONFLICT (id) DO NOTHING`
 
 	res, err := tx.Exec(ctx, query,
-		order.OrderID, order.ReceiverID, order.StorageUntil, order.Status,
+		order.OrderID, order.ReceiverID, order.PVZID, order.StorageUntil, order.Status,
 		order.AcceptTime, order.LastUpdateTime, order.PackageType, order.Weight, order.Price,
+		nullID(order.CellID), nullID(order.SellerID), order.ExtendedDays,
+		order.CashOnDelivery, order.PaymentStatus, nullString(order.ShipmentID),
 	)
 	if err != nil {
 		return fmt.Errorf("exec insert: %w", err)
@@ -103,34 +97,52 @@ func (r *OrderRepository) SaveOrderInTx(ctx context.Context, tx *db.Tx, order do
 	return nil
 }
 
+// UpdateOrderInTx сравнивает версию заказа с прочитанной и увеличивает ее. Если заказ
+// успели изменить, возвращает ConcurrentModificationError
 func (r *OrderRepository) UpdateOrderInTx(ctx context.Context, tx *db.Tx, order domain.Order) error {
 	const query = `
         UPDATE orders
-        SET receiver_id = $2, expires_at = $3, status = $4,
-            accept_time = $5, last_update_time = $6,
-            package_code = $7, weight = $8, price = $9
-        WHERE id = $1`
+        SET receiver_id = $2, pvz_id = $3, expires_at = $4, status = $5,
+            accept_time = $6, last_update_time = $7,
+            package_code = $8, weight_grams = $9, price_kopecks = $10, cell_id = $11, seller_id = $12,
+            extended_days = $13, cash_on_delivery = $14, payment_status = $15,
+            shipment_id = $16, version = version + 1
+        WHERE id = $1 AND version = $17`
 
 	res, err := tx.Exec(ctx, query,
-		order.OrderID, order.ReceiverID, order.StorageUntil, order.Status,
+		order.OrderID, order.ReceiverID, order.PVZID, order.StorageUntil, order.Status,
 		order.AcceptTime, order.LastUpdateTime, order.PackageType, order.Weight, order.Price,
+		nullID(order.CellID), nullID(order.SellerID), order.ExtendedDays,
+		order.CashOnDelivery, order.PaymentStatus, nullString(order.ShipmentID), order.Version,
 	)
 	if err != nil {
 		return fmt.Errorf("exec update: %w", err)
 	}
 
 	rows, _ := res.RowsAffected()
-	if rows == 0 {
-		return domain.EntityNotFoundError("Order", fmt.Sprintf("%d", order.OrderID))
+	if rows > 0 {
+		return nil
 	}
 
-	return nil
+	// ничего не обновили: либо заказа нет, либо версия уже другая
+	var exists int
+	err = tx.QueryRow(ctx, `SELECT 1 FROM orders WHERE id = $1`, order.OrderID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.EntityNotFoundError("Order", fmt.Sprintf("%d", order.OrderID))
+	}
+	if err != nil {
+		return fmt.Errorf("check existence: %w", err)
+	}
+	return domain.ConcurrentModificationError(order.OrderID)
 }
 
 func (r *OrderRepository) SaveHistoryInTx(ctx context.Context, tx *db.Tx, history domain.OrderHistory) error {
-	const query = `INSERT INTO order_history (order_id, status, changed_at) VALUES ($1,$2,$3)`
+	const query = `
+        INSERT INTO order_history (order_id, pvz_id, status, changed_at, actor_type, actor_id, prev_status, reason, comment)
+        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`
 
-	_, err := tx.Exec(ctx, query, history.OrderID, history.Status, history.ChangedAt)
+	_, err := tx.Exec(ctx, query, history.OrderID, history.PVZID, history.Status, history.ChangedAt,
+		history.Actor.Type, history.Actor.ID, history.PrevStatus, history.Reason, history.Comment)
 	if err != nil {
 		return fmt.Errorf("exec insert history: %w", err)
 	}
@@ -6,16 +6,18 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/lib/pq"
 	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
+// GetByID читает заказ с мастера: по прочитанной версии сервис делает условное обновление,
+// и с отстающей реплики оно упиралось бы в конфликт версий
 func (r *OrderRepository) GetByID(ctx context.Context, orderID uint64) (domain.Order, error) {
-	query := `
-		SELECT id, receiver_id, expires_at, status, accept_time, last_update_time, package_code, weight, price
-		FROM orders
-		WHERE id = $1
+	query := selectOrderQuery + `
+		WHERE o.id = $1
 	`
-	row := r.client.QueryRow(ctx, query, orderID)
+	row := r.client.QueryRowMode(ctx, db.ModeWrite, query, orderID)
 
 	order, err := scanOrder(row)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -28,13 +30,11 @@ func (r *OrderRepository) GetByID(ctx context.Context, orderID uint64) (domain.O
 	return order, nil
 }
 
-func (r *OrderRepository) GetByReceiverID(ctx context.Context, receiverID uint64) ([]domain.Order, error) {
-	query := `
-		SELECT id, receiver_id, expires_at, status, accept_time, last_update_time, package_code, weight, price
-		FROM orders
-		WHERE receiver_id = $1
+func (r *OrderRepository) GetByReceiverID(ctx context.Context, pvzID, receiverID uint64) ([]domain.Order, error) {
+	query := selectOrderQuery + `
+		WHERE o.pvz_id = $1 AND o.receiver_id = $2
 	`
-	rows, err := r.client.Query(ctx, query, receiverID)
+	rows, err := r.client.Query(ctx, query, pvzID, receiverID)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
@@ -52,19 +52,86 @@ func (r *OrderRepository) GetByReceiverID(ctx context.Context, receiverID uint64
 	return orders, nil
 }
 
-func (r *OrderRepository) GetReturnedOrders(ctx context.Context) ([]domain.Order, error) {
-	query := `
-		SELECT id, receiver_id, expires_at, status, accept_time, last_update_time, package_code, weight, price
-		FROM orders
-		WHERE status IN ($1, $2)
-		ORDER BY last_update_time DESC
+// orderListWhere — условие OrderListFilter, занимает параметры $1-$3
+const orderListWhere = `
+		WHERE o.pvz_id = $1
+		  AND ($2::bigint = 0 OR o.receiver_id = $2)
+		  AND (cardinality($3::int[]) = 0 OR o.status = ANY($3))`
+
+// ListOrdersByID отдает страницу заказов по возрастанию ID: после q.After или с пропуском q.Offset
+func (r *OrderRepository) ListOrdersByID(ctx context.Context, f domain.OrderListFilter, q domain.OrderPageQuery) ([]domain.Order, error) {
+	query := selectOrderQuery + orderListWhere
+	args := []interface{}{f.PVZID, f.ReceiverID, statusArray(f.Statuses)}
+	if q.After != nil {
+		query += `
+		  AND o.id > $4
+		ORDER BY o.id
+		LIMIT $5
+	`
+		args = append(args, q.After.ID, q.Limit)
+	} else {
+		query += `
+		ORDER BY o.id
+		OFFSET $4 LIMIT $5
+	`
+		args = append(args, q.Offset, q.Limit)
+	}
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	return scanOrders(rows)
+}
+
+// ListOrdersByUpdate отдает страницу заказов от свежих обновлений к старым; ID различает заказы
+// с одинаковым временем обновления, поэтому курсор не теряет и не повторяет строки
+func (r *OrderRepository) ListOrdersByUpdate(ctx context.Context, f domain.OrderListFilter, q domain.OrderPageQuery) ([]domain.Order, error) {
+	query := selectOrderQuery + orderListWhere
+	args := []interface{}{f.PVZID, f.ReceiverID, statusArray(f.Statuses)}
+	if q.After != nil {
+		query += `
+		  AND (o.last_update_time, o.id) < ($4, $5)
+		ORDER BY o.last_update_time DESC, o.id DESC
+		LIMIT $6
 	`
-	rows, err := r.client.Query(ctx, query, domain.StatusReturnedFromClient, domain.StatusGivenToCourier)
+		args = append(args, q.After.Time, q.After.ID, q.Limit)
+	} else {
+		query += `
+		ORDER BY o.last_update_time DESC, o.id DESC
+		OFFSET $4 LIMIT $5
+	`
+		args = append(args, q.Offset, q.Limit)
+	}
+	rows, err := r.client.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
 	defer rows.Close()
 
+	return scanOrders(rows)
+}
+
+func (r *OrderRepository) CountOrders(ctx context.Context, f domain.OrderListFilter) (uint64, error) {
+	query := `SELECT count(*) FROM orders o` + orderListWhere
+
+	var total uint64
+	if err := r.client.QueryRow(ctx, query, f.PVZID, f.ReceiverID, statusArray(f.Statuses)).Scan(&total); err != nil {
+		return 0, fmt.Errorf("scan: %w", err)
+	}
+	return total, nil
+}
+
+func statusArray(statuses []domain.OrderStatus) interface{} {
+	out := make([]int64, len(statuses))
+	for i, s := range statuses {
+		out[i] = int64(s)
+	}
+	return pq.Array(out)
+}
+
+func scanOrders(rows *sql.Rows) ([]domain.Order, error) {
 	var orders []domain.Order
 	for rows.Next() {
 		order, err := scanOrder(rows)
@@ -73,17 +140,18 @@ func (r *OrderRepository) GetReturnedOrders(ctx context.Context) ([]domain.Order
 		}
 		orders = append(orders, order)
 	}
-
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
 	return orders, nil
 }
 
-func (r *OrderRepository) GetAllOrders(ctx context.Context) ([]domain.Order, error) {
-	query := `
-		SELECT id, receiver_id, expires_at, status, accept_time, last_update_time, package_code, weight, price
-		FROM orders
-		ORDER BY last_update_time DESC
+func (r *OrderRepository) GetAllOrders(ctx context.Context, pvzID uint64) ([]domain.Order, error) {
+	query := selectOrderQuery + `
+		WHERE o.pvz_id = $1
+		ORDER BY o.last_update_time DESC
 	`
-	rows, err := r.client.Query(ctx, query)
+	rows, err := r.client.Query(ctx, query, pvzID)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
@@ -101,38 +169,40 @@ func (r *OrderRepository) GetAllOrders(ctx context.Context) ([]domain.Order, err
 	return orders, nil
 }
 
-func (r *OrderRepository) GetPackageRules(ctx context.Context, code string) ([]domain.PackageRules, error) {
-	query := `
-		SELECT max_weight, extra_price
-		FROM package_types
-		WHERE code = $1
-	`
-	rows, err := r.client.Query(ctx, query, code)
+// CountOrdersByStatus считает заказы пункта по статусам одним запросом, не читая сами заказы
+func (r *OrderRepository) CountOrdersByStatus(ctx context.Context, pvzID uint64) (map[domain.OrderStatus]uint64, error) {
+	const query = `
+        SELECT status, count(*)
+        FROM orders
+        WHERE pvz_id = $1
+        GROUP BY status`
+
+	rows, err := r.client.Query(ctx, query, pvzID)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
 	defer rows.Close()
 
-	var rules []domain.PackageRules
+	counts := make(map[domain.OrderStatus]uint64)
 	for rows.Next() {
-		var rule domain.PackageRules
-		err := rows.Scan(&rule.MaxWeight, &rule.Price)
-		if err != nil {
+		var (
+			status domain.OrderStatus
+			count  uint64
+		)
+		if err := rows.Scan(&status, &count); err != nil {
 			return nil, fmt.Errorf("scan: %w", err)
 		}
-		rules = append(rules, rule)
+		counts[status] = count
 	}
-
-	if len(rules) == 0 {
-		return nil, fmt.Errorf("no rules found for package code %s", code)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
 	}
-
-	return rules, nil
+	return counts, nil
 }
 
 func (r *OrderRepository) GetHistoryByOrderID(ctx context.Context, orderID uint64) ([]domain.OrderHistory, error) {
 	query := `
-        SELECT order_id, status, changed_at
+        SELECT order_id, pvz_id, status, changed_at, actor_type, actor_id, prev_status, reason, comment
         FROM order_history
         WHERE order_id = $1
         ORDER BY changed_at DESC
@@ -147,7 +217,8 @@ func (r *OrderRepository) GetHistoryByOrderID(ctx context.Context, orderID uint6
 	var history []domain.OrderHistory
 	for rows.Next() {
 		var h domain.OrderHistory
-		err := rows.Scan(&h.OrderID, &h.Status, &h.ChangedAt)
+		err := rows.Scan(&h.OrderID, &h.PVZID, &h.Status, &h.ChangedAt,
+			&h.Actor.Type, &h.Actor.ID, &h.PrevStatus, &h.Reason, &h.Comment)
 		if err != nil {
 			return nil, fmt.Errorf("scan: %w", err)
 		}
@@ -156,3 +227,64 @@ func (r *OrderRepository) GetHistoryByOrderID(ctx context.Context, orderID uint6
 
 	return history, nil
 }
+
+// сколько заказов выгрузки читается из курсора за один FETCH
+const exportFetchSize = 500
+
+// ExportOrders читает заказы по фильтру курсором на реплике и передает их в emit по одному, по возрастанию ID.
+// В памяти держится не больше одной порции курсора; ошибка emit прерывает выгрузку
+func (r *OrderRepository) ExportOrders(ctx context.Context, f domain.OrderExportFilter, emit func(domain.Order) error) error {
+	declare := `DECLARE export_orders NO SCROLL CURSOR FOR` + selectOrderQuery + `
+		WHERE o.pvz_id = $1
+		  AND (cardinality($2::int[]) = 0 OR o.status = ANY($2))
+		  AND ($3::bigint = 0 OR o.receiver_id = $3)
+		  AND ($4::timestamptz IS NULL OR o.accept_time >= $4)
+		  AND ($5::timestamptz IS NULL OR o.accept_time < $5)
+		  AND ($6::text = '' OR o.package_code = $6)
+		ORDER BY o.id
+	`
+	fetch := fmt.Sprintf(`FETCH %d FROM export_orders`, exportFetchSize)
+
+	from := sql.NullTime{Time: f.AcceptedFrom, Valid: !f.AcceptedFrom.IsZero()}
+	to := sql.NullTime{Time: f.AcceptedTo, Valid: !f.AcceptedTo.IsZero()}
+
+	return r.client.WithReadTransaction(ctx, func(tx *db.Tx) error {
+		if _, err := tx.Exec(ctx, declare, f.PVZID, statusArray(f.Statuses), f.ReceiverID, from, to, f.PackageType); err != nil {
+			return fmt.Errorf("declare cursor: %w", err)
+		}
+		for {
+			fetched, err := fetchOrders(ctx, tx, fetch, emit)
+			if err != nil {
+				return err
+			}
+			if fetched < exportFetchSize {
+				return nil
+			}
+		}
+	})
+}
+
+// fetchOrders читает одну порцию курсора и возвращает число прочитанных заказов
+func fetchOrders(ctx context.Context, tx *db.Tx, fetch string, emit func(domain.Order) error) (int, error) {
+	rows, err := tx.Query(ctx, fetch)
+	if err != nil {
+		return 0, fmt.Errorf("fetch: %w", err)
+	}
+	defer rows.Close()
+
+	var n int
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return n, err
+		}
+		n++
+		if err := emit(order); err != nil {
+			return n, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return n, fmt.Errorf("rows: %w", err)
+	}
+	return n, nil
+}
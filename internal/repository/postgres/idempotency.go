@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+// ReserveIdempotencyKey занимает ключ под новый запрос. Ключ, записанный раньше expiredBefore,
+// считается свободным, как и незавершенный ключ, занятый раньше staleBefore.
+// Если ключ занят, возвращает его запись и false
+func (r *OrderRepository) ReserveIdempotencyKey(ctx context.Context, rec domain.IdempotencyRecord,
+	expiredBefore, staleBefore time.Time) (domain.IdempotencyRecord, bool, error) {
+
+	const reserve = `
+        INSERT INTO idempotency_keys (pvz_id, key, method, request_hash, created_at)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (pvz_id, key, method) DO UPDATE
+        SET request_hash = EXCLUDED.request_hash, response_type = '', response = NULL,
+            completed = FALSE, created_at = EXCLUDED.created_at
+        WHERE idempotency_keys.created_at < $6
+           OR (NOT idempotency_keys.completed AND idempotency_keys.created_at < $7)
+        RETURNING key`
+	const selectExisting = `
+        SELECT pvz_id, key, method, request_hash, response_type, response, completed, created_at
+        FROM idempotency_keys
+        WHERE pvz_id = $1 AND key = $2 AND method = $3`
+
+	var (
+		existing domain.IdempotencyRecord
+		reserved bool
+	)
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var key string
+		err := tx.QueryRow(ctx, reserve, rec.PVZID, rec.Key, rec.Method, rec.RequestHash, rec.CreatedAt,
+			expiredBefore, staleBefore).Scan(&key)
+		if err == nil {
+			reserved = true
+			return nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("reserve: %w", err)
+		}
+
+		return tx.QueryRow(ctx, selectExisting, rec.PVZID, rec.Key, rec.Method).Scan(
+			&existing.PVZID, &existing.Key, &existing.Method, &existing.RequestHash, &existing.ResponseType,
+			&existing.Response, &existing.Completed, &existing.CreatedAt)
+	})
+	if err != nil {
+		return domain.IdempotencyRecord{}, false, fmt.Errorf("exec reserve idempotency key: %w", err)
+	}
+	return existing, reserved, nil
+}
+
+// CompleteIdempotencyKey сохраняет ответ, только если ключ все еще занят тем же запросом: rec.CreatedAt —
+// время, когда запрос занял ключ. Если брошенный ключ уже занял повтор, ответ опоздавшего запроса
+// не записывается и возвращается false
+func (r *OrderRepository) CompleteIdempotencyKey(ctx context.Context, rec domain.IdempotencyRecord) (bool, error) {
+	const query = `
+        UPDATE idempotency_keys
+        SET response_type = $4, response = $5, completed = TRUE
+        WHERE pvz_id = $1 AND key = $2 AND method = $3 AND created_at = $6 AND NOT completed`
+
+	res, err := r.client.Exec(ctx, db.ModeWrite, query,
+		rec.PVZID, rec.Key, rec.Method, rec.ResponseType, rec.Response, rec.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("exec complete idempotency key: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ незавершенного запроса, занятый в claimedAt, чтобы клиент мог повторить его
+func (r *OrderRepository) ReleaseIdempotencyKey(ctx context.Context, pvzID uint64, key, method string, claimedAt time.Time) error {
+	const query = `
+        DELETE FROM idempotency_keys
+        WHERE pvz_id = $1 AND key = $2 AND method = $3 AND created_at = $4 AND NOT completed`
+
+	if _, err := r.client.Exec(ctx, db.ModeWrite, query, pvzID, key, method, claimedAt); err != nil {
+		return fmt.Errorf("exec release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *OrderRepository) PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	const query = `DELETE FROM idempotency_keys WHERE created_at < $1`
+
+	res, err := r.client.Exec(ctx, db.ModeWrite, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("exec purge idempotency keys: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	return rows, nil
+}
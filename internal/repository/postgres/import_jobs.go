@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+const selectImportJobQuery = `
+        SELECT id, pvz_id, actor_type, actor_id, status, owner, total, last_row, imported, failed, error,
+               created_at, updated_at
+        FROM import_jobs`
+
+// SaveImportJob сохраняет задачу вместе со всеми ее строками; строки нумеруются с 1 в порядке orders.
+// Строки вставляются одним запросом через unnest, чтобы большой файл укладывался в таймаут вызова
+func (r *OrderRepository) SaveImportJob(ctx context.Context, job domain.ImportJob, orders []domain.OrderToImport) (domain.ImportJob, error) {
+	const insertJob = `
+        INSERT INTO import_jobs (pvz_id, actor_type, actor_id, status, owner, heartbeat_at, total, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $6, $6)
+        RETURNING id`
+	const insertRows = `
+        INSERT INTO import_job_rows (job_id, row_no, payload)
+        SELECT $1, t.row_no, t.payload::jsonb
+        FROM unnest($2::text[]) WITH ORDINALITY AS t(payload, row_no)`
+
+	payloads := make([]string, len(orders))
+	for i, order := range orders {
+		payload, err := json.Marshal(order)
+		if err != nil {
+			return domain.ImportJob{}, fmt.Errorf("json.Marshal: %w", err)
+		}
+		payloads[i] = string(payload)
+	}
+
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		if err := tx.QueryRow(ctx, insertJob, job.PVZID, job.Actor.Type, job.Actor.ID, job.Status,
+			job.Owner, job.CreatedAt, job.Total).Scan(&job.ID); err != nil {
+			return fmt.Errorf("exec insert import job: %w", err)
+		}
+		if _, err := tx.Exec(ctx, insertRows, job.ID, pq.Array(payloads)); err != nil {
+			return fmt.Errorf("exec insert import job rows: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+	return job, nil
+}
+
+func (r *OrderRepository) GetImportJob(ctx context.Context, id uint64) (domain.ImportJob, error) {
+	job, err := scanImportJob(r.client.QueryRow(ctx, selectImportJobQuery+` WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return domain.ImportJob{}, domain.EntityNotFoundError("ImportJob", strconv.FormatUint(id, 10))
+	}
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+
+	const query = `
+        SELECT row_no, order_id, error_code, message
+        FROM import_job_failures
+        WHERE job_id = $1
+        ORDER BY row_no`
+
+	rows, err := r.client.Query(ctx, query, id)
+	if err != nil {
+		return domain.ImportJob{}, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var f domain.ImportJobFailure
+		if err := rows.Scan(&f.Row, &f.OrderID, &f.ErrorCode, &f.Message); err != nil {
+			return domain.ImportJob{}, fmt.Errorf("scan: %w", err)
+		}
+		job.Failures = append(job.Failures, f)
+	}
+	return job, nil
+}
+
+// ListUnfinishedImportJobs отдает незавершенные задачи всех пунктов, которые никто не держит:
+// без владельца или с отметкой владельца старше staleBefore
+func (r *OrderRepository) ListUnfinishedImportJobs(ctx context.Context, staleBefore time.Time) ([]domain.ImportJob, error) {
+	query := selectImportJobQuery + `
+        WHERE status IN ($1, $2) AND (owner = '' OR heartbeat_at < $3)
+        ORDER BY id`
+
+	rows, err := r.client.Query(ctx, query, domain.ImportJobPending, domain.ImportJobRunning, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []domain.ImportJob
+	for rows.Next() {
+		job, err := scanImportJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// ListImportJobRows отдает до limit строк задачи после строки afterRow.
+// Строки могли быть записаны только что, поэтому читаем их в транзакции с мастера, а не с реплики
+func (r *OrderRepository) ListImportJobRows(ctx context.Context, jobID, afterRow, limit uint64) ([]domain.ImportRow, error) {
+	const query = `
+        SELECT row_no, payload
+        FROM import_job_rows
+        WHERE job_id = $1 AND row_no > $2
+        ORDER BY row_no
+        LIMIT $3`
+
+	var out []domain.ImportRow
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		rows, err := tx.Query(ctx, query, jobID, afterRow, limit)
+		if err != nil {
+			return fmt.Errorf("query: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				row     domain.ImportRow
+				payload []byte
+			)
+			if err := rows.Scan(&row.Row, &payload); err != nil {
+				return fmt.Errorf("scan: %w", err)
+			}
+			if err := json.Unmarshal(payload, &row.Order); err != nil {
+				return fmt.Errorf("json.Unmarshal: %w", err)
+			}
+			out = append(out, row)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClaimImportJob делает owner владельцем незавершенной задачи и переводит ее в RUNNING. Задачу
+// другого владельца можно забрать, только если он не отмечался с staleBefore; иначе возвращается false
+func (r *OrderRepository) ClaimImportJob(ctx context.Context, id uint64, owner string, at, staleBefore time.Time) (bool, error) {
+	const query = `
+        UPDATE import_jobs
+        SET status = $2, owner = $3, heartbeat_at = $4, updated_at = $4
+        WHERE id = $1 AND status IN ($5, $2) AND (owner = '' OR owner = $3 OR heartbeat_at < $6)`
+
+	res, err := r.client.Exec(ctx, db.ModeWrite, query,
+		id, domain.ImportJobRunning, owner, at, domain.ImportJobPending, staleBefore)
+	if err != nil {
+		return false, fmt.Errorf("exec claim import job: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+// ReleaseImportJob снимает владельца с задачи, если ее все еще держит owner
+func (r *OrderRepository) ReleaseImportJob(ctx context.Context, id uint64, owner string) error {
+	const query = `UPDATE import_jobs SET owner = '' WHERE id = $1 AND owner = $2`
+
+	if _, err := r.client.Exec(ctx, db.ModeWrite, query, id, owner); err != nil {
+		return fmt.Errorf("exec release import job: %w", err)
+	}
+	return nil
+}
+
+// UpdateImportJobStatus переводит задачу в статус to, только если ее текущий статус входит в from.
+// Возвращает false, если задачу уже перевел кто-то другой, например отменил
+func (r *OrderRepository) UpdateImportJobStatus(ctx context.Context, id uint64, from []domain.ImportJobStatus,
+	to domain.ImportJobStatus, errMsg string, at time.Time) (bool, error) {
+	const query = `
+        UPDATE import_jobs
+        SET status = $2, error = $3, updated_at = $4
+        WHERE id = $1 AND status = ANY($5)`
+
+	statuses := make([]int64, len(from))
+	for i, s := range from {
+		statuses[i] = int64(s)
+	}
+	res, err := r.client.Exec(ctx, db.ModeWrite, query, id, to, errMsg, at, pq.Array(statuses))
+	if err != nil {
+		return false, fmt.Errorf("exec update import job: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+// CommitImportJobProgress фиксирует итог пачки строк одной транзакцией. Итог пишется, только если задача
+// выполняется, ее держит p.Owner и last_row все еще равен p.PrevRow. Иначе задачу отменили, забрали
+// другим экземпляром или эту пачку уже зафиксировал другой исполнитель, и возвращается false
+func (r *OrderRepository) CommitImportJobProgress(ctx context.Context, p domain.ImportJobProgress) (bool, error) {
+	const updateJob = `
+        UPDATE import_jobs
+        SET last_row = $2, imported = imported + $3, failed = failed + $4, updated_at = $5, heartbeat_at = $5
+        WHERE id = $1 AND status = $6 AND owner = $7 AND last_row = $8`
+	const insertFailure = `
+        INSERT INTO import_job_failures (job_id, row_no, order_id, error_code, message)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (job_id, row_no) DO NOTHING`
+
+	var committed bool
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		res, err := tx.Exec(ctx, updateJob, p.JobID, p.LastRow, p.Imported, p.Failed, p.At,
+			domain.ImportJobRunning, p.Owner, p.PrevRow)
+		if err != nil {
+			return fmt.Errorf("exec update import job: %w", err)
+		}
+		if rows, _ := res.RowsAffected(); rows == 0 {
+			return nil
+		}
+		committed = true
+
+		for _, f := range p.Failures {
+			if _, err := tx.Exec(ctx, insertFailure, p.JobID, f.Row, f.OrderID, f.ErrorCode, f.Message); err != nil {
+				return fmt.Errorf("exec insert import job failure: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return committed, nil
+}
+
+func scanImportJob(scanner Scanner) (domain.ImportJob, error) {
+	var job domain.ImportJob
+	if err := scanner.Scan(&job.ID, &job.PVZID, &job.Actor.Type, &job.Actor.ID, &job.Status, &job.Owner, &job.Total,
+		&job.LastRow, &job.Imported, &job.Failed, &job.Error, &job.CreatedAt, &job.UpdatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return job, err
+		}
+		return job, fmt.Errorf("scan: %w", err)
+	}
+	return job, nil
+}
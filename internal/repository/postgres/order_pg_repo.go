@@ -9,6 +9,13 @@ import (
 	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
+const selectOrderQuery = `
+		SELECT o.id, o.receiver_id, o.pvz_id, o.expires_at, o.status, o.accept_time, o.last_update_time,
+		       o.package_code, o.weight_grams, o.price_kopecks, o.cell_id, c.code, o.seller_id, o.extended_days,
+		       o.cash_on_delivery, o.payment_status, o.shipment_id, o.version
+		FROM orders o
+		LEFT JOIN storage_cells c ON c.id = o.cell_id`
+
 type Scanner interface {
 	Scan(dest ...interface{}) error
 }
@@ -16,11 +23,13 @@ type Scanner interface {
 func scanOrder(scanner Scanner) (domain.Order, error) {
 	var order domain.Order
 	var expiresAt, acceptTime, lastUpdateTime time.Time
-	var packageCode sql.NullString
+	var packageCode, cellCode, shipmentID sql.NullString
+	var cellID, sellerID sql.NullInt64
 
 	err := scanner.Scan(
 		&order.OrderID,
 		&order.ReceiverID,
+		&order.PVZID,
 		&expiresAt,
 		&order.Status,
 		&acceptTime,
@@ -28,6 +37,14 @@ func scanOrder(scanner Scanner) (domain.Order, error) {
 		&packageCode,
 		&order.Weight,
 		&order.Price,
+		&cellID,
+		&cellCode,
+		&sellerID,
+		&order.ExtendedDays,
+		&order.CashOnDelivery,
+		&order.PaymentStatus,
+		&shipmentID,
+		&order.Version,
 	)
 	if err != nil {
 		return domain.Order{}, fmt.Errorf("scan: %w", err)
@@ -36,6 +53,7 @@ func scanOrder(scanner Scanner) (domain.Order, error) {
 	return domain.Order{
 		OrderID:        order.OrderID,
 		ReceiverID:     order.ReceiverID,
+		PVZID:          order.PVZID,
 		StorageUntil:   expiresAt,
 		Status:         order.Status,
 		AcceptTime:     acceptTime,
@@ -43,9 +61,26 @@ func scanOrder(scanner Scanner) (domain.Order, error) {
 		PackageType:    packageCode.String,
 		Weight:         order.Weight,
 		Price:          order.Price,
+		CellID:         uint64(cellID.Int64),
+		CellCode:       cellCode.String,
+		SellerID:       uint64(sellerID.Int64),
+		ExtendedDays:   order.ExtendedDays,
+		CashOnDelivery: order.CashOnDelivery,
+		PaymentStatus:  order.PaymentStatus,
+		ShipmentID:     shipmentID.String,
+		Version:        order.Version,
 	}, nil
 }
 
+// нулевой ID (ячейка, продавец) пишем в базу как NULL
+func nullID(id uint64) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id != 0}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
 type OrderRepository struct {
 	client *db.Client
 }
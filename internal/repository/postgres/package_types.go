@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (r *OrderRepository) SavePackageType(ctx context.Context, p domain.PackageType) error {
+	const query = `
+        INSERT INTO package_types (code, kind, max_weight_grams, extra_price_kopecks)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (code) DO NOTHING`
+
+	res, err := r.client.Exec(ctx, db.ModeWrite, query, p.Code, p.Kind, p.MaxWeight, p.Price)
+	if err != nil {
+		return fmt.Errorf("exec insert package type: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ValidationFailedError(fmt.Sprintf("package type %q already exists", p.Code))
+	}
+	return nil
+}
+
+func (r *OrderRepository) UpdatePackageType(ctx context.Context, p domain.PackageType) error {
+	const query = `
+        UPDATE package_types
+        SET kind = $2, max_weight_grams = $3, extra_price_kopecks = $4
+        WHERE code = $1`
+
+	res, err := r.client.Exec(ctx, db.ModeWrite, query, p.Code, p.Kind, p.MaxWeight, p.Price)
+	if err != nil {
+		return fmt.Errorf("exec update package type: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.EntityNotFoundError("PackageType", p.Code)
+	}
+	return nil
+}
+
+// упаковку, на которую ссылается политика возврата (в том числе как часть комбинации), не удаляем
+func (r *OrderRepository) DeletePackageType(ctx context.Context, code string) error {
+	const (
+		usedQuery = `
+            SELECT EXISTS (
+                SELECT 1 FROM return_policies
+                WHERE $1 = ANY(string_to_array(package_code, '+'))
+            )`
+		deleteQuery = `DELETE FROM package_types WHERE code = $1`
+	)
+
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var used bool
+		if err := tx.QueryRow(ctx, usedQuery, code).Scan(&used); err != nil {
+			return fmt.Errorf("query return policies: %w", err)
+		}
+		if used {
+			return domain.ValidationFailedError(fmt.Sprintf("package type %q is used by a return policy", code))
+		}
+
+		res, err := tx.Exec(ctx, deleteQuery, code)
+		if err != nil {
+			return fmt.Errorf("exec delete package type: %w", err)
+		}
+		rows, _ := res.RowsAffected()
+		if rows == 0 {
+			return domain.EntityNotFoundError("PackageType", code)
+		}
+		return nil
+	})
+}
+
+func (r *OrderRepository) ListPackageTypes(ctx context.Context) ([]domain.PackageType, error) {
+	const query = `
+        SELECT code, kind, max_weight_grams, extra_price_kopecks
+        FROM package_types
+        ORDER BY kind, code`
+
+	rows, err := r.client.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var types []domain.PackageType
+	for rows.Next() {
+		var p domain.PackageType
+		if err := rows.Scan(&p.Code, &p.Kind, &p.MaxWeight, &p.Price); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		types = append(types, p)
+	}
+
+	return types, nil
+}
+
+// GetPackageRules собирает правила упаковки (в том числе составной, вида box+film) из справочника
+func (r *OrderRepository) GetPackageRules(ctx context.Context, code string) (domain.PackageRules, error) {
+	catalogue, err := r.ListPackageTypes(ctx)
+	if err != nil {
+		return domain.PackageRules{}, err
+	}
+	return domain.ComposePackageRules(code, catalogue)
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (r *OrderRepository) SavePaymentInTx(ctx context.Context, tx *db.Tx, p domain.Payment) (domain.Payment, error) {
+	const query = `
+        INSERT INTO payments (order_id, pvz_id, kind, amount_kopecks, created_at)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id`
+
+	if err := tx.QueryRow(ctx, query, p.OrderID, p.PVZID, p.Kind, p.Amount, p.CreatedAt).Scan(&p.ID); err != nil {
+		return domain.Payment{}, fmt.Errorf("exec insert payment: %w", err)
+	}
+	return p, nil
+}
+
+func (r *OrderRepository) SavePayment(ctx context.Context, p domain.Payment) (domain.Payment, error) {
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var err error
+		p, err = r.SavePaymentInTx(ctx, tx, p)
+		return err
+	})
+	return p, err
+}
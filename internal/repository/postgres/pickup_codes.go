@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+const pickupCodeColumns = `pvz_id, receiver_id, code_hash, failed_attempts, locked_until, created_at`
+
+func scanPickupCode(scanner Scanner) (domain.PickupCode, error) {
+	var (
+		c           domain.PickupCode
+		lockedUntil sql.NullTime
+	)
+	err := scanner.Scan(&c.PVZID, &c.ReceiverID, &c.CodeHash, &c.FailedAttempts, &lockedUntil, &c.CreatedAt)
+	if err != nil {
+		return domain.PickupCode{}, err
+	}
+	c.LockedUntil = lockedUntil.Time
+	return c, nil
+}
+
+// SavePickupCodeInTx выпускает получателю новый код и сообщает, заменил ли он прежний. Пока у получателя
+// в пункте есть заказы на хранении, прежний код остается в силе; иначе новый код сбрасывает счетчик ошибок.
+// Вызывается до того, как новый заказ получателя попадет на хранение
+func (r *OrderRepository) SavePickupCodeInTx(ctx context.Context, tx *db.Tx, c domain.PickupCode) (bool, error) {
+	const query = `
+        INSERT INTO pickup_codes (pvz_id, receiver_id, code_hash, created_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (pvz_id, receiver_id) DO UPDATE
+        SET code_hash = EXCLUDED.code_hash, created_at = EXCLUDED.created_at,
+            failed_attempts = 0, locked_until = NULL
+        WHERE pickup_codes.code_hash = '' OR NOT EXISTS (
+            SELECT 1 FROM orders o
+            WHERE o.pvz_id = pickup_codes.pvz_id AND o.receiver_id = pickup_codes.receiver_id AND o.status = $5)`
+
+	res, err := tx.Exec(ctx, query, c.PVZID, c.ReceiverID, c.CodeHash, c.CreatedAt, domain.StatusInStorage)
+	if err != nil {
+		return false, fmt.Errorf("exec upsert pickup code: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func (r *OrderRepository) SavePickupCode(ctx context.Context, c domain.PickupCode) (bool, error) {
+	var issued bool
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var err error
+		issued, err = r.SavePickupCodeInTx(ctx, tx, c)
+		return err
+	})
+	return issued, err
+}
+
+// читаем с мастера: счетчик попыток на реплике может отставать
+func (r *OrderRepository) GetPickupCode(ctx context.Context, pvzID, receiverID uint64) (domain.PickupCode, error) {
+	query := `SELECT ` + pickupCodeColumns + ` FROM pickup_codes WHERE pvz_id = $1 AND receiver_id = $2`
+
+	var c domain.PickupCode
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var err error
+		c, err = scanPickupCode(tx.QueryRow(ctx, query, pvzID, receiverID))
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PickupCode{}, domain.EntityNotFoundError("PickupCode", strconv.FormatUint(receiverID, 10))
+	}
+	if err != nil {
+		return domain.PickupCode{}, fmt.Errorf("scan: %w", err)
+	}
+	return c, nil
+}
+
+// атомарно увеличивает счетчик ошибок; на maxAttempts-й ошибке блокирует код до lockUntil
+func (r *OrderRepository) RegisterPickupCodeFailure(ctx context.Context, pvzID, receiverID uint64, maxAttempts uint32, lockUntil time.Time) (domain.PickupCode, error) {
+	query := `
+        UPDATE pickup_codes SET
+            failed_attempts = CASE WHEN failed_attempts + 1 >= $3 THEN 0 ELSE failed_attempts + 1 END,
+            locked_until    = CASE WHEN failed_attempts + 1 >= $3 THEN $4 ELSE locked_until END
+        WHERE pvz_id = $1 AND receiver_id = $2
+        RETURNING ` + pickupCodeColumns
+
+	var c domain.PickupCode
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var err error
+		c, err = scanPickupCode(tx.QueryRow(ctx, query, pvzID, receiverID, maxAttempts, lockUntil))
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PickupCode{}, domain.EntityNotFoundError("PickupCode", strconv.FormatUint(receiverID, 10))
+	}
+	if err != nil {
+		return domain.PickupCode{}, fmt.Errorf("scan: %w", err)
+	}
+	return c, nil
+}
+
+func (r *OrderRepository) ResetPickupCodeFailures(ctx context.Context, pvzID, receiverID uint64) error {
+	const query = `UPDATE pickup_codes SET failed_attempts = 0, locked_until = NULL WHERE pvz_id = $1 AND receiver_id = $2`
+
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		if _, err := tx.Exec(ctx, query, pvzID, receiverID); err != nil {
+			return fmt.Errorf("exec reset pickup code: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *OrderRepository) DeletePickupCode(ctx context.Context, pvzID, receiverID uint64) error {
+	const query = `DELETE FROM pickup_codes WHERE pvz_id = $1 AND receiver_id = $2`
+
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		if _, err := tx.Exec(ctx, query, pvzID, receiverID); err != nil {
+			return fmt.Errorf("exec delete pickup code: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (r *OrderRepository) SavePickupPoint(ctx context.Context, p domain.PickupPoint) (domain.PickupPoint, error) {
+	const query = `
+        INSERT INTO pickup_points (name, address, created_at)
+        VALUES ($1, $2, $3)
+        RETURNING id`
+
+	// Client.QueryRow читает с реплики, поэтому RETURNING выполняем в транзакции на мастере
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return tx.QueryRow(ctx, query, p.Name, p.Address, p.CreatedAt).Scan(&p.ID)
+	})
+	if err != nil {
+		return domain.PickupPoint{}, fmt.Errorf("exec insert pickup point: %w", err)
+	}
+	return p, nil
+}
+
+func (r *OrderRepository) GetPickupPoint(ctx context.Context, pvzID uint64) (domain.PickupPoint, error) {
+	const query = `SELECT id, name, address, created_at FROM pickup_points WHERE id = $1`
+
+	var p domain.PickupPoint
+	err := r.client.QueryRow(ctx, query, pvzID).Scan(&p.ID, &p.Name, &p.Address, &p.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PickupPoint{}, domain.EntityNotFoundError("PickupPoint", fmt.Sprintf("%d", pvzID))
+	}
+	if err != nil {
+		return domain.PickupPoint{}, fmt.Errorf("scan: %w", err)
+	}
+	return p, nil
+}
+
+func (r *OrderRepository) ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error) {
+	const query = `SELECT id, name, address, created_at FROM pickup_points ORDER BY id`
+
+	rows, err := r.client.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var points []domain.PickupPoint
+	for rows.Next() {
+		var p domain.PickupPoint
+		if err := rows.Scan(&p.ID, &p.Name, &p.Address, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		points = append(points, p)
+	}
+
+	return points, nil
+}
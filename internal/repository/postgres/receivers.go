@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+const uniqueViolation = "23505"
+
+// UpsertReceiver создает получателя или обновляет его контакты; created_at при обновлении сохраняется
+func (r *OrderRepository) UpsertReceiver(ctx context.Context, rec domain.Receiver) (domain.Receiver, error) {
+	const query = `
+        INSERT INTO receivers (id, phone, name, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $4)
+        ON CONFLICT (id) DO UPDATE
+        SET phone = EXCLUDED.phone, name = EXCLUDED.name, updated_at = EXCLUDED.updated_at
+        RETURNING created_at, updated_at`
+
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return tx.QueryRow(ctx, query, rec.ID, rec.Phone, rec.Name, rec.UpdatedAt).Scan(&rec.CreatedAt, &rec.UpdatedAt)
+	})
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return domain.Receiver{}, domain.ValidationFailedError(
+			fmt.Sprintf("phone %s already belongs to another receiver", rec.Phone))
+	}
+	if err != nil {
+		return domain.Receiver{}, fmt.Errorf("exec upsert receiver: %w", err)
+	}
+	return rec, nil
+}
+
+const getReceiverQuery = `SELECT id, phone, name, created_at, updated_at FROM receivers WHERE id = $1`
+
+func (r *OrderRepository) GetReceiver(ctx context.Context, id uint64) (domain.Receiver, error) {
+	rec, err := scanReceiver(r.client.QueryRow(ctx, getReceiverQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Receiver{}, domain.EntityNotFoundError("Receiver", fmt.Sprintf("%d", id))
+	}
+	return rec, err
+}
+
+func (r *OrderRepository) GetReceiverInTx(ctx context.Context, tx *db.Tx, id uint64) (domain.Receiver, error) {
+	rec, err := scanReceiver(tx.QueryRow(ctx, getReceiverQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Receiver{}, domain.EntityNotFoundError("Receiver", fmt.Sprintf("%d", id))
+	}
+	return rec, err
+}
+
+func (r *OrderRepository) GetReceiverByPhone(ctx context.Context, phone string) (domain.Receiver, error) {
+	const query = `SELECT id, phone, name, created_at, updated_at FROM receivers WHERE phone = $1`
+
+	rec, err := scanReceiver(r.client.QueryRow(ctx, query, phone))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Receiver{}, domain.EntityNotFoundError("Receiver", phone)
+	}
+	return rec, err
+}
+
+// SearchReceivers ищет по фрагменту телефона или имени без учета регистра
+func (r *OrderRepository) SearchReceivers(ctx context.Context, query string, limit uint64) ([]domain.Receiver, error) {
+	const q = `
+        SELECT id, phone, name, created_at, updated_at
+        FROM receivers
+        WHERE ($1 <> '' AND phone LIKE '%' || $1 || '%')
+           OR lower(name) LIKE '%' || lower($2) || '%'
+        ORDER BY name, id
+        LIMIT $3`
+
+	rows, err := r.client.Query(ctx, q, phoneDigits(query), query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var list []domain.Receiver
+	for rows.Next() {
+		rec, err := scanReceiver(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rec)
+	}
+	return list, nil
+}
+
+func scanReceiver(scanner Scanner) (domain.Receiver, error) {
+	var rec domain.Receiver
+	if err := scanner.Scan(&rec.ID, &rec.Phone, &rec.Name, &rec.CreatedAt, &rec.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return rec, err
+		}
+		return rec, fmt.Errorf("scan: %w", err)
+	}
+	return rec, nil
+}
+
+// в поиске по телефону сравниваем только цифры, чтобы "+7 (999)" и "7999" находили одно и то же
+func phoneDigits(s string) string {
+	digits := make([]rune, 0, len(s))
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, r)
+		}
+	}
+	return string(digits)
+}
@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+// ListDueForReturn отдает истекшие заказы на хранении и возвраты клиентов,
+// которые еще не попали в несданную ведомость
+func (r *OrderRepository) ListDueForReturn(ctx context.Context, pvzID uint64, now time.Time) ([]domain.Order, error) {
+	query := selectOrderQuery + `
+		WHERE o.pvz_id = $1
+		  AND o.status IN ($2, $3)
+		  AND o.expires_at < $4
+		  AND NOT EXISTS (
+		      SELECT 1 FROM return_manifest_orders mo
+		      JOIN return_manifests m ON m.id = mo.manifest_id
+		      WHERE mo.order_id = o.id AND m.status = $5)
+		ORDER BY o.id
+	`
+	rows, err := r.client.Query(ctx, query, pvzID,
+		domain.StatusInStorage, domain.StatusReturnedFromClient, now, domain.ManifestOpen)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []domain.Order
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// класс advisory-блокировки сборки ведомостей; вторая часть ключа — пункт выдачи
+const returnManifestLockKey = 7_301_002
+
+// SaveReturnManifest сохраняет ведомость под блокировкой пункта: сборка идет и по таймеру на каждой реплике,
+// и по запросу, поэтому заказы, которые успела забрать параллельная сборка, из ведомости выкидываются.
+// Если не осталось ни одного заказа, ведомость не создается
+func (r *OrderRepository) SaveReturnManifest(ctx context.Context, m domain.ReturnManifest) (domain.ReturnManifest, error) {
+	const selectTaken = `
+        SELECT mo.order_id
+        FROM return_manifest_orders mo
+        JOIN return_manifests m ON m.id = mo.manifest_id
+        WHERE m.pvz_id = $1 AND m.status = $2 AND mo.order_id = ANY($3)`
+	const insertManifest = `
+        INSERT INTO return_manifests (pvz_id, status, created_at)
+        VALUES ($1, $2, $3)
+        RETURNING id`
+	const insertItem = `
+        INSERT INTO return_manifest_orders (manifest_id, order_id, receiver_id, status, cell_code, weight_grams, storage_until)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	orderIDs := make([]int64, len(m.Items))
+	for i, it := range m.Items {
+		orderIDs[i] = int64(it.OrderID)
+	}
+
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1, hashint8($2))`, returnManifestLockKey, int64(m.PVZID)); err != nil {
+			return fmt.Errorf("lock pvz manifests: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, selectTaken, m.PVZID, domain.ManifestOpen, pq.Array(orderIDs))
+		if err != nil {
+			return fmt.Errorf("query taken orders: %w", err)
+		}
+		taken := make(map[uint64]struct{})
+		for rows.Next() {
+			var id uint64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return fmt.Errorf("scan: %w", err)
+			}
+			taken[id] = struct{}{}
+		}
+		rows.Close()
+
+		items := m.Items[:0:0]
+		for _, it := range m.Items {
+			if _, ok := taken[it.OrderID]; !ok {
+				items = append(items, it)
+			}
+		}
+		m.Items = items
+		if len(m.Items) == 0 {
+			return nil
+		}
+
+		if err := tx.QueryRow(ctx, insertManifest, m.PVZID, m.Status, m.CreatedAt).Scan(&m.ID); err != nil {
+			return fmt.Errorf("exec insert manifest: %w", err)
+		}
+		for _, it := range m.Items {
+			if _, err := tx.Exec(ctx, insertItem, m.ID, it.OrderID, it.ReceiverID, it.Status,
+				nullString(it.CellCode), it.Weight, it.StorageUntil); err != nil {
+				return fmt.Errorf("exec insert manifest order: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ReturnManifest{}, err
+	}
+	return m, nil
+}
+
+func (r *OrderRepository) GetReturnManifest(ctx context.Context, id uint64) (domain.ReturnManifest, error) {
+	const query = `
+        SELECT id, pvz_id, status, created_at, handed_over_at
+        FROM return_manifests
+        WHERE id = $1`
+
+	m, err := scanManifest(r.client.QueryRow(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return domain.ReturnManifest{}, domain.EntityNotFoundError("ReturnManifest", strconv.FormatUint(id, 10))
+	}
+	if err != nil {
+		return domain.ReturnManifest{}, err
+	}
+
+	items, err := r.listManifestItems(ctx, []uint64{id})
+	if err != nil {
+		return domain.ReturnManifest{}, err
+	}
+	m.Items = items[id]
+	return m, nil
+}
+
+func (r *OrderRepository) ListReturnManifests(ctx context.Context, pvzID uint64) ([]domain.ReturnManifest, error) {
+	const query = `
+        SELECT id, pvz_id, status, created_at, handed_over_at
+        FROM return_manifests
+        WHERE pvz_id = $1
+        ORDER BY created_at DESC, id DESC`
+
+	rows, err := r.client.Query(ctx, query, pvzID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		manifests []domain.ReturnManifest
+		ids       []uint64
+	)
+	for rows.Next() {
+		m, err := scanManifest(rows)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+		ids = append(ids, m.ID)
+	}
+	if len(ids) == 0 {
+		return manifests, nil
+	}
+
+	items, err := r.listManifestItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range manifests {
+		manifests[i].Items = items[manifests[i].ID]
+	}
+	return manifests, nil
+}
+
+func (r *OrderRepository) listManifestItems(ctx context.Context, ids []uint64) (map[uint64][]domain.ReturnManifestItem, error) {
+	const query = `
+        SELECT manifest_id, order_id, receiver_id, status, cell_code, weight_grams, storage_until
+        FROM return_manifest_orders
+        WHERE manifest_id = ANY($1)
+        ORDER BY manifest_id, order_id`
+
+	arr := make([]int64, len(ids))
+	for i, id := range ids {
+		arr[i] = int64(id)
+	}
+	rows, err := r.client.Query(ctx, query, pq.Array(arr))
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	items := make(map[uint64][]domain.ReturnManifestItem, len(ids))
+	for rows.Next() {
+		var (
+			manifestID uint64
+			it         domain.ReturnManifestItem
+			cellCode   sql.NullString
+		)
+		if err := rows.Scan(&manifestID, &it.OrderID, &it.ReceiverID, &it.Status,
+			&cellCode, &it.Weight, &it.StorageUntil); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		it.CellCode = cellCode.String
+		items[manifestID] = append(items[manifestID], it)
+	}
+	return items, nil
+}
+
+// заказ, который к моменту передачи уже нельзя вернуть курьеру, из ведомости убираем
+func (r *OrderRepository) RemoveManifestOrderInTx(ctx context.Context, tx *db.Tx, manifestID, orderID uint64) error {
+	const query = `DELETE FROM return_manifest_orders WHERE manifest_id = $1 AND order_id = $2`
+
+	if _, err := tx.Exec(ctx, query, manifestID, orderID); err != nil {
+		return fmt.Errorf("exec delete manifest order: %w", err)
+	}
+	return nil
+}
+
+func (r *OrderRepository) RemoveManifestOrder(ctx context.Context, manifestID, orderID uint64) error {
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return r.RemoveManifestOrderInTx(ctx, tx, manifestID, orderID)
+	})
+}
+
+// условие на статус не дает передать одну ведомость дважды при параллельных запросах
+func (r *OrderRepository) MarkManifestHandedOverInTx(ctx context.Context, tx *db.Tx, id uint64, at time.Time) error {
+	const query = `
+        UPDATE return_manifests
+        SET status = $2, handed_over_at = $3
+        WHERE id = $1 AND status = $4`
+
+	res, err := tx.Exec(ctx, query, id, domain.ManifestHandedOver, at, domain.ManifestOpen)
+	if err != nil {
+		return fmt.Errorf("exec update manifest: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ValidationFailedError(fmt.Sprintf("return manifest %d is already handed over", id))
+	}
+	return nil
+}
+
+func (r *OrderRepository) MarkManifestHandedOver(ctx context.Context, id uint64, at time.Time) error {
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return r.MarkManifestHandedOverInTx(ctx, tx, id, at)
+	})
+}
+
+func scanManifest(scanner Scanner) (domain.ReturnManifest, error) {
+	var (
+		m            domain.ReturnManifest
+		handedOverAt sql.NullTime
+	)
+	if err := scanner.Scan(&m.ID, &m.PVZID, &m.Status, &m.CreatedAt, &handedOverAt); err != nil {
+		if err == sql.ErrNoRows {
+			return m, err
+		}
+		return m, fmt.Errorf("scan: %w", err)
+	}
+	m.HandedOverAt = handedOverAt.Time
+	return m, nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (r *OrderRepository) SaveReturnPolicy(ctx context.Context, p domain.ReturnPolicy) (domain.ReturnPolicy, error) {
+	const query = `
+        INSERT INTO return_policies (name, package_code, seller_id, window_hours, returnable)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT ((COALESCE(package_code, '')), (COALESCE(seller_id, 0))) DO UPDATE
+        SET name = EXCLUDED.name, window_hours = EXCLUDED.window_hours, returnable = EXCLUDED.returnable
+        RETURNING id`
+
+	packageCode := sql.NullString{String: p.PackageType, Valid: p.PackageType != ""}
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return tx.QueryRow(ctx, query,
+			p.Name, packageCode, nullID(p.SellerID), int64(p.Window/time.Hour), p.Returnable,
+		).Scan(&p.ID)
+	})
+	if err != nil {
+		return domain.ReturnPolicy{}, fmt.Errorf("exec upsert return policy: %w", err)
+	}
+	return p, nil
+}
+
+func (r *OrderRepository) ListReturnPolicies(ctx context.Context) ([]domain.ReturnPolicy, error) {
+	const query = `
+        SELECT id, name, package_code, seller_id, window_hours, returnable
+        FROM return_policies
+        ORDER BY id`
+
+	rows, err := r.client.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []domain.ReturnPolicy
+	for rows.Next() {
+		var (
+			p           domain.ReturnPolicy
+			packageCode sql.NullString
+			sellerID    sql.NullInt64
+			windowHours int64
+		)
+		if err := rows.Scan(&p.ID, &p.Name, &packageCode, &sellerID, &windowHours, &p.Returnable); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		p.PackageType = packageCode.String
+		p.SellerID = uint64(sellerID.Int64)
+		p.Window = time.Duration(windowHours) * time.Hour
+		policies = append(policies, p)
+	}
+
+	return policies, nil
+}
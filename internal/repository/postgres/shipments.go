@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (r *OrderRepository) GetByShipmentID(ctx context.Context, pvzID uint64, shipmentID string) ([]domain.Order, error) {
+	query := selectOrderQuery + `
+		WHERE o.pvz_id = $1 AND o.shipment_id = $2
+		ORDER BY o.id
+	`
+	rows, err := r.client.Query(ctx, query, pvzID, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []domain.Order
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// повторная приемка той же поставки не плодит одинаковые расхождения;
+// false — такое расхождение уже было записано
+func (r *OrderRepository) SaveDiscrepancyInTx(ctx context.Context, tx *db.Tx, d domain.ArrivalDiscrepancy) (bool, error) {
+	const query = `
+        INSERT INTO arrival_discrepancies (pvz_id, shipment_id, order_id, kind, detected_at)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (pvz_id, shipment_id, order_id, kind) DO NOTHING`
+
+	res, err := tx.Exec(ctx, query, d.PVZID, d.ShipmentID, d.OrderID, d.Kind, d.DetectedAt)
+	if err != nil {
+		return false, fmt.Errorf("exec insert discrepancy: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func (r *OrderRepository) SaveDiscrepancy(ctx context.Context, d domain.ArrivalDiscrepancy) (bool, error) {
+	var inserted bool
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var err error
+		inserted, err = r.SaveDiscrepancyInTx(ctx, tx, d)
+		return err
+	})
+	return inserted, err
+}
+
+// заказ, который все-таки доехал с опозданием, из отчета о недостаче убираем
+func (r *OrderRepository) ResolveMissingInTx(
+	ctx context.Context,
+	tx *db.Tx,
+	pvzID uint64,
+	shipmentID string,
+	orderID uint64,
+) error {
+	const query = `
+        DELETE FROM arrival_discrepancies
+        WHERE pvz_id = $1 AND shipment_id = $2 AND order_id = $3 AND kind = $4`
+
+	if _, err := tx.Exec(ctx, query, pvzID, shipmentID, orderID, domain.DiscrepancyMissing); err != nil {
+		return fmt.Errorf("exec delete discrepancy: %w", err)
+	}
+	return nil
+}
+
+func (r *OrderRepository) ResolveMissing(ctx context.Context, pvzID uint64, shipmentID string, orderID uint64) error {
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return r.ResolveMissingInTx(ctx, tx, pvzID, shipmentID, orderID)
+	})
+}
+
+// ListDiscrepancies отдает расхождения пункта; пустой shipmentID — по всем поставкам
+func (r *OrderRepository) ListDiscrepancies(ctx context.Context, pvzID uint64, shipmentID string) ([]domain.ArrivalDiscrepancy, error) {
+	const query = `
+        SELECT id, pvz_id, shipment_id, order_id, kind, detected_at
+        FROM arrival_discrepancies
+        WHERE pvz_id = $1 AND ($2 = '' OR shipment_id = $2)
+        ORDER BY detected_at, id`
+
+	rows, err := r.client.Query(ctx, query, pvzID, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var list []domain.ArrivalDiscrepancy
+	for rows.Next() {
+		var d domain.ArrivalDiscrepancy
+		if err := rows.Scan(&d.ID, &d.PVZID, &d.ShipmentID, &d.OrderID, &d.Kind, &d.DetectedAt); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		list = append(list, d)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+const storageCellColumns = `id, pvz_id, code, size_class, capacity, occupied`
+
+func scanStorageCell(scanner Scanner) (domain.StorageCell, error) {
+	var c domain.StorageCell
+	if err := scanner.Scan(&c.ID, &c.PVZID, &c.Code, &c.Size, &c.Capacity, &c.Occupied); err != nil {
+		return domain.StorageCell{}, err
+	}
+	return c, nil
+}
+
+func (r *OrderRepository) SaveStorageCell(ctx context.Context, c domain.StorageCell) (domain.StorageCell, error) {
+	const query = `
+        INSERT INTO storage_cells (pvz_id, code, size_class, capacity)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (pvz_id, code) DO NOTHING
+        RETURNING id`
+
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return tx.QueryRow(ctx, query, c.PVZID, c.Code, c.Size, c.Capacity).Scan(&c.ID)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.StorageCell{}, domain.ValidationFailedError(fmt.Sprintf("storage cell %q already exists", c.Code))
+	}
+	if err != nil {
+		return domain.StorageCell{}, fmt.Errorf("exec insert storage cell: %w", err)
+	}
+	return c, nil
+}
+
+func (r *OrderRepository) ListStorageCells(ctx context.Context, pvzID uint64) ([]domain.StorageCell, error) {
+	query := `SELECT ` + storageCellColumns + ` FROM storage_cells WHERE pvz_id = $1 ORDER BY size_class, code`
+
+	rows, err := r.client.Query(ctx, query, pvzID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var cells []domain.StorageCell
+	for rows.Next() {
+		c, err := scanStorageCell(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		cells = append(cells, c)
+	}
+
+	return cells, nil
+}
+
+// занимает место в самой маленькой подходящей ячейке; строка блокируется до конца транзакции
+func (r *OrderRepository) OccupyCellInTx(ctx context.Context, tx *db.Tx, pvzID uint64, size domain.CellSize) (domain.StorageCell, error) {
+	query := `
+        UPDATE storage_cells SET occupied = occupied + 1
+        WHERE id = (
+            SELECT id FROM storage_cells
+            WHERE pvz_id = $1 AND size_class >= $2 AND occupied < capacity
+            ORDER BY size_class, code
+            LIMIT 1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING ` + storageCellColumns
+
+	c, err := scanStorageCell(tx.QueryRow(ctx, query, pvzID, size))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.StorageCell{}, domain.NoFreeCellError(pvzID, size)
+	}
+	if err != nil {
+		return domain.StorageCell{}, fmt.Errorf("scan: %w", err)
+	}
+	return c, nil
+}
+
+func (r *OrderRepository) OccupyCellByCodeInTx(ctx context.Context, tx *db.Tx, pvzID uint64, code string) (domain.StorageCell, error) {
+	query := `
+        UPDATE storage_cells SET occupied = occupied + 1
+        WHERE pvz_id = $1 AND code = $2 AND occupied < capacity
+        RETURNING ` + storageCellColumns
+
+	c, err := scanStorageCell(tx.QueryRow(ctx, query, pvzID, code))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.StorageCell{}, domain.CellUnavailableError(code)
+	}
+	if err != nil {
+		return domain.StorageCell{}, fmt.Errorf("scan: %w", err)
+	}
+	return c, nil
+}
+
+func (r *OrderRepository) ReleaseCellInTx(ctx context.Context, tx *db.Tx, cellID uint64) error {
+	const query = `UPDATE storage_cells SET occupied = occupied - 1 WHERE id = $1 AND occupied > 0`
+
+	if _, err := tx.Exec(ctx, query, cellID); err != nil {
+		return fmt.Errorf("exec release cell: %w", err)
+	}
+	return nil
+}
+
+func (r *OrderRepository) OccupyCell(ctx context.Context, pvzID uint64, size domain.CellSize) (domain.StorageCell, error) {
+	var c domain.StorageCell
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var err error
+		c, err = r.OccupyCellInTx(ctx, tx, pvzID, size)
+		return err
+	})
+	return c, err
+}
+
+func (r *OrderRepository) OccupyCellByCode(ctx context.Context, pvzID uint64, code string) (domain.StorageCell, error) {
+	var c domain.StorageCell
+	err := r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		var err error
+		c, err = r.OccupyCellByCodeInTx(ctx, tx, pvzID, code)
+		return err
+	})
+	return c, err
+}
+
+func (r *OrderRepository) ReleaseCell(ctx context.Context, cellID uint64) error {
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return r.ReleaseCellInTx(ctx, tx, cellID)
+	})
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/safariproxd/homework/internal/domain"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/db"
+)
+
+func (r *OrderRepository) SaveStorageFeeInTx(ctx context.Context, tx *db.Tx, f domain.StorageFee) error {
+	const query = `
+        INSERT INTO storage_fees (order_id, pvz_id, receiver_id, kind, paid_days, rate_kopecks, amount_kopecks, charged_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	if _, err := tx.Exec(ctx, query,
+		f.OrderID, f.PVZID, f.ReceiverID, f.Kind, f.PaidDays, f.DailyRate, f.Amount, f.ChargedAt,
+	); err != nil {
+		return fmt.Errorf("exec insert storage fee: %w", err)
+	}
+	return nil
+}
+
+func (r *OrderRepository) SaveStorageFee(ctx context.Context, f domain.StorageFee) error {
+	return r.client.WithTransaction(ctx, func(tx *db.Tx) error {
+		return r.SaveStorageFeeInTx(ctx, tx, f)
+	})
+}
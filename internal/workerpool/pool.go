@@ -151,6 +151,22 @@ func (p *Pool) Submit(j Job) {
 	}
 }
 
+// TrySubmit ставит задачу в очередь и никогда не выполняет ее в вызывающей горутине.
+// Возвращает false, если очередь заполнена или пул уже закрыт
+func (p *Pool) TrySubmit(j Job) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return false
+	}
+	select {
+	case p.jobs <- j:
+		return true
+	default:
+		return false
+	}
+}
+
 func (p *Pool) Resize(n int) {
 	if n < 0 {
 		return
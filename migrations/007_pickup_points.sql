@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE pickup_points (
+    id          BIGSERIAL    PRIMARY KEY,
+    name        TEXT         NOT NULL,
+    address     TEXT         NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO pickup_points (id, name) VALUES (1, 'default');
+SELECT setval('pickup_points_id_seq', 1);
+
+ALTER TABLE orders ADD COLUMN pvz_id BIGINT NOT NULL DEFAULT 1 REFERENCES pickup_points(id);
+ALTER TABLE order_history ADD COLUMN pvz_id BIGINT NOT NULL DEFAULT 1 REFERENCES pickup_points(id);
+
+DROP INDEX IF EXISTS idx_orders_receiver_status;
+CREATE INDEX idx_orders_pvz_receiver_status ON orders (pvz_id, receiver_id, status);
+CREATE INDEX idx_orders_pvz_status_updated ON orders (pvz_id, status, last_update_time DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_orders_pvz_status_updated;
+DROP INDEX IF EXISTS idx_orders_pvz_receiver_status;
+CREATE INDEX idx_orders_receiver_status ON orders (receiver_id, status);
+
+ALTER TABLE order_history DROP COLUMN IF EXISTS pvz_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS pvz_id;
+DROP TABLE IF EXISTS pickup_points;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE storage_cells (
+    id          BIGSERIAL   PRIMARY KEY,
+    pvz_id      BIGINT      NOT NULL REFERENCES pickup_points(id),
+    code        TEXT        NOT NULL,
+    size_class  SMALLINT    NOT NULL,
+    capacity    INT         NOT NULL CHECK (capacity > 0),
+    occupied    INT         NOT NULL DEFAULT 0 CHECK (occupied >= 0 AND occupied <= capacity),
+    UNIQUE (pvz_id, code)
+);
+
+CREATE INDEX idx_storage_cells_pvz_size ON storage_cells (pvz_id, size_class, code);
+
+-- стартовый набор ячеек для пункта по умолчанию: size_class 0/1/2 = small/medium/large
+INSERT INTO storage_cells (pvz_id, code, size_class, capacity)
+SELECT 1, 'S-' || LPAD(n::TEXT, 2, '0'), 0, 10 FROM generate_series(1, 20) AS n;
+INSERT INTO storage_cells (pvz_id, code, size_class, capacity)
+SELECT 1, 'M-' || LPAD(n::TEXT, 2, '0'), 1, 5 FROM generate_series(1, 10) AS n;
+INSERT INTO storage_cells (pvz_id, code, size_class, capacity)
+SELECT 1, 'L-' || LPAD(n::TEXT, 2, '0'), 2, 2 FROM generate_series(1, 5) AS n;
+
+ALTER TABLE orders ADD COLUMN cell_id BIGINT REFERENCES storage_cells(id);
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN IF EXISTS cell_id;
+DROP INDEX IF EXISTS idx_storage_cells_pvz_size;
+DROP TABLE IF EXISTS storage_cells;
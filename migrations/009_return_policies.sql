@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN seller_id BIGINT;
+
+CREATE TABLE return_policies (
+    id            BIGSERIAL   PRIMARY KEY,
+    name          TEXT        NOT NULL,
+    package_code  TEXT        REFERENCES package_types(code),
+    seller_id     BIGINT,
+    window_hours  INT         NOT NULL CHECK (window_hours >= 0),
+    returnable    BOOLEAN     NOT NULL DEFAULT TRUE
+);
+
+-- одна политика на пару (упаковка, продавец); NULL означает "любой"
+CREATE UNIQUE INDEX idx_return_policies_scope ON return_policies ((COALESCE(package_code, '')), (COALESCE(seller_id, 0)));
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_return_policies_scope;
+DROP TABLE IF EXISTS return_policies;
+ALTER TABLE orders DROP COLUMN IF EXISTS seller_id;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE pickup_codes (
+    pvz_id          BIGINT      NOT NULL REFERENCES pickup_points(id),
+    receiver_id     BIGINT      NOT NULL,
+    code_hash       TEXT        NOT NULL,
+    failed_attempts INT         NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pvz_id, receiver_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS pickup_codes;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- деньги храним в копейках, вес — в граммах, чтобы суммы не накапливали ошибку округления
+ALTER TABLE orders ALTER COLUMN weight TYPE BIGINT USING ROUND(weight * 1000);
+ALTER TABLE orders ALTER COLUMN price TYPE BIGINT USING ROUND(price * 100);
+ALTER TABLE orders RENAME COLUMN weight TO weight_grams;
+ALTER TABLE orders RENAME COLUMN price TO price_kopecks;
+
+ALTER TABLE package_types ALTER COLUMN max_weight TYPE BIGINT USING ROUND(max_weight * 1000);
+ALTER TABLE package_types ALTER COLUMN extra_price TYPE BIGINT USING ROUND(extra_price * 100);
+ALTER TABLE package_types RENAME COLUMN max_weight TO max_weight_grams;
+ALTER TABLE package_types RENAME COLUMN extra_price TO extra_price_kopecks;
+
+-- +goose Down
+ALTER TABLE package_types RENAME COLUMN extra_price_kopecks TO extra_price;
+ALTER TABLE package_types RENAME COLUMN max_weight_grams TO max_weight;
+ALTER TABLE package_types ALTER COLUMN extra_price TYPE NUMERIC(10,2) USING extra_price / 100.0;
+ALTER TABLE package_types ALTER COLUMN max_weight TYPE NUMERIC(10,2) USING max_weight / 1000.0;
+
+ALTER TABLE orders RENAME COLUMN price_kopecks TO price;
+ALTER TABLE orders RENAME COLUMN weight_grams TO weight;
+ALTER TABLE orders ALTER COLUMN price TYPE NUMERIC(10,2) USING price / 100.0;
+ALTER TABLE orders ALTER COLUMN weight TYPE NUMERIC(10,2) USING weight / 1000.0;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- комбинации вида bag+film больше не храним: правила собираются из частей справочника
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_package_code_fkey;
+ALTER TABLE return_policies DROP CONSTRAINT IF EXISTS return_policies_package_code_fkey;
+
+ALTER TABLE package_types ADD COLUMN kind SMALLINT NOT NULL DEFAULT 0;
+UPDATE package_types SET kind = 1 WHERE code = 'film';
+DELETE FROM package_types WHERE code LIKE '%+%';
+ALTER TABLE package_types ADD CONSTRAINT package_types_code_atomic CHECK (code NOT LIKE '%+%');
+
+-- +goose Down
+ALTER TABLE package_types DROP CONSTRAINT IF EXISTS package_types_code_atomic;
+INSERT INTO package_types (code, max_weight_grams, extra_price_kopecks) VALUES
+  ('bag+film', 10000, 600), ('box+film', 30000, 2100)
+ON CONFLICT (code) DO NOTHING;
+ALTER TABLE package_types DROP COLUMN IF EXISTS kind;
+
+ALTER TABLE return_policies ADD CONSTRAINT return_policies_package_code_fkey
+  FOREIGN KEY (package_code) REFERENCES package_types(code) NOT VALID;
+ALTER TABLE orders ADD CONSTRAINT orders_package_code_fkey
+  FOREIGN KEY (package_code) REFERENCES package_types(code) NOT VALID;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- журнал платы за хранение: одна запись на выданный заказ, если хранение вышло за бесплатный срок
+CREATE TABLE storage_fees (
+    id              BIGSERIAL    PRIMARY KEY,
+    order_id        BIGINT       NOT NULL REFERENCES orders(id),
+    pvz_id          BIGINT       NOT NULL,
+    receiver_id     BIGINT       NOT NULL,
+    paid_days       INT          NOT NULL CHECK (paid_days > 0),
+    rate_kopecks    BIGINT       NOT NULL,
+    amount_kopecks  BIGINT       NOT NULL,
+    charged_at      TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idx_storage_fees_order ON storage_fees (order_id);
+CREATE INDEX idx_storage_fees_pvz_charged ON storage_fees (pvz_id, charged_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_storage_fees_pvz_charged;
+DROP INDEX IF EXISTS idx_storage_fees_order;
+DROP TABLE IF EXISTS storage_fees;
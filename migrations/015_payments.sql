@@ -0,0 +1,22 @@
+-- +goose Up
+-- уже принятые заказы считаем предоплаченными
+ALTER TABLE orders ADD COLUMN cash_on_delivery BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE orders ADD COLUMN payment_status SMALLINT NOT NULL DEFAULT 1;
+
+-- журнал денег по заказам: оплата при получении (kind = 0) и возврат клиенту (kind = 1)
+CREATE TABLE payments (
+    id              BIGSERIAL    PRIMARY KEY,
+    order_id        BIGINT       NOT NULL REFERENCES orders(id),
+    pvz_id          BIGINT       NOT NULL,
+    kind            SMALLINT     NOT NULL,
+    amount_kopecks  BIGINT       NOT NULL CHECK (amount_kopecks >= 0),
+    created_at      TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idx_payments_order ON payments (order_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_payments_order;
+DROP TABLE IF EXISTS payments;
+ALTER TABLE orders DROP COLUMN IF EXISTS payment_status;
+ALTER TABLE orders DROP COLUMN IF EXISTS cash_on_delivery;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- анонсированные заказы (status = 5) приходят в пункт поставками
+ALTER TABLE orders ADD COLUMN shipment_id TEXT;
+CREATE INDEX idx_orders_pvz_shipment ON orders (pvz_id, shipment_id) WHERE shipment_id IS NOT NULL;
+
+-- расхождения при приемке поставки: 0 — анонсирован, но не пришел; 1 — пришел без анонса
+CREATE TABLE arrival_discrepancies (
+    id           BIGSERIAL    PRIMARY KEY,
+    pvz_id       BIGINT       NOT NULL,
+    shipment_id  TEXT         NOT NULL,
+    order_id     BIGINT       NOT NULL,
+    kind         SMALLINT     NOT NULL,
+    detected_at  TIMESTAMPTZ  NOT NULL,
+    UNIQUE (shipment_id, order_id, kind)
+);
+
+CREATE INDEX idx_arrival_discrepancies_pvz ON arrival_discrepancies (pvz_id, detected_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_arrival_discrepancies_pvz;
+DROP TABLE IF EXISTS arrival_discrepancies;
+DROP INDEX IF EXISTS idx_orders_pvz_shipment;
+ALTER TABLE orders DROP COLUMN IF EXISTS shipment_id;
@@ -0,0 +1,31 @@
+-- +goose Up
+-- ведомости возврата курьеру: 0 — собрана, 1 — передана
+CREATE TABLE return_manifests (
+    id              BIGSERIAL    PRIMARY KEY,
+    pvz_id          BIGINT       NOT NULL,
+    status          SMALLINT     NOT NULL DEFAULT 0,
+    created_at      TIMESTAMPTZ  NOT NULL,
+    handed_over_at  TIMESTAMPTZ
+);
+
+CREATE INDEX idx_return_manifests_pvz ON return_manifests (pvz_id, created_at);
+
+-- ячейка и срок хранения запоминаются на момент сборки ведомости
+CREATE TABLE return_manifest_orders (
+    manifest_id    BIGINT       NOT NULL REFERENCES return_manifests (id) ON DELETE CASCADE,
+    order_id       BIGINT       NOT NULL,
+    receiver_id    BIGINT       NOT NULL,
+    status         SMALLINT     NOT NULL,
+    cell_code      TEXT,
+    weight_grams   BIGINT       NOT NULL,
+    storage_until  TIMESTAMPTZ  NOT NULL,
+    PRIMARY KEY (manifest_id, order_id)
+);
+
+CREATE INDEX idx_return_manifest_orders_order ON return_manifest_orders (order_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_return_manifest_orders_order;
+DROP TABLE IF EXISTS return_manifest_orders;
+DROP INDEX IF EXISTS idx_return_manifests_pvz;
+DROP TABLE IF EXISTS return_manifests;
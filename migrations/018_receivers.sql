@@ -0,0 +1,15 @@
+-- +goose Up
+-- справочник получателей: id совпадает с receiver_id заказов, телефон хранится нормализованным (+7...)
+CREATE TABLE receivers (
+    id          BIGINT       PRIMARY KEY,
+    phone       TEXT         NOT NULL UNIQUE,
+    name        TEXT         NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idx_receivers_name ON receivers (lower(name));
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_receivers_name;
+DROP TABLE IF EXISTS receivers;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- кто перевел заказ в статус; для старых записей исполнитель неизвестен
+ALTER TABLE order_history
+    ADD COLUMN actor_type TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN actor_id   BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE order_history
+    DROP COLUMN IF EXISTS actor_id,
+    DROP COLUMN IF EXISTS actor_type;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- подробности перехода для разбора спорных ситуаций; prev_status пуст у первой записи заказа
+ALTER TABLE order_history
+    ADD COLUMN prev_status SMALLINT,
+    ADD COLUMN reason      TEXT NOT NULL DEFAULT '',
+    ADD COLUMN comment     TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE order_history
+    DROP COLUMN IF EXISTS comment,
+    DROP COLUMN IF EXISTS reason,
+    DROP COLUMN IF EXISTS prev_status;
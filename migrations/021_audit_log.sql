@@ -0,0 +1,28 @@
+-- +goose Up
+-- журнал изменяющих вызовов API; hash = sha256(prev_hash + поля записи), записи только добавляются
+CREATE TABLE audit_log (
+    id           BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    method       TEXT        NOT NULL,
+    pvz_id       BIGINT      NOT NULL,
+    actor_type   TEXT        NOT NULL DEFAULT '',
+    actor_id     BIGINT      NOT NULL DEFAULT 0,
+    sender       TEXT        NOT NULL DEFAULT '',
+    peer         TEXT        NOT NULL DEFAULT '',
+    payload      JSON        NOT NULL,
+    order_ids    BIGINT[]    NOT NULL DEFAULT '{}',
+    result_code  TEXT        NOT NULL,
+    error        TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL,
+    prev_hash    TEXT        NOT NULL,
+    hash         TEXT        NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_audit_log_actor ON audit_log (actor_type, actor_id, created_at);
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
+CREATE INDEX idx_audit_log_order_ids ON audit_log USING GIN (order_ids);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_log_order_ids;
+DROP INDEX IF EXISTS idx_audit_log_created_at;
+DROP INDEX IF EXISTS idx_audit_log_actor;
+DROP TABLE IF EXISTS audit_log;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- ответы на запросы с ключом идемпотентности; пока completed = false, запрос еще выполняется
+CREATE TABLE idempotency_keys (
+    key            TEXT        NOT NULL,
+    method         TEXT        NOT NULL,
+    request_hash   TEXT        NOT NULL,
+    response_type  TEXT        NOT NULL DEFAULT '',
+    response       BYTEA,
+    completed      BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at     TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (key, method)
+);
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys (created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_idempotency_keys_created_at;
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- версия заказа для оптимистичной блокировки: UPDATE проходит, только если версия не изменилась с момента чтения
+ALTER TABLE orders ADD COLUMN version BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN IF EXISTS version;
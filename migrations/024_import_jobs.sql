@@ -0,0 +1,42 @@
+-- +goose Up
+-- фоновые задачи импорта: 0 — ждет, 1 — выполняется, 2 — завершена, 3 — отменена, 4 — сбой.
+-- last_row — последняя строка, результат которой зафиксирован; с нее задача продолжается после перезапуска
+CREATE TABLE import_jobs (
+    id          BIGSERIAL    PRIMARY KEY,
+    pvz_id      BIGINT       NOT NULL,
+    actor_type  TEXT         NOT NULL DEFAULT '',
+    actor_id    BIGINT       NOT NULL DEFAULT 0,
+    status      SMALLINT     NOT NULL DEFAULT 0,
+    total       BIGINT       NOT NULL,
+    last_row    BIGINT       NOT NULL DEFAULT 0,
+    imported    BIGINT       NOT NULL DEFAULT 0,
+    failed      BIGINT       NOT NULL DEFAULT 0,
+    error       TEXT         NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idx_import_jobs_unfinished ON import_jobs (id) WHERE status IN (0, 1);
+
+-- строки задачи в том виде, в каком пришли в запросе
+CREATE TABLE import_job_rows (
+    job_id  BIGINT  NOT NULL REFERENCES import_jobs (id) ON DELETE CASCADE,
+    row_no  BIGINT  NOT NULL,
+    payload JSONB   NOT NULL,
+    PRIMARY KEY (job_id, row_no)
+);
+
+CREATE TABLE import_job_failures (
+    job_id      BIGINT    NOT NULL REFERENCES import_jobs (id) ON DELETE CASCADE,
+    row_no      BIGINT    NOT NULL,
+    order_id    BIGINT    NOT NULL,
+    error_code  BIGINT    NOT NULL,
+    message     TEXT      NOT NULL,
+    PRIMARY KEY (job_id, row_no)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS import_job_failures;
+DROP TABLE IF EXISTS import_job_rows;
+DROP INDEX IF EXISTS idx_import_jobs_unfinished;
+DROP TABLE IF EXISTS import_jobs;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- ключи keyset-пагинации: заказы получателя идут по id, история пункта — от свежих обновлений к старым.
+-- Возвраты покрывает idx_orders_pvz_status_updated
+CREATE INDEX idx_orders_pvz_receiver_id ON orders (pvz_id, receiver_id, id);
+CREATE INDEX idx_orders_pvz_updated ON orders (pvz_id, last_update_time DESC, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_orders_pvz_updated;
+DROP INDEX IF EXISTS idx_orders_pvz_receiver_id;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- ключ идемпотентности выбирает клиент, поэтому в разных пунктах ключи могут совпасть
+ALTER TABLE idempotency_keys ADD COLUMN pvz_id BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (pvz_id, key, method);
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+-- без пункта совпавшие ключи конфликтуют: оставляем запись пункта с меньшим id
+DELETE FROM idempotency_keys a
+USING idempotency_keys b
+WHERE a.key = b.key AND a.method = b.method AND a.pvz_id > b.pvz_id;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key, method);
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS pvz_id;
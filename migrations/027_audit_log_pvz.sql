@@ -0,0 +1,6 @@
+-- +goose Up
+-- поиск по журналу идет в пределах пункта, новые записи первыми
+CREATE INDEX idx_audit_log_pvz ON audit_log (pvz_id, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_log_pvz;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- номер поставки приходит извне и может совпасть в разных пунктах
+ALTER TABLE arrival_discrepancies DROP CONSTRAINT arrival_discrepancies_shipment_id_order_id_kind_key;
+ALTER TABLE arrival_discrepancies
+    ADD CONSTRAINT arrival_discrepancies_pvz_shipment_order_kind_key UNIQUE (pvz_id, shipment_id, order_id, kind);
+
+-- +goose Down
+ALTER TABLE arrival_discrepancies DROP CONSTRAINT arrival_discrepancies_pvz_shipment_order_kind_key;
+-- без пункта совпавшие расхождения конфликтуют: оставляем самое раннее
+DELETE FROM arrival_discrepancies a
+USING arrival_discrepancies b
+WHERE a.shipment_id = b.shipment_id AND a.order_id = b.order_id AND a.kind = b.kind AND a.id > b.id;
+ALTER TABLE arrival_discrepancies ADD CONSTRAINT arrival_discrepancies_shipment_id_order_id_kind_key
+    UNIQUE (shipment_id, order_id, kind);
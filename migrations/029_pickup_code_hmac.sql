@@ -0,0 +1,6 @@
+-- +goose Up
+-- хэши кодов выдачи теперь HMAC под секретом сервера, прежние sha256 с ними не сверить.
+-- Пустой хэш не совпадает ни с одним кодом, и такой код заменяется новым при следующей приемке
+UPDATE pickup_codes SET code_hash = '';
+
+-- +goose Down
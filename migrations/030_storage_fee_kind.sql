@@ -0,0 +1,6 @@
+-- +goose Up
+-- за что начислена плата: 0 — хранение сверх бесплатного срока при выдаче, 1 — продление хранения
+ALTER TABLE storage_fees ADD COLUMN kind SMALLINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE storage_fees DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- owner — экземпляр сервиса, который выполняет задачу; heartbeat_at он обновляет с каждой пачкой.
+-- Задачу без владельца или с давно не обновленной отметкой забирает другой экземпляр
+ALTER TABLE import_jobs ADD COLUMN owner TEXT NOT NULL DEFAULT '';
+ALTER TABLE import_jobs ADD COLUMN heartbeat_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE import_jobs DROP COLUMN IF EXISTS heartbeat_at;
+ALTER TABLE import_jobs DROP COLUMN IF EXISTS owner;
@@ -132,6 +132,10 @@ func (PackageType) EnumDescriptor() ([]byte, []int) {
 	return file_orders_contract_proto_rawDescGZIP(), []int{1}
 }
 
+// Статусы заказа глазами клиента:
+// EXPECTS — лежит в ПВЗ и ждет получателя, ACCEPTED — получатель забрал заказ,
+// RETURNED — получатель вернул заказ в ПВЗ, DELETED — заказ ушел из ПВЗ курьеру,
+// ANNOUNCED — анонсирован в поставке, но еще не прибыл в ПВЗ
 type OrderStatus int32
 
 const (
@@ -140,6 +144,7 @@ const (
 	OrderStatus_ORDER_STATUS_ACCEPTED    OrderStatus = 2
 	OrderStatus_ORDER_STATUS_RETURNED    OrderStatus = 3
 	OrderStatus_ORDER_STATUS_DELETED     OrderStatus = 4
+	OrderStatus_ORDER_STATUS_ANNOUNCED   OrderStatus = 5
 )
 
 // Enum value maps for OrderStatus.
@@ -150,6 +155,7 @@ var (
 		2: "ORDER_STATUS_ACCEPTED",
 		3: "ORDER_STATUS_RETURNED",
 		4: "ORDER_STATUS_DELETED",
+		5: "ORDER_STATUS_ANNOUNCED",
 	}
 	OrderStatus_value = map[string]int32{
 		"ORDER_STATUS_UNSPECIFIED": 0,
@@ -157,6 +163,7 @@ var (
 		"ORDER_STATUS_ACCEPTED":    2,
 		"ORDER_STATUS_RETURNED":    3,
 		"ORDER_STATUS_DELETED":     4,
+		"ORDER_STATUS_ANNOUNCED":   5,
 	}
 )
 
@@ -187,6 +194,113 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_orders_contract_proto_rawDescGZIP(), []int{2}
 }
 
+type OrderAction int32
+
+const (
+	OrderAction_ORDER_ACTION_UNSPECIFIED        OrderAction = 0
+	OrderAction_ORDER_ACTION_ISSUE              OrderAction = 1
+	OrderAction_ORDER_ACTION_RETURN_FROM_CLIENT OrderAction = 2
+	OrderAction_ORDER_ACTION_RETURN_TO_COURIER  OrderAction = 3
+	OrderAction_ORDER_ACTION_EXTEND_STORAGE     OrderAction = 4
+)
+
+// Enum value maps for OrderAction.
+var (
+	OrderAction_name = map[int32]string{
+		0: "ORDER_ACTION_UNSPECIFIED",
+		1: "ORDER_ACTION_ISSUE",
+		2: "ORDER_ACTION_RETURN_FROM_CLIENT",
+		3: "ORDER_ACTION_RETURN_TO_COURIER",
+		4: "ORDER_ACTION_EXTEND_STORAGE",
+	}
+	OrderAction_value = map[string]int32{
+		"ORDER_ACTION_UNSPECIFIED":        0,
+		"ORDER_ACTION_ISSUE":              1,
+		"ORDER_ACTION_RETURN_FROM_CLIENT": 2,
+		"ORDER_ACTION_RETURN_TO_COURIER":  3,
+		"ORDER_ACTION_EXTEND_STORAGE":     4,
+	}
+)
+
+func (x OrderAction) Enum() *OrderAction {
+	p := new(OrderAction)
+	*p = x
+	return p
+}
+
+func (x OrderAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_contract_proto_enumTypes[3].Descriptor()
+}
+
+func (OrderAction) Type() protoreflect.EnumType {
+	return &file_orders_contract_proto_enumTypes[3]
+}
+
+func (x OrderAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderAction.Descriptor instead.
+func (OrderAction) EnumDescriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{3}
+}
+
+type CellSize int32
+
+const (
+	CellSize_CELL_SIZE_UNSPECIFIED CellSize = 0
+	CellSize_CELL_SIZE_SMALL       CellSize = 1
+	CellSize_CELL_SIZE_MEDIUM      CellSize = 2
+	CellSize_CELL_SIZE_LARGE       CellSize = 3
+)
+
+// Enum value maps for CellSize.
+var (
+	CellSize_name = map[int32]string{
+		0: "CELL_SIZE_UNSPECIFIED",
+		1: "CELL_SIZE_SMALL",
+		2: "CELL_SIZE_MEDIUM",
+		3: "CELL_SIZE_LARGE",
+	}
+	CellSize_value = map[string]int32{
+		"CELL_SIZE_UNSPECIFIED": 0,
+		"CELL_SIZE_SMALL":       1,
+		"CELL_SIZE_MEDIUM":      2,
+		"CELL_SIZE_LARGE":       3,
+	}
+)
+
+func (x CellSize) Enum() *CellSize {
+	p := new(CellSize)
+	*p = x
+	return p
+}
+
+func (x CellSize) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CellSize) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_contract_proto_enumTypes[4].Descriptor()
+}
+
+func (CellSize) Type() protoreflect.EnumType {
+	return &file_orders_contract_proto_enumTypes[4]
+}
+
+func (x CellSize) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CellSize.Descriptor instead.
+func (CellSize) EnumDescriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{4}
+}
+
 type AcceptOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -195,6 +309,7 @@ type AcceptOrderRequest struct {
 	Package       *PackageType           `protobuf:"varint,4,opt,name=package,proto3,enum=orders.PackageType,oneof" json:"package,omitempty"`
 	Weight        float32                `protobuf:"fixed32,5,opt,name=weight,proto3" json:"weight,omitempty"`
 	Price         float32                `protobuf:"fixed32,6,opt,name=price,proto3" json:"price,omitempty"`
+	SellerId      *uint64                `protobuf:"varint,7,opt,name=seller_id,json=sellerId,proto3,oneof" json:"seller_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -271,6 +386,13 @@ func (x *AcceptOrderRequest) GetPrice() float32 {
 	return 0
 }
 
+func (x *AcceptOrderRequest) GetSellerId() uint64 {
+	if x != nil && x.SellerId != nil {
+		return *x.SellerId
+	}
+	return 0
+}
+
 type OrderIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -316,10 +438,12 @@ func (x *OrderIdRequest) GetOrderId() uint64 {
 }
 
 type ProcessOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Action        ActionType             `protobuf:"varint,2,opt,name=action,proto3,enum=orders.ActionType" json:"action,omitempty"`
-	OrderIds      []uint64               `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action   ActionType             `protobuf:"varint,2,opt,name=action,proto3,enum=orders.ActionType" json:"action,omitempty"`
+	OrderIds []uint64               `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	// код выдачи из уведомления о приемке; обязателен для ACTION_TYPE_ISSUE
+	PickupCode    *string `protobuf:"bytes,4,opt,name=pickup_code,json=pickupCode,proto3,oneof" json:"pickup_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -375,6 +499,13 @@ func (x *ProcessOrdersRequest) GetOrderIds() []uint64 {
 	return nil
 }
 
+func (x *ProcessOrdersRequest) GetPickupCode() string {
+	if x != nil && x.PickupCode != nil {
+		return *x.PickupCode
+	}
+	return ""
+}
+
 type ListOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1020,6 +1151,9 @@ type Order struct {
 	Weight        float32                `protobuf:"fixed32,5,opt,name=weight,proto3" json:"weight,omitempty"`
 	TotalPrice    float32                `protobuf:"fixed32,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	Package       *PackageType           `protobuf:"varint,7,opt,name=package,proto3,enum=orders.PackageType,oneof" json:"package,omitempty"`
+	PvzId         uint64                 `protobuf:"varint,8,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	CellCode      string                 `protobuf:"bytes,9,opt,name=cell_code,json=cellCode,proto3" json:"cell_code,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,10,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1103,11 +1237,33 @@ func (x *Order) GetPackage() PackageType {
 	return PackageType_PACKAGE_TYPE_UNSPECIFIED
 }
 
+func (x *Order) GetPvzId() uint64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
+
+func (x *Order) GetCellCode() string {
+	if x != nil {
+		return x.CellCode
+	}
+	return ""
+}
+
+func (x *Order) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
 type OrderHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=orders.OrderStatus" json:"status,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PvzId         uint64                 `protobuf:"varint,4,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1163,94 +1319,1094 @@ func (x *OrderHistory) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_orders_contract_proto protoreflect.FileDescriptor
+func (x *OrderHistory) GetPvzId() uint64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
 
-const file_orders_contract_proto_rawDesc = "" +
-	"\n" +
-	"\x15orders/contract.proto\x12\x06orders\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa7\x02\n" +
-	"\x12AcceptOrderRequest\x12\"\n" +
-	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\x12 \n" +
-	"\auser_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x12E\n" +
-	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\n" +
-	"\xfaB\a\xb2\x01\x04\b\x01@\x01R\texpiresAt\x122\n" +
-	"\apackage\x18\x04 \x01(\x0e2\x13.orders.PackageTypeH\x00R\apackage\x88\x01\x01\x12\"\n" +
-	"\x06weight\x18\x05 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05%\x00\x00\x00\x00R\x06weight\x12 \n" +
-	"\x05price\x18\x06 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05%\x00\x00\x00\x00R\x05priceB\n" +
-	"\n" +
-	"\b_package\"4\n" +
-	"\x0eOrderIdRequest\x12\"\n" +
-	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"\x9d\x01\n" +
-	"\x14ProcessOrdersRequest\x12 \n" +
-	"\auser_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x126\n" +
-	"\x06action\x18\x02 \x01(\x0e2\x12.orders.ActionTypeB\n" +
-	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06action\x12+\n" +
-	"\torder_ids\x18\x03 \x03(\x04B\x0e\xfaB\v\x92\x01\b\b\x01\"\x042\x02 \x00R\borderIds\"\xc4\x01\n" +
-	"\x11ListOrdersRequest\x12 \n" +
-	"\auser_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x12\x15\n" +
-	"\x06in_pvz\x18\x02 \x01(\bR\x05inPvz\x12#\n" +
-	"\x06last_n\x18\x03 \x01(\rB\a\xfaB\x04*\x02 \x00H\x00R\x05lastN\x88\x01\x01\x127\n" +
-	"\n" +
-	"pagination\x18\x04 \x01(\v2\x12.orders.PaginationH\x01R\n" +
-	"pagination\x88\x01\x01B\t\n" +
-	"\a_last_nB\r\n" +
-	"\v_pagination\"V\n" +
-	"\n" +
-	"Pagination\x12\x1b\n" +
-	"\x04page\x18\x01 \x01(\rB\a\xfaB\x04*\x02(\x00R\x04page\x12+\n" +
-	"\rcount_on_page\x18\x02 \x01(\rB\a\xfaB\x04*\x02 \x00R\vcountOnPage\"H\n" +
-	"\x12ListReturnsRequest\x122\n" +
-	"\n" +
-	"pagination\x18\x01 \x01(\v2\x12.orders.PaginationR\n" +
-	"pagination\"S\n" +
-	"\x13ImportOrdersRequest\x12<\n" +
-	"\x06orders\x18\x01 \x03(\v2\x1a.orders.AcceptOrderRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\x06orders\"G\n" +
-	"\x11GetHistoryRequest\x122\n" +
-	"\n" +
-	"pagination\x18\x01 \x01(\v2\x12.orders.PaginationR\n" +
-	"pagination\"9\n" +
-	"\x13OrderHistoryRequest\x12\"\n" +
-	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"F\n" +
-	"\x14OrderHistoryResponse\x12.\n" +
-	"\ahistory\x18\x01 \x03(\v2\x14.orders.OrderHistoryR\ahistory\"W\n" +
-	"\rOrderResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x13.orders.OrderStatusR\x06status\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\x04R\aorderId\"E\n" +
-	"\rProcessResult\x12\x1c\n" +
-	"\tprocessed\x18\x01 \x03(\x04R\tprocessed\x12\x16\n" +
-	"\x06errors\x18\x02 \x03(\x04R\x06errors\"I\n" +
-	"\n" +
-	"OrdersList\x12%\n" +
-	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"6\n" +
-	"\vReturnsList\x12'\n" +
-	"\areturns\x18\x01 \x03(\v2\r.orders.OrderR\areturns\"B\n" +
-	"\x10OrderHistoryList\x12.\n" +
-	"\ahistory\x18\x01 \x03(\v2\x14.orders.OrderHistoryR\ahistory\"B\n" +
-	"\fImportResult\x12\x1a\n" +
-	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x16\n" +
-	"\x06errors\x18\x02 \x03(\x04R\x06errors\"\x9c\x02\n" +
-	"\x05Order\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12+\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x13.orders.OrderStatusR\x06status\x129\n" +
-	"\n" +
-	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
-	"\x06weight\x18\x05 \x01(\x02R\x06weight\x12\x1f\n" +
-	"\vtotal_price\x18\x06 \x01(\x02R\n" +
-	"totalPrice\x122\n" +
-	"\apackage\x18\a \x01(\x0e2\x13.orders.PackageTypeH\x00R\apackage\x88\x01\x01B\n" +
-	"\n" +
-	"\b_package\"\x91\x01\n" +
-	"\fOrderHistory\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x04R\aorderId\x12+\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x13.orders.OrderStatusR\x06status\x129\n" +
+type GetAllowedActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedActionsRequest) Reset() {
+	*x = GetAllowedActionsRequest{}
+	mi := &file_orders_contract_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedActionsRequest) ProtoMessage() {}
+
+func (x *GetAllowedActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowedActionsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAllowedActionsRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type AllowedActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=orders.OrderStatus" json:"status,omitempty"`
+	Actions       []OrderAction          `protobuf:"varint,3,rep,packed,name=actions,proto3,enum=orders.OrderAction" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllowedActionsResponse) Reset() {
+	*x = AllowedActionsResponse{}
+	mi := &file_orders_contract_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowedActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedActionsResponse) ProtoMessage() {}
+
+func (x *AllowedActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedActionsResponse.ProtoReflect.Descriptor instead.
+func (*AllowedActionsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AllowedActionsResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AllowedActionsResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *AllowedActionsResponse) GetActions() []OrderAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type ExtendStorageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Days          uint32                 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendStorageRequest) Reset() {
+	*x = ExtendStorageRequest{}
+	mi := &file_orders_contract_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendStorageRequest) ProtoMessage() {}
+
+func (x *ExtendStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendStorageRequest.ProtoReflect.Descriptor instead.
+func (*ExtendStorageRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExtendStorageRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ExtendStorageRequest) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type ExtendStorageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Fee           float32                `protobuf:"fixed32,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendStorageResponse) Reset() {
+	*x = ExtendStorageResponse{}
+	mi := &file_orders_contract_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendStorageResponse) ProtoMessage() {}
+
+func (x *ExtendStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendStorageResponse.ProtoReflect.Descriptor instead.
+func (*ExtendStorageResponse) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExtendStorageResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ExtendStorageResponse) GetFee() float32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+type MoveOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CellCode      string                 `protobuf:"bytes,2,opt,name=cell_code,json=cellCode,proto3" json:"cell_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveOrderRequest) Reset() {
+	*x = MoveOrderRequest{}
+	mi := &file_orders_contract_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveOrderRequest) ProtoMessage() {}
+
+func (x *MoveOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveOrderRequest.ProtoReflect.Descriptor instead.
+func (*MoveOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MoveOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *MoveOrderRequest) GetCellCode() string {
+	if x != nil {
+		return x.CellCode
+	}
+	return ""
+}
+
+type CreateStorageCellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Size          CellSize               `protobuf:"varint,2,opt,name=size,proto3,enum=orders.CellSize" json:"size,omitempty"`
+	Capacity      uint32                 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStorageCellRequest) Reset() {
+	*x = CreateStorageCellRequest{}
+	mi := &file_orders_contract_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStorageCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStorageCellRequest) ProtoMessage() {}
+
+func (x *CreateStorageCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStorageCellRequest.ProtoReflect.Descriptor instead.
+func (*CreateStorageCellRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateStorageCellRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateStorageCellRequest) GetSize() CellSize {
+	if x != nil {
+		return x.Size
+	}
+	return CellSize_CELL_SIZE_UNSPECIFIED
+}
+
+func (x *CreateStorageCellRequest) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type ListStorageCellsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageCellsRequest) Reset() {
+	*x = ListStorageCellsRequest{}
+	mi := &file_orders_contract_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageCellsRequest) ProtoMessage() {}
+
+func (x *ListStorageCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageCellsRequest.ProtoReflect.Descriptor instead.
+func (*ListStorageCellsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{24}
+}
+
+type StorageCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Size          CellSize               `protobuf:"varint,3,opt,name=size,proto3,enum=orders.CellSize" json:"size,omitempty"`
+	Capacity      uint32                 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Occupied      uint32                 `protobuf:"varint,5,opt,name=occupied,proto3" json:"occupied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageCell) Reset() {
+	*x = StorageCell{}
+	mi := &file_orders_contract_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCell) ProtoMessage() {}
+
+func (x *StorageCell) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCell.ProtoReflect.Descriptor instead.
+func (*StorageCell) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StorageCell) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorageCell) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StorageCell) GetSize() CellSize {
+	if x != nil {
+		return x.Size
+	}
+	return CellSize_CELL_SIZE_UNSPECIFIED
+}
+
+func (x *StorageCell) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *StorageCell) GetOccupied() uint32 {
+	if x != nil {
+		return x.Occupied
+	}
+	return 0
+}
+
+type StorageCellsList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*StorageCell         `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageCellsList) Reset() {
+	*x = StorageCellsList{}
+	mi := &file_orders_contract_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageCellsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCellsList) ProtoMessage() {}
+
+func (x *StorageCellsList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCellsList.ProtoReflect.Descriptor instead.
+func (*StorageCellsList) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StorageCellsList) GetCells() []*StorageCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type SetReturnPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Package       *PackageType           `protobuf:"varint,2,opt,name=package,proto3,enum=orders.PackageType,oneof" json:"package,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	WindowHours   uint32                 `protobuf:"varint,4,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	Returnable    bool                   `protobuf:"varint,5,opt,name=returnable,proto3" json:"returnable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReturnPolicyRequest) Reset() {
+	*x = SetReturnPolicyRequest{}
+	mi := &file_orders_contract_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReturnPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReturnPolicyRequest) ProtoMessage() {}
+
+func (x *SetReturnPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReturnPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetReturnPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetReturnPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetReturnPolicyRequest) GetPackage() PackageType {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
+}
+
+func (x *SetReturnPolicyRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *SetReturnPolicyRequest) GetWindowHours() uint32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *SetReturnPolicyRequest) GetReturnable() bool {
+	if x != nil {
+		return x.Returnable
+	}
+	return false
+}
+
+type ListReturnPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnPoliciesRequest) Reset() {
+	*x = ListReturnPoliciesRequest{}
+	mi := &file_orders_contract_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnPoliciesRequest) ProtoMessage() {}
+
+func (x *ListReturnPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{28}
+}
+
+type ReturnPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Package       *PackageType           `protobuf:"varint,3,opt,name=package,proto3,enum=orders.PackageType,oneof" json:"package,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	WindowHours   uint32                 `protobuf:"varint,5,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	Returnable    bool                   `protobuf:"varint,6,opt,name=returnable,proto3" json:"returnable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnPolicy) Reset() {
+	*x = ReturnPolicy{}
+	mi := &file_orders_contract_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnPolicy) ProtoMessage() {}
+
+func (x *ReturnPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnPolicy.ProtoReflect.Descriptor instead.
+func (*ReturnPolicy) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReturnPolicy) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReturnPolicy) GetPackage() PackageType {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
+}
+
+func (x *ReturnPolicy) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetWindowHours() uint32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetReturnable() bool {
+	if x != nil {
+		return x.Returnable
+	}
+	return false
+}
+
+type ReturnPoliciesList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*ReturnPolicy        `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnPoliciesList) Reset() {
+	*x = ReturnPoliciesList{}
+	mi := &file_orders_contract_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnPoliciesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnPoliciesList) ProtoMessage() {}
+
+func (x *ReturnPoliciesList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnPoliciesList.ProtoReflect.Descriptor instead.
+func (*ReturnPoliciesList) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReturnPoliciesList) GetPolicies() []*ReturnPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type CreatePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupPointRequest) Reset() {
+	*x = CreatePickupPointRequest{}
+	mi := &file_orders_contract_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupPointRequest) ProtoMessage() {}
+
+func (x *CreatePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*CreatePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePickupPointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePickupPointRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ListPickupPointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupPointsRequest) Reset() {
+	*x = ListPickupPointsRequest{}
+	mi := &file_orders_contract_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupPointsRequest) ProtoMessage() {}
+
+func (x *ListPickupPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupPointsRequest.ProtoReflect.Descriptor instead.
+func (*ListPickupPointsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{32}
+}
+
+type PickupPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPoint) Reset() {
+	*x = PickupPoint{}
+	mi := &file_orders_contract_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPoint) ProtoMessage() {}
+
+func (x *PickupPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPoint.ProtoReflect.Descriptor instead.
+func (*PickupPoint) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PickupPoint) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PickupPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PickupPointsList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*PickupPoint         `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointsList) Reset() {
+	*x = PickupPointsList{}
+	mi := &file_orders_contract_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointsList) ProtoMessage() {}
+
+func (x *PickupPointsList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_contract_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointsList.ProtoReflect.Descriptor instead.
+func (*PickupPointsList) Descriptor() ([]byte, []int) {
+	return file_orders_contract_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PickupPointsList) GetPoints() []*PickupPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+var File_orders_contract_proto protoreflect.FileDescriptor
+
+const file_orders_contract_proto_rawDesc = "" +
+	"\n" +
+	"\x15orders/contract.proto\x12\x06orders\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd7\x02\n" +
+	"\x12AcceptOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x12E\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\n" +
+	"\xfaB\a\xb2\x01\x04\b\x01@\x01R\texpiresAt\x122\n" +
+	"\apackage\x18\x04 \x01(\x0e2\x13.orders.PackageTypeH\x00R\apackage\x88\x01\x01\x12\"\n" +
+	"\x06weight\x18\x05 \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05%\x00\x00\x00\x00R\x06weight\x12 \n" +
+	"\x05price\x18\x06 \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05%\x00\x00\x00\x00R\x05price\x12 \n" +
+	"\tseller_id\x18\a \x01(\x04H\x01R\bsellerId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_packageB\f\n" +
+	"\n" +
+	"_seller_id\"4\n" +
+	"\x0eOrderIdRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"\xe6\x01\n" +
+	"\x14ProcessOrdersRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x126\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x12.orders.ActionTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06action\x12+\n" +
+	"\torder_ids\x18\x03 \x03(\x04B\x0e\xfaB\v\x92\x01\b\b\x01\"\x042\x02 \x00R\borderIds\x127\n" +
+	"\vpickup_code\x18\x04 \x01(\tB\x11\xfaB\x0er\f2\n" +
+	"^[0-9]{6}$H\x00R\n" +
+	"pickupCode\x88\x01\x01B\x0e\n" +
+	"\f_pickup_code\"\xc4\x01\n" +
+	"\x11ListOrdersRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x12\x15\n" +
+	"\x06in_pvz\x18\x02 \x01(\bR\x05inPvz\x12#\n" +
+	"\x06last_n\x18\x03 \x01(\rB\a\xfaB\x04*\x02 \x00H\x00R\x05lastN\x88\x01\x01\x127\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x12.orders.PaginationH\x01R\n" +
+	"pagination\x88\x01\x01B\t\n" +
+	"\a_last_nB\r\n" +
+	"\v_pagination\"V\n" +
+	"\n" +
+	"Pagination\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\rB\a\xfaB\x04*\x02(\x00R\x04page\x12+\n" +
+	"\rcount_on_page\x18\x02 \x01(\rB\a\xfaB\x04*\x02 \x00R\vcountOnPage\"H\n" +
+	"\x12ListReturnsRequest\x122\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x12.orders.PaginationR\n" +
+	"pagination\"S\n" +
+	"\x13ImportOrdersRequest\x12<\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1a.orders.AcceptOrderRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\x06orders\"G\n" +
+	"\x11GetHistoryRequest\x122\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x12.orders.PaginationR\n" +
+	"pagination\"9\n" +
+	"\x13OrderHistoryRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"F\n" +
+	"\x14OrderHistoryResponse\x12.\n" +
+	"\ahistory\x18\x01 \x03(\v2\x14.orders.OrderHistoryR\ahistory\"W\n" +
+	"\rOrderResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.orders.OrderStatusR\x06status\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x04R\aorderId\"E\n" +
+	"\rProcessResult\x12\x1c\n" +
+	"\tprocessed\x18\x01 \x03(\x04R\tprocessed\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\x04R\x06errors\"I\n" +
+	"\n" +
+	"OrdersList\x12%\n" +
+	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"6\n" +
+	"\vReturnsList\x12'\n" +
+	"\areturns\x18\x01 \x03(\v2\r.orders.OrderR\areturns\"B\n" +
+	"\x10OrderHistoryList\x12.\n" +
+	"\ahistory\x18\x01 \x03(\v2\x14.orders.OrderHistoryR\ahistory\"B\n" +
+	"\fImportResult\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\x04R\x06errors\"\xed\x02\n" +
+	"\x05Order\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12+\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.orders.OrderStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x02R\x06weight\x12\x1f\n" +
+	"\vtotal_price\x18\x06 \x01(\x02R\n" +
+	"totalPrice\x122\n" +
+	"\apackage\x18\a \x01(\x0e2\x13.orders.PackageTypeH\x00R\apackage\x88\x01\x01\x12\x15\n" +
+	"\x06pvz_id\x18\b \x01(\x04R\x05pvzId\x12\x1b\n" +
+	"\tcell_code\x18\t \x01(\tR\bcellCode\x12\x1b\n" +
+	"\tseller_id\x18\n" +
+	" \x01(\x04R\bsellerIdB\n" +
+	"\n" +
+	"\b_package\"\xa8\x01\n" +
+	"\fOrderHistory\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.orders.OrderStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x15\n" +
+	"\x06pvz_id\x18\x04 \x01(\x04R\x05pvzId\">\n" +
+	"\x18GetAllowedActionsRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"\x8f\x01\n" +
+	"\x16AllowedActionsResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.orders.OrderStatusR\x06status\x12-\n" +
+	"\aactions\x18\x03 \x03(\x0e2\x13.orders.OrderActionR\aactions\"W\n" +
+	"\x14ExtendStorageRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\x12\x1b\n" +
+	"\x04days\x18\x02 \x01(\rB\a\xfaB\x04*\x02 \x00R\x04days\"N\n" +
+	"\x15ExtendStorageResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\x12\x10\n" +
+	"\x03fee\x18\x02 \x01(\x02R\x03fee\"\\\n" +
+	"\x10MoveOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\x12$\n" +
+	"\tcell_code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcellCode\"\x8e\x01\n" +
+	"\x18CreateStorageCellRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\x120\n" +
+	"\x04size\x18\x02 \x01(\x0e2\x10.orders.CellSizeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04size\x12#\n" +
+	"\bcapacity\x18\x03 \x01(\rB\a\xfaB\x04*\x02 \x00R\bcapacity\"\x19\n" +
+	"\x17ListStorageCellsRequest\"\x8f\x01\n" +
+	"\vStorageCell\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12$\n" +
+	"\x04size\x18\x03 \x01(\x0e2\x10.orders.CellSizeR\x04size\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\rR\bcapacity\x12\x1a\n" +
+	"\boccupied\x18\x05 \x01(\rR\boccupied\"=\n" +
+	"\x10StorageCellsList\x12)\n" +
+	"\x05cells\x18\x01 \x03(\v2\x13.orders.StorageCellR\x05cells\"\xd5\x01\n" +
+	"\x16SetReturnPolicyRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x122\n" +
+	"\apackage\x18\x02 \x01(\x0e2\x13.orders.PackageTypeH\x00R\apackage\x88\x01\x01\x12\x1b\n" +
+	"\tseller_id\x18\x03 \x01(\x04R\bsellerId\x12!\n" +
+	"\fwindow_hours\x18\x04 \x01(\rR\vwindowHours\x12\x1e\n" +
+	"\n" +
+	"returnable\x18\x05 \x01(\bR\n" +
+	"returnableB\n" +
+	"\n" +
+	"\b_package\"\x1b\n" +
+	"\x19ListReturnPoliciesRequest\"\xd2\x01\n" +
+	"\fReturnPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
+	"\apackage\x18\x03 \x01(\x0e2\x13.orders.PackageTypeH\x00R\apackage\x88\x01\x01\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\x04R\bsellerId\x12!\n" +
+	"\fwindow_hours\x18\x05 \x01(\rR\vwindowHours\x12\x1e\n" +
+	"\n" +
+	"returnable\x18\x06 \x01(\bR\n" +
+	"returnableB\n" +
+	"\n" +
+	"\b_package\"F\n" +
+	"\x12ReturnPoliciesList\x120\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x14.orders.ReturnPolicyR\bpolicies\"Q\n" +
+	"\x18CreatePickupPointRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x19\n" +
+	"\x17ListPickupPointsRequest\"\x86\x01\n" +
+	"\vPickupPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x129\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*X\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"?\n" +
+	"\x10PickupPointsList\x12+\n" +
+	"\x06points\x18\x01 \x03(\v2\x13.orders.PickupPointR\x06points*X\n" +
 	"\n" +
 	"ActionType\x12\x1b\n" +
 	"\x17ACTION_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
@@ -1262,24 +2418,45 @@ const file_orders_contract_proto_rawDesc = "" +
 	"\x10PACKAGE_TYPE_BOX\x10\x02\x12\x15\n" +
 	"\x11PACKAGE_TYPE_TAPE\x10\x03\x12\x19\n" +
 	"\x15PACKAGE_TYPE_BAG_TAPE\x10\x04\x12\x19\n" +
-	"\x15PACKAGE_TYPE_BOX_TAPE\x10\x05*\x95\x01\n" +
+	"\x15PACKAGE_TYPE_BOX_TAPE\x10\x05*\xb1\x01\n" +
 	"\vOrderStatus\x12\x1c\n" +
 	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ORDER_STATUS_EXPECTS\x10\x01\x12\x19\n" +
 	"\x15ORDER_STATUS_ACCEPTED\x10\x02\x12\x19\n" +
 	"\x15ORDER_STATUS_RETURNED\x10\x03\x12\x18\n" +
-	"\x14ORDER_STATUS_DELETED\x10\x042\xa0\x1b\n" +
+	"\x14ORDER_STATUS_DELETED\x10\x04\x12\x1a\n" +
+	"\x16ORDER_STATUS_ANNOUNCED\x10\x05*\xad\x01\n" +
+	"\vOrderAction\x12\x1c\n" +
+	"\x18ORDER_ACTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ORDER_ACTION_ISSUE\x10\x01\x12#\n" +
+	"\x1fORDER_ACTION_RETURN_FROM_CLIENT\x10\x02\x12\"\n" +
+	"\x1eORDER_ACTION_RETURN_TO_COURIER\x10\x03\x12\x1f\n" +
+	"\x1bORDER_ACTION_EXTEND_STORAGE\x10\x04*e\n" +
+	"\bCellSize\x12\x19\n" +
+	"\x15CELL_SIZE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fCELL_SIZE_SMALL\x10\x01\x12\x14\n" +
+	"\x10CELL_SIZE_MEDIUM\x10\x02\x12\x13\n" +
+	"\x0fCELL_SIZE_LARGE\x10\x032\xa4:\n" +
 	"\rOrdersService\x12\x90\x03\n" +
 	"\vAcceptOrder\x12\x1a.orders.AcceptOrderRequest\x1a\x15.orders.OrderResponse\"\xcd\x02\x92A\xad\x02\x12-Принять заказ от курьера\x1a\xfb\x01Принимает заказ с указанным ID, ID получателя и сроком хранения. Заказ нельзя принять дважды. Если срок хранения в прошлом, выдается ошибка.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/orders/accept\x12\xc2\x03\n" +
-	"\vReturnOrder\x12\x16.orders.OrderIdRequest\x1a\x15.orders.OrderResponse\"\x83\x03\x92A\xe3\x02\x12(Вернуть заказ курьеру\x1a\xb6\x02Возвращает заказ курьеру по указанному ID. Можно вернуть только заказы, которые не находятся у клиентов или у которых истек срок хранения. Заказ помечается как удаленный.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/orders/return\x12\xb0\x05\n" +
-	"\rProcessOrders\x12\x1c.orders.ProcessOrdersRequest\x1a\x15.orders.ProcessResult\"\xe9\x04\x92A\xc8\x04\x12OВыдать заказы или принять возвраты клиента\x1a\xf4\x03Обрабатывает выдачу заказов или прием возвратов для указанного пользователя и списка заказов. Выдача возможна только для принятых заказов с неистекшим сроком хранения. Возврат возможен в течение двух суток с момента выдачи. Все заказы должны принадлежать одному клиенту.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/orders/process\x12\xb5\x03\n" +
+	"\vReturnOrder\x12\x16.orders.OrderIdRequest\x1a\x15.orders.OrderResponse\"\x83\x03\x92A\xe3\x02\x12(Вернуть заказ курьеру\x1a\xb6\x02Возвращает заказ курьеру по указанному ID. Можно вернуть только заказы, которые не находятся у клиентов или у которых истек срок хранения. Заказ помечается как удаленный.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/orders/return\x12\xc3\b\n" +
+	"\rProcessOrders\x12\x1c.orders.ProcessOrdersRequest\x1a\x15.orders.ProcessResult\"\xfc\a\x92A\xdb\a\x12OВыдать заказы или принять возвраты клиента\x1a\x87\aОбрабатывает выдачу заказов или прием возвратов для указанного пользователя и списка заказов. Выдача возможна только для принятых заказов с неистекшим сроком хранения и только по коду выдачи, который получатель получает в уведомлении о приемке; после нескольких неверных кодов выдача временно блокируется. Возврат возможен в течение окна, заданного политикой возврата для типа упаковки или продавца (по умолчанию двое суток с момента выдачи). Все заказы должны принадлежать одному клиенту.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/orders/process\x12\xb5\x03\n" +
 	"\n" +
 	"ListOrders\x12\x19.orders.ListOrdersRequest\x1a\x12.orders.OrdersList\"\xf7\x02\x92A\xd2\x02\x12,Получить список заказов\x1a\xa1\x02Возвращает список заказов для указанного пользователя. Поддерживает получение последних N заказов или заказов, находящихся в ПВЗ, с опциональной пагинацией.\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/orders/list/{user_id}\x12\xf5\x02\n" +
 	"\vListReturns\x12\x1a.orders.ListReturnsRequest\x1a\x13.orders.ReturnsList\"\xb4\x02\x92A\x96\x02\x12AПолучить список возвратов клиентов\x1a\xd0\x01Возвращает список возвращенных заказов с постраничной пагинацией, отсортированный от свежих возвратов к старым.\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/orders/returns\x12\xd1\x02\n" +
 	"\n" +
 	"GetHistory\x12\x19.orders.GetHistoryRequest\x1a\x18.orders.OrderHistoryList\"\x8d\x02\x92A\xef\x01\x12.Получить историю заказов\x1a\xbc\x01Возвращает историю изменений статуса всех заказов, отсортированную по времени последнего обновления.\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/orders/history\x12\xa8\x02\n" +
 	"\fImportOrders\x12\x1b.orders.ImportOrdersRequest\x1a\x14.orders.ImportResult\"\xe4\x01\x92A\xc4\x01\x12'Импортировать заказы\x1a\x98\x01Импортирует несколько заказов из предоставленного списка, валидируя каждый заказ.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/orders/import\x12\xd4\x03\n" +
-	"\x0fGetOrderHistory\x12\x1b.orders.OrderHistoryRequest\x1a\x1c.orders.OrderHistoryResponse\"\x85\x03\x92A\xdc\x02\x12BПолучить историю статусов по заказу\x1a\x95\x02Возвращает историю изменений статуса для указанного заказа, отсортированную по убыванию времени изменения. Если заказ не найден, возвращается ошибка.\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/orders/{order_id}/historyB\xf5\x01\x92A\xc3\x01\x12\x89\x01\n" +
+	"\x0fGetOrderHistory\x12\x1b.orders.OrderHistoryRequest\x1a\x1c.orders.OrderHistoryResponse\"\x85\x03\x92A\xdc\x02\x12BПолучить историю статусов по заказу\x1a\x95\x02Возвращает историю изменений статуса для указанного заказа, отсортированную по убыванию времени изменения. Если заказ не найден, возвращается ошибка.\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/orders/{order_id}/history\x12\xd6\x03\n" +
+	"\x11GetAllowedActions\x12 .orders.GetAllowedActionsRequest\x1a\x1e.orders.AllowedActionsResponse\"\xfe\x02\x92A\xd5\x02\x12FПолучить доступные действия по заказу\x1a\x8a\x02Возвращает текущий статус заказа и действия, которые можно выполнить с ним прямо сейчас, с учетом таблицы переходов и сроков хранения и возврата.\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/orders/{order_id}/actions\x12\x82\x04\n" +
+	"\rExtendStorage\x12\x1c.orders.ExtendStorageRequest\x1a\x1d.orders.ExtendStorageResponse\"\xb3\x03\x92A\x88\x03\x12.Продлить хранение заказа\x1a\xd5\x02Переносит срок хранения заказа на указанное число дней. Суммарное продление ограничено настройкой сервиса, за каждый день может взиматься плата, которая добавляется к стоимости заказа.\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/orders/{order_id}/extend\x12\xa1\x03\n" +
+	"\tMoveOrder\x12\x18.orders.MoveOrderRequest\x1a\r.orders.Order\"\xea\x02\x92A\xc1\x02\x12<Переложить заказ в другую ячейку\x1a\x80\x02Перемещает заказ, находящийся в ПВЗ, в указанную ячейку хранения. Предыдущая ячейка освобождается. Если в ячейке нет места, выдается ошибка.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/orders/{order_id}/move\x12\xd0\x02\n" +
+	"\x11CreateStorageCell\x12 .orders.CreateStorageCellRequest\x1a\x13.orders.StorageCell\"\x83\x02\x92A\xe3\x01\x12,Создать ячейку хранения\x1a\xb2\x01Добавляет ячейку хранения с указанным кодом, размером и вместимостью в пункт выдачи вызывающего.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/storage-cells\x12\xbe\x02\n" +
+	"\x10ListStorageCells\x12\x1f.orders.ListStorageCellsRequest\x1a\x18.orders.StorageCellsList\"\xee\x01\x92A\xd1\x01\x129Получить список ячеек хранения\x1a\x93\x01Возвращает ячейки хранения пункта выдачи вызывающего с текущей заполненностью.\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/storage-cells\x12\xb5\x04\n" +
+	"\x0fSetReturnPolicy\x12\x1e.orders.SetReturnPolicyRequest\x1a\x14.orders.ReturnPolicy\"\xeb\x03\x92A\xc9\x03\x12.Задать политику возврата\x1a\x96\x03Создает или обновляет политику возврата для типа упаковки и/или продавца. Если ни упаковка, ни продавец не указаны, политика действует для всех заказов. Более конкретная политика (продавец, затем упаковка) имеет приоритет.\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/return-policies\x12\x8b\x02\n" +
+	"\x12ListReturnPolicies\x12!.orders.ListReturnPoliciesRequest\x1a\x1a.orders.ReturnPoliciesList\"\xb5\x01\x92A\x96\x01\x12=Получить список политик возврата\x1aUВозвращает все настроенные политики возврата.\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/return-policies\x12\xfb\x02\n" +
+	"\x11CreatePickupPoint\x12 .orders.CreatePickupPointRequest\x1a\x13.orders.PickupPoint\"\xae\x02\x92A\x8e\x02\x12&Создать пункт выдачи\x1a\xe3\x01Регистрирует новый пункт выдачи заказов. ID пункта передается в остальные методы через метаданные x-pvz-id (заголовок X-Pvz-Id в HTTP).\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/pickup-points\x12\x94\x02\n" +
+	"\x10ListPickupPoints\x12\x1f.orders.ListPickupPointsRequest\x1a\x18.orders.PickupPointsList\"\xc4\x01\x92A\xa7\x01\x129Получить список пунктов выдачи\x1ajВозвращает все зарегистрированные пункты выдачи заказов.\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/pickup-pointsB\xf5\x01\x92A\xc3\x01\x12\x89\x01\n" +
 	"\x12PVZ Orders Service\x12lAPI для управления заказами в системе пункта выдачи заказов.2\x051.0.0\x1a\x0elocalhost:8081*\x01\x012\x10application/json:\x10application/jsonZ,gitlab.ozon.dev/safariproxd/homework/pkg/apib\x06proto3"
 
 var (
@@ -1294,71 +2471,119 @@ func file_orders_contract_proto_rawDescGZIP() []byte {
 	return file_orders_contract_proto_rawDescData
 }
 
-var file_orders_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_orders_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_orders_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_orders_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_orders_contract_proto_goTypes = []any{
-	(ActionType)(0),               // 0: orders.ActionType
-	(PackageType)(0),              // 1: orders.PackageType
-	(OrderStatus)(0),              // 2: orders.OrderStatus
-	(*AcceptOrderRequest)(nil),    // 3: orders.AcceptOrderRequest
-	(*OrderIdRequest)(nil),        // 4: orders.OrderIdRequest
-	(*ProcessOrdersRequest)(nil),  // 5: orders.ProcessOrdersRequest
-	(*ListOrdersRequest)(nil),     // 6: orders.ListOrdersRequest
-	(*Pagination)(nil),            // 7: orders.Pagination
-	(*ListReturnsRequest)(nil),    // 8: orders.ListReturnsRequest
-	(*ImportOrdersRequest)(nil),   // 9: orders.ImportOrdersRequest
-	(*GetHistoryRequest)(nil),     // 10: orders.GetHistoryRequest
-	(*OrderHistoryRequest)(nil),   // 11: orders.OrderHistoryRequest
-	(*OrderHistoryResponse)(nil),  // 12: orders.OrderHistoryResponse
-	(*OrderResponse)(nil),         // 13: orders.OrderResponse
-	(*ProcessResult)(nil),         // 14: orders.ProcessResult
-	(*OrdersList)(nil),            // 15: orders.OrdersList
-	(*ReturnsList)(nil),           // 16: orders.ReturnsList
-	(*OrderHistoryList)(nil),      // 17: orders.OrderHistoryList
-	(*ImportResult)(nil),          // 18: orders.ImportResult
-	(*Order)(nil),                 // 19: orders.Order
-	(*OrderHistory)(nil),          // 20: orders.OrderHistory
-	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
+	(ActionType)(0),                   // 0: orders.ActionType
+	(PackageType)(0),                  // 1: orders.PackageType
+	(OrderStatus)(0),                  // 2: orders.OrderStatus
+	(OrderAction)(0),                  // 3: orders.OrderAction
+	(CellSize)(0),                     // 4: orders.CellSize
+	(*AcceptOrderRequest)(nil),        // 5: orders.AcceptOrderRequest
+	(*OrderIdRequest)(nil),            // 6: orders.OrderIdRequest
+	(*ProcessOrdersRequest)(nil),      // 7: orders.ProcessOrdersRequest
+	(*ListOrdersRequest)(nil),         // 8: orders.ListOrdersRequest
+	(*Pagination)(nil),                // 9: orders.Pagination
+	(*ListReturnsRequest)(nil),        // 10: orders.ListReturnsRequest
+	(*ImportOrdersRequest)(nil),       // 11: orders.ImportOrdersRequest
+	(*GetHistoryRequest)(nil),         // 12: orders.GetHistoryRequest
+	(*OrderHistoryRequest)(nil),       // 13: orders.OrderHistoryRequest
+	(*OrderHistoryResponse)(nil),      // 14: orders.OrderHistoryResponse
+	(*OrderResponse)(nil),             // 15: orders.OrderResponse
+	(*ProcessResult)(nil),             // 16: orders.ProcessResult
+	(*OrdersList)(nil),                // 17: orders.OrdersList
+	(*ReturnsList)(nil),               // 18: orders.ReturnsList
+	(*OrderHistoryList)(nil),          // 19: orders.OrderHistoryList
+	(*ImportResult)(nil),              // 20: orders.ImportResult
+	(*Order)(nil),                     // 21: orders.Order
+	(*OrderHistory)(nil),              // 22: orders.OrderHistory
+	(*GetAllowedActionsRequest)(nil),  // 23: orders.GetAllowedActionsRequest
+	(*AllowedActionsResponse)(nil),    // 24: orders.AllowedActionsResponse
+	(*ExtendStorageRequest)(nil),      // 25: orders.ExtendStorageRequest
+	(*ExtendStorageResponse)(nil),     // 26: orders.ExtendStorageResponse
+	(*MoveOrderRequest)(nil),          // 27: orders.MoveOrderRequest
+	(*CreateStorageCellRequest)(nil),  // 28: orders.CreateStorageCellRequest
+	(*ListStorageCellsRequest)(nil),   // 29: orders.ListStorageCellsRequest
+	(*StorageCell)(nil),               // 30: orders.StorageCell
+	(*StorageCellsList)(nil),          // 31: orders.StorageCellsList
+	(*SetReturnPolicyRequest)(nil),    // 32: orders.SetReturnPolicyRequest
+	(*ListReturnPoliciesRequest)(nil), // 33: orders.ListReturnPoliciesRequest
+	(*ReturnPolicy)(nil),              // 34: orders.ReturnPolicy
+	(*ReturnPoliciesList)(nil),        // 35: orders.ReturnPoliciesList
+	(*CreatePickupPointRequest)(nil),  // 36: orders.CreatePickupPointRequest
+	(*ListPickupPointsRequest)(nil),   // 37: orders.ListPickupPointsRequest
+	(*PickupPoint)(nil),               // 38: orders.PickupPoint
+	(*PickupPointsList)(nil),          // 39: orders.PickupPointsList
+	(*timestamppb.Timestamp)(nil),     // 40: google.protobuf.Timestamp
 }
 var file_orders_contract_proto_depIdxs = []int32{
-	21, // 0: orders.AcceptOrderRequest.expires_at:type_name -> google.protobuf.Timestamp
+	40, // 0: orders.AcceptOrderRequest.expires_at:type_name -> google.protobuf.Timestamp
 	1,  // 1: orders.AcceptOrderRequest.package:type_name -> orders.PackageType
 	0,  // 2: orders.ProcessOrdersRequest.action:type_name -> orders.ActionType
-	7,  // 3: orders.ListOrdersRequest.pagination:type_name -> orders.Pagination
-	7,  // 4: orders.ListReturnsRequest.pagination:type_name -> orders.Pagination
-	3,  // 5: orders.ImportOrdersRequest.orders:type_name -> orders.AcceptOrderRequest
-	7,  // 6: orders.GetHistoryRequest.pagination:type_name -> orders.Pagination
-	20, // 7: orders.OrderHistoryResponse.history:type_name -> orders.OrderHistory
+	9,  // 3: orders.ListOrdersRequest.pagination:type_name -> orders.Pagination
+	9,  // 4: orders.ListReturnsRequest.pagination:type_name -> orders.Pagination
+	5,  // 5: orders.ImportOrdersRequest.orders:type_name -> orders.AcceptOrderRequest
+	9,  // 6: orders.GetHistoryRequest.pagination:type_name -> orders.Pagination
+	22, // 7: orders.OrderHistoryResponse.history:type_name -> orders.OrderHistory
 	2,  // 8: orders.OrderResponse.status:type_name -> orders.OrderStatus
-	19, // 9: orders.OrdersList.orders:type_name -> orders.Order
-	19, // 10: orders.ReturnsList.returns:type_name -> orders.Order
-	20, // 11: orders.OrderHistoryList.history:type_name -> orders.OrderHistory
+	21, // 9: orders.OrdersList.orders:type_name -> orders.Order
+	21, // 10: orders.ReturnsList.returns:type_name -> orders.Order
+	22, // 11: orders.OrderHistoryList.history:type_name -> orders.OrderHistory
 	2,  // 12: orders.Order.status:type_name -> orders.OrderStatus
-	21, // 13: orders.Order.expires_at:type_name -> google.protobuf.Timestamp
+	40, // 13: orders.Order.expires_at:type_name -> google.protobuf.Timestamp
 	1,  // 14: orders.Order.package:type_name -> orders.PackageType
 	2,  // 15: orders.OrderHistory.status:type_name -> orders.OrderStatus
-	21, // 16: orders.OrderHistory.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 17: orders.OrdersService.AcceptOrder:input_type -> orders.AcceptOrderRequest
-	4,  // 18: orders.OrdersService.ReturnOrder:input_type -> orders.OrderIdRequest
-	5,  // 19: orders.OrdersService.ProcessOrders:input_type -> orders.ProcessOrdersRequest
-	6,  // 20: orders.OrdersService.ListOrders:input_type -> orders.ListOrdersRequest
-	8,  // 21: orders.OrdersService.ListReturns:input_type -> orders.ListReturnsRequest
-	10, // 22: orders.OrdersService.GetHistory:input_type -> orders.GetHistoryRequest
-	9,  // 23: orders.OrdersService.ImportOrders:input_type -> orders.ImportOrdersRequest
-	11, // 24: orders.OrdersService.GetOrderHistory:input_type -> orders.OrderHistoryRequest
-	13, // 25: orders.OrdersService.AcceptOrder:output_type -> orders.OrderResponse
-	13, // 26: orders.OrdersService.ReturnOrder:output_type -> orders.OrderResponse
-	14, // 27: orders.OrdersService.ProcessOrders:output_type -> orders.ProcessResult
-	15, // 28: orders.OrdersService.ListOrders:output_type -> orders.OrdersList
-	16, // 29: orders.OrdersService.ListReturns:output_type -> orders.ReturnsList
-	17, // 30: orders.OrdersService.GetHistory:output_type -> orders.OrderHistoryList
-	18, // 31: orders.OrdersService.ImportOrders:output_type -> orders.ImportResult
-	12, // 32: orders.OrdersService.GetOrderHistory:output_type -> orders.OrderHistoryResponse
-	25, // [25:33] is the sub-list for method output_type
-	17, // [17:25] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	40, // 16: orders.OrderHistory.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 17: orders.AllowedActionsResponse.status:type_name -> orders.OrderStatus
+	3,  // 18: orders.AllowedActionsResponse.actions:type_name -> orders.OrderAction
+	21, // 19: orders.ExtendStorageResponse.order:type_name -> orders.Order
+	4,  // 20: orders.CreateStorageCellRequest.size:type_name -> orders.CellSize
+	4,  // 21: orders.StorageCell.size:type_name -> orders.CellSize
+	30, // 22: orders.StorageCellsList.cells:type_name -> orders.StorageCell
+	1,  // 23: orders.SetReturnPolicyRequest.package:type_name -> orders.PackageType
+	1,  // 24: orders.ReturnPolicy.package:type_name -> orders.PackageType
+	34, // 25: orders.ReturnPoliciesList.policies:type_name -> orders.ReturnPolicy
+	40, // 26: orders.PickupPoint.created_at:type_name -> google.protobuf.Timestamp
+	38, // 27: orders.PickupPointsList.points:type_name -> orders.PickupPoint
+	5,  // 28: orders.OrdersService.AcceptOrder:input_type -> orders.AcceptOrderRequest
+	6,  // 29: orders.OrdersService.ReturnOrder:input_type -> orders.OrderIdRequest
+	7,  // 30: orders.OrdersService.ProcessOrders:input_type -> orders.ProcessOrdersRequest
+	8,  // 31: orders.OrdersService.ListOrders:input_type -> orders.ListOrdersRequest
+	10, // 32: orders.OrdersService.ListReturns:input_type -> orders.ListReturnsRequest
+	12, // 33: orders.OrdersService.GetHistory:input_type -> orders.GetHistoryRequest
+	11, // 34: orders.OrdersService.ImportOrders:input_type -> orders.ImportOrdersRequest
+	13, // 35: orders.OrdersService.GetOrderHistory:input_type -> orders.OrderHistoryRequest
+	23, // 36: orders.OrdersService.GetAllowedActions:input_type -> orders.GetAllowedActionsRequest
+	25, // 37: orders.OrdersService.ExtendStorage:input_type -> orders.ExtendStorageRequest
+	27, // 38: orders.OrdersService.MoveOrder:input_type -> orders.MoveOrderRequest
+	28, // 39: orders.OrdersService.CreateStorageCell:input_type -> orders.CreateStorageCellRequest
+	29, // 40: orders.OrdersService.ListStorageCells:input_type -> orders.ListStorageCellsRequest
+	32, // 41: orders.OrdersService.SetReturnPolicy:input_type -> orders.SetReturnPolicyRequest
+	33, // 42: orders.OrdersService.ListReturnPolicies:input_type -> orders.ListReturnPoliciesRequest
+	36, // 43: orders.OrdersService.CreatePickupPoint:input_type -> orders.CreatePickupPointRequest
+	37, // 44: orders.OrdersService.ListPickupPoints:input_type -> orders.ListPickupPointsRequest
+	15, // 45: orders.OrdersService.AcceptOrder:output_type -> orders.OrderResponse
+	15, // 46: orders.OrdersService.ReturnOrder:output_type -> orders.OrderResponse
+	16, // 47: orders.OrdersService.ProcessOrders:output_type -> orders.ProcessResult
+	17, // 48: orders.OrdersService.ListOrders:output_type -> orders.OrdersList
+	18, // 49: orders.OrdersService.ListReturns:output_type -> orders.ReturnsList
+	19, // 50: orders.OrdersService.GetHistory:output_type -> orders.OrderHistoryList
+	20, // 51: orders.OrdersService.ImportOrders:output_type -> orders.ImportResult
+	14, // 52: orders.OrdersService.GetOrderHistory:output_type -> orders.OrderHistoryResponse
+	24, // 53: orders.OrdersService.GetAllowedActions:output_type -> orders.AllowedActionsResponse
+	26, // 54: orders.OrdersService.ExtendStorage:output_type -> orders.ExtendStorageResponse
+	21, // 55: orders.OrdersService.MoveOrder:output_type -> orders.Order
+	30, // 56: orders.OrdersService.CreateStorageCell:output_type -> orders.StorageCell
+	31, // 57: orders.OrdersService.ListStorageCells:output_type -> orders.StorageCellsList
+	34, // 58: orders.OrdersService.SetReturnPolicy:output_type -> orders.ReturnPolicy
+	35, // 59: orders.OrdersService.ListReturnPolicies:output_type -> orders.ReturnPoliciesList
+	38, // 60: orders.OrdersService.CreatePickupPoint:output_type -> orders.PickupPoint
+	39, // 61: orders.OrdersService.ListPickupPoints:output_type -> orders.PickupPointsList
+	45, // [45:62] is the sub-list for method output_type
+	28, // [28:45] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_orders_contract_proto_init() }
@@ -1367,15 +2592,18 @@ func file_orders_contract_proto_init() {
 		return
 	}
 	file_orders_contract_proto_msgTypes[0].OneofWrappers = []any{}
+	file_orders_contract_proto_msgTypes[2].OneofWrappers = []any{}
 	file_orders_contract_proto_msgTypes[3].OneofWrappers = []any{}
 	file_orders_contract_proto_msgTypes[16].OneofWrappers = []any{}
+	file_orders_contract_proto_msgTypes[27].OneofWrappers = []any{}
+	file_orders_contract_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orders_contract_proto_rawDesc), len(file_orders_contract_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   18,
+			NumEnums:      5,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
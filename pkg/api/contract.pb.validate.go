@@ -146,6 +146,10 @@ func (m *AcceptOrderRequest) validate(all bool) error {
 		// no validation rules for Package
 	}
 
+	if m.SellerId != nil {
+		// no validation rules for SellerId
+	}
+
 	if len(errors) > 0 {
 		return AcceptOrderRequestMultiError(errors)
 	}
@@ -419,6 +423,21 @@ func (m *ProcessOrdersRequest) validate(all bool) error {
 
 	}
 
+	if m.PickupCode != nil {
+
+		if !_ProcessOrdersRequest_PickupCode_Pattern.MatchString(m.GetPickupCode()) {
+			err := ProcessOrdersRequestValidationError{
+				field:  "PickupCode",
+				reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ProcessOrdersRequestMultiError(errors)
 	}
@@ -503,6 +522,8 @@ var _ProcessOrdersRequest_Action_NotInLookup = map[ActionType]struct{}{
 	0: {},
 }
 
+var _ProcessOrdersRequest_PickupCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
 // Validate checks the field values on ListOrdersRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2212,6 +2233,12 @@ func (m *Order) validate(all bool) error {
 
 	// no validation rules for TotalPrice
 
+	// no validation rules for PvzId
+
+	// no validation rules for CellCode
+
+	// no validation rules for SellerId
+
 	if m.Package != nil {
 		// no validation rules for Package
 	}
@@ -2348,6 +2375,8 @@ func (m *OrderHistory) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PvzId
+
 	if len(errors) > 0 {
 		return OrderHistoryMultiError(errors)
 	}
@@ -2424,3 +2453,2055 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = OrderHistoryValidationError{}
+
+// Validate checks the field values on GetAllowedActionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllowedActionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllowedActionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllowedActionsRequestMultiError, or nil if none found.
+func (m *GetAllowedActionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllowedActionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := GetAllowedActionsRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAllowedActionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllowedActionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllowedActionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllowedActionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllowedActionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllowedActionsRequestMultiError) AllErrors() []error { return m }
+
+// GetAllowedActionsRequestValidationError is the validation error returned by
+// GetAllowedActionsRequest.Validate if the designated constraints aren't met.
+type GetAllowedActionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllowedActionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllowedActionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllowedActionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllowedActionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllowedActionsRequestValidationError) ErrorName() string {
+	return "GetAllowedActionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllowedActionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllowedActionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllowedActionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllowedActionsRequestValidationError{}
+
+// Validate checks the field values on AllowedActionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AllowedActionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllowedActionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllowedActionsResponseMultiError, or nil if none found.
+func (m *AllowedActionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllowedActionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AllowedActionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllowedActionsResponseMultiError is an error wrapping multiple validation
+// errors returned by AllowedActionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AllowedActionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllowedActionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllowedActionsResponseMultiError) AllErrors() []error { return m }
+
+// AllowedActionsResponseValidationError is the validation error returned by
+// AllowedActionsResponse.Validate if the designated constraints aren't met.
+type AllowedActionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllowedActionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllowedActionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllowedActionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllowedActionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllowedActionsResponseValidationError) ErrorName() string {
+	return "AllowedActionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AllowedActionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllowedActionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllowedActionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllowedActionsResponseValidationError{}
+
+// Validate checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendStorageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendStorageRequestMultiError, or nil if none found.
+func (m *ExtendStorageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendStorageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := ExtendStorageRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDays() <= 0 {
+		err := ExtendStorageRequestValidationError{
+			field:  "Days",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExtendStorageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendStorageRequestMultiError is an error wrapping multiple validation
+// errors returned by ExtendStorageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendStorageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendStorageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendStorageRequestMultiError) AllErrors() []error { return m }
+
+// ExtendStorageRequestValidationError is the validation error returned by
+// ExtendStorageRequest.Validate if the designated constraints aren't met.
+type ExtendStorageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendStorageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendStorageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendStorageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendStorageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendStorageRequestValidationError) ErrorName() string {
+	return "ExtendStorageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendStorageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendStorageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendStorageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendStorageRequestValidationError{}
+
+// Validate checks the field values on ExtendStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendStorageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendStorageResponseMultiError, or nil if none found.
+func (m *ExtendStorageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendStorageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendStorageResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendStorageResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendStorageResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Fee
+
+	if len(errors) > 0 {
+		return ExtendStorageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendStorageResponseMultiError is an error wrapping multiple validation
+// errors returned by ExtendStorageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendStorageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendStorageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendStorageResponseMultiError) AllErrors() []error { return m }
+
+// ExtendStorageResponseValidationError is the validation error returned by
+// ExtendStorageResponse.Validate if the designated constraints aren't met.
+type ExtendStorageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendStorageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendStorageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendStorageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendStorageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendStorageResponseValidationError) ErrorName() string {
+	return "ExtendStorageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendStorageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendStorageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendStorageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendStorageResponseValidationError{}
+
+// Validate checks the field values on MoveOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveOrderRequestMultiError, or nil if none found.
+func (m *MoveOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := MoveOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCellCode()) < 1 {
+		err := MoveOrderRequestValidationError{
+			field:  "CellCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveOrderRequestMultiError) AllErrors() []error { return m }
+
+// MoveOrderRequestValidationError is the validation error returned by
+// MoveOrderRequest.Validate if the designated constraints aren't met.
+type MoveOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveOrderRequestValidationError) ErrorName() string { return "MoveOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveOrderRequestValidationError{}
+
+// Validate checks the field values on CreateStorageCellRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateStorageCellRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateStorageCellRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateStorageCellRequestMultiError, or nil if none found.
+func (m *CreateStorageCellRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateStorageCellRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateStorageCellRequest_Size_NotInLookup[m.GetSize()]; ok {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Size",
+			reason: "value must not be in list [CELL_SIZE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CellSize_name[int32(m.GetSize())]; !ok {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Size",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCapacity() <= 0 {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Capacity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateStorageCellRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateStorageCellRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateStorageCellRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateStorageCellRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateStorageCellRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateStorageCellRequestMultiError) AllErrors() []error { return m }
+
+// CreateStorageCellRequestValidationError is the validation error returned by
+// CreateStorageCellRequest.Validate if the designated constraints aren't met.
+type CreateStorageCellRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateStorageCellRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateStorageCellRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateStorageCellRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateStorageCellRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateStorageCellRequestValidationError) ErrorName() string {
+	return "CreateStorageCellRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateStorageCellRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateStorageCellRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateStorageCellRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateStorageCellRequestValidationError{}
+
+var _CreateStorageCellRequest_Size_NotInLookup = map[CellSize]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListStorageCellsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStorageCellsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStorageCellsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStorageCellsRequestMultiError, or nil if none found.
+func (m *ListStorageCellsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStorageCellsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListStorageCellsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStorageCellsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStorageCellsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStorageCellsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStorageCellsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStorageCellsRequestMultiError) AllErrors() []error { return m }
+
+// ListStorageCellsRequestValidationError is the validation error returned by
+// ListStorageCellsRequest.Validate if the designated constraints aren't met.
+type ListStorageCellsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStorageCellsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStorageCellsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStorageCellsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStorageCellsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStorageCellsRequestValidationError) ErrorName() string {
+	return "ListStorageCellsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStorageCellsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStorageCellsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStorageCellsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStorageCellsRequestValidationError{}
+
+// Validate checks the field values on StorageCell with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageCell) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageCell with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageCellMultiError, or
+// nil if none found.
+func (m *StorageCell) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageCell) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Size
+
+	// no validation rules for Capacity
+
+	// no validation rules for Occupied
+
+	if len(errors) > 0 {
+		return StorageCellMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageCellMultiError is an error wrapping multiple validation errors
+// returned by StorageCell.ValidateAll() if the designated constraints aren't met.
+type StorageCellMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageCellMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageCellMultiError) AllErrors() []error { return m }
+
+// StorageCellValidationError is the validation error returned by
+// StorageCell.Validate if the designated constraints aren't met.
+type StorageCellValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageCellValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageCellValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageCellValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageCellValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageCellValidationError) ErrorName() string { return "StorageCellValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageCellValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageCell.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageCellValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageCellValidationError{}
+
+// Validate checks the field values on StorageCellsList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StorageCellsList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageCellsList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageCellsListMultiError, or nil if none found.
+func (m *StorageCellsList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageCellsList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCells() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageCellsListValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageCellsListValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageCellsListValidationError{
+					field:  fmt.Sprintf("Cells[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StorageCellsListMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageCellsListMultiError is an error wrapping multiple validation errors
+// returned by StorageCellsList.ValidateAll() if the designated constraints
+// aren't met.
+type StorageCellsListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageCellsListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageCellsListMultiError) AllErrors() []error { return m }
+
+// StorageCellsListValidationError is the validation error returned by
+// StorageCellsList.Validate if the designated constraints aren't met.
+type StorageCellsListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageCellsListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageCellsListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageCellsListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageCellsListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageCellsListValidationError) ErrorName() string { return "StorageCellsListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageCellsListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageCellsList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageCellsListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageCellsListValidationError{}
+
+// Validate checks the field values on SetReturnPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetReturnPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetReturnPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetReturnPolicyRequestMultiError, or nil if none found.
+func (m *SetReturnPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetReturnPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := SetReturnPolicyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SellerId
+
+	// no validation rules for WindowHours
+
+	// no validation rules for Returnable
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if len(errors) > 0 {
+		return SetReturnPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetReturnPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetReturnPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetReturnPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetReturnPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetReturnPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetReturnPolicyRequestValidationError is the validation error returned by
+// SetReturnPolicyRequest.Validate if the designated constraints aren't met.
+type SetReturnPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetReturnPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetReturnPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetReturnPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetReturnPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetReturnPolicyRequestValidationError) ErrorName() string {
+	return "SetReturnPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetReturnPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetReturnPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetReturnPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetReturnPolicyRequestValidationError{}
+
+// Validate checks the field values on ListReturnPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReturnPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReturnPoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReturnPoliciesRequestMultiError, or nil if none found.
+func (m *ListReturnPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReturnPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListReturnPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReturnPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReturnPoliciesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListReturnPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReturnPoliciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReturnPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// ListReturnPoliciesRequestValidationError is the validation error returned by
+// ListReturnPoliciesRequest.Validate if the designated constraints aren't met.
+type ListReturnPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReturnPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReturnPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReturnPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReturnPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReturnPoliciesRequestValidationError) ErrorName() string {
+	return "ListReturnPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReturnPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReturnPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReturnPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReturnPoliciesRequestValidationError{}
+
+// Validate checks the field values on ReturnPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnPolicyMultiError, or
+// nil if none found.
+func (m *ReturnPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for SellerId
+
+	// no validation rules for WindowHours
+
+	// no validation rules for Returnable
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if len(errors) > 0 {
+		return ReturnPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnPolicyMultiError is an error wrapping multiple validation errors
+// returned by ReturnPolicy.ValidateAll() if the designated constraints aren't met.
+type ReturnPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnPolicyMultiError) AllErrors() []error { return m }
+
+// ReturnPolicyValidationError is the validation error returned by
+// ReturnPolicy.Validate if the designated constraints aren't met.
+type ReturnPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnPolicyValidationError) ErrorName() string { return "ReturnPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReturnPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnPolicyValidationError{}
+
+// Validate checks the field values on ReturnPoliciesList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnPoliciesList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnPoliciesList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnPoliciesListMultiError, or nil if none found.
+func (m *ReturnPoliciesList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnPoliciesList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnPoliciesListValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnPoliciesListValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnPoliciesListValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReturnPoliciesListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnPoliciesListMultiError is an error wrapping multiple validation errors
+// returned by ReturnPoliciesList.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnPoliciesListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnPoliciesListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnPoliciesListMultiError) AllErrors() []error { return m }
+
+// ReturnPoliciesListValidationError is the validation error returned by
+// ReturnPoliciesList.Validate if the designated constraints aren't met.
+type ReturnPoliciesListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnPoliciesListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnPoliciesListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnPoliciesListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnPoliciesListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnPoliciesListValidationError) ErrorName() string {
+	return "ReturnPoliciesListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnPoliciesListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnPoliciesList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnPoliciesListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnPoliciesListValidationError{}
+
+// Validate checks the field values on CreatePickupPointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePickupPointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePickupPointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePickupPointRequestMultiError, or nil if none found.
+func (m *CreatePickupPointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePickupPointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreatePickupPointRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return CreatePickupPointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePickupPointRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePickupPointRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePickupPointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePickupPointRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePickupPointRequestMultiError) AllErrors() []error { return m }
+
+// CreatePickupPointRequestValidationError is the validation error returned by
+// CreatePickupPointRequest.Validate if the designated constraints aren't met.
+type CreatePickupPointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePickupPointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePickupPointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePickupPointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePickupPointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePickupPointRequestValidationError) ErrorName() string {
+	return "CreatePickupPointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePickupPointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePickupPointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePickupPointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePickupPointRequestValidationError{}
+
+// Validate checks the field values on ListPickupPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPickupPointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPickupPointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPickupPointsRequestMultiError, or nil if none found.
+func (m *ListPickupPointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPickupPointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPickupPointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPickupPointsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPickupPointsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPickupPointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPickupPointsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPickupPointsRequestMultiError) AllErrors() []error { return m }
+
+// ListPickupPointsRequestValidationError is the validation error returned by
+// ListPickupPointsRequest.Validate if the designated constraints aren't met.
+type ListPickupPointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPickupPointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPickupPointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPickupPointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPickupPointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPickupPointsRequestValidationError) ErrorName() string {
+	return "ListPickupPointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPickupPointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPickupPointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPickupPointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPickupPointsRequestValidationError{}
+
+// Validate checks the field values on PickupPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PickupPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PickupPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PickupPointMultiError, or
+// nil if none found.
+func (m *PickupPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PickupPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Address
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PickupPointValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PickupPointValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PickupPointValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PickupPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// PickupPointMultiError is an error wrapping multiple validation errors
+// returned by PickupPoint.ValidateAll() if the designated constraints aren't met.
+type PickupPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PickupPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PickupPointMultiError) AllErrors() []error { return m }
+
+// PickupPointValidationError is the validation error returned by
+// PickupPoint.Validate if the designated constraints aren't met.
+type PickupPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PickupPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PickupPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PickupPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PickupPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PickupPointValidationError) ErrorName() string { return "PickupPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PickupPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPickupPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PickupPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PickupPointValidationError{}
+
+// Validate checks the field values on PickupPointsList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PickupPointsList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PickupPointsList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PickupPointsListMultiError, or nil if none found.
+func (m *PickupPointsList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PickupPointsList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PickupPointsListValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PickupPointsListValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PickupPointsListValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PickupPointsListMultiError(errors)
+	}
+
+	return nil
+}
+
+// PickupPointsListMultiError is an error wrapping multiple validation errors
+// returned by PickupPointsList.ValidateAll() if the designated constraints
+// aren't met.
+type PickupPointsListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PickupPointsListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PickupPointsListMultiError) AllErrors() []error { return m }
+
+// PickupPointsListValidationError is the validation error returned by
+// PickupPointsList.Validate if the designated constraints aren't met.
+type PickupPointsListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PickupPointsListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PickupPointsListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PickupPointsListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PickupPointsListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PickupPointsListValidationError) ErrorName() string { return "PickupPointsListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PickupPointsListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPickupPointsList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PickupPointsListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PickupPointsListValidationError{}
@@ -19,14 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrdersService_AcceptOrder_FullMethodName     = "/orders.OrdersService/AcceptOrder"
-	OrdersService_ReturnOrder_FullMethodName     = "/orders.OrdersService/ReturnOrder"
-	OrdersService_ProcessOrders_FullMethodName   = "/orders.OrdersService/ProcessOrders"
-	OrdersService_ListOrders_FullMethodName      = "/orders.OrdersService/ListOrders"
-	OrdersService_ListReturns_FullMethodName     = "/orders.OrdersService/ListReturns"
-	OrdersService_GetHistory_FullMethodName      = "/orders.OrdersService/GetHistory"
-	OrdersService_ImportOrders_FullMethodName    = "/orders.OrdersService/ImportOrders"
-	OrdersService_GetOrderHistory_FullMethodName = "/orders.OrdersService/GetOrderHistory"
+	OrdersService_AcceptOrder_FullMethodName        = "/orders.OrdersService/AcceptOrder"
+	OrdersService_ReturnOrder_FullMethodName        = "/orders.OrdersService/ReturnOrder"
+	OrdersService_ProcessOrders_FullMethodName      = "/orders.OrdersService/ProcessOrders"
+	OrdersService_ListOrders_FullMethodName         = "/orders.OrdersService/ListOrders"
+	OrdersService_ListReturns_FullMethodName        = "/orders.OrdersService/ListReturns"
+	OrdersService_GetHistory_FullMethodName         = "/orders.OrdersService/GetHistory"
+	OrdersService_ImportOrders_FullMethodName       = "/orders.OrdersService/ImportOrders"
+	OrdersService_GetOrderHistory_FullMethodName    = "/orders.OrdersService/GetOrderHistory"
+	OrdersService_GetAllowedActions_FullMethodName  = "/orders.OrdersService/GetAllowedActions"
+	OrdersService_ExtendStorage_FullMethodName      = "/orders.OrdersService/ExtendStorage"
+	OrdersService_MoveOrder_FullMethodName          = "/orders.OrdersService/MoveOrder"
+	OrdersService_CreateStorageCell_FullMethodName  = "/orders.OrdersService/CreateStorageCell"
+	OrdersService_ListStorageCells_FullMethodName   = "/orders.OrdersService/ListStorageCells"
+	OrdersService_SetReturnPolicy_FullMethodName    = "/orders.OrdersService/SetReturnPolicy"
+	OrdersService_ListReturnPolicies_FullMethodName = "/orders.OrdersService/ListReturnPolicies"
+	OrdersService_CreatePickupPoint_FullMethodName  = "/orders.OrdersService/CreatePickupPoint"
+	OrdersService_ListPickupPoints_FullMethodName   = "/orders.OrdersService/ListPickupPoints"
 )
 
 // OrdersServiceClient is the client API for OrdersService service.
@@ -41,6 +50,15 @@ type OrdersServiceClient interface {
 	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryList, error)
 	ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportResult, error)
 	GetOrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error)
+	GetAllowedActions(ctx context.Context, in *GetAllowedActionsRequest, opts ...grpc.CallOption) (*AllowedActionsResponse, error)
+	ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error)
+	MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*StorageCell, error)
+	ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*StorageCellsList, error)
+	SetReturnPolicy(ctx context.Context, in *SetReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error)
+	ListReturnPolicies(ctx context.Context, in *ListReturnPoliciesRequest, opts ...grpc.CallOption) (*ReturnPoliciesList, error)
+	CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error)
+	ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*PickupPointsList, error)
 }
 
 type ordersServiceClient struct {
@@ -131,6 +149,96 @@ func (c *ordersServiceClient) GetOrderHistory(ctx context.Context, in *OrderHist
 	return out, nil
 }
 
+func (c *ordersServiceClient) GetAllowedActions(ctx context.Context, in *GetAllowedActionsRequest, opts ...grpc.CallOption) (*AllowedActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllowedActionsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetAllowedActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendStorageResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ExtendStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrdersService_MoveOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*StorageCell, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageCell)
+	err := c.cc.Invoke(ctx, OrdersService_CreateStorageCell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*StorageCellsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageCellsList)
+	err := c.cc.Invoke(ctx, OrdersService_ListStorageCells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) SetReturnPolicy(ctx context.Context, in *SetReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnPolicy)
+	err := c.cc.Invoke(ctx, OrdersService_SetReturnPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListReturnPolicies(ctx context.Context, in *ListReturnPoliciesRequest, opts ...grpc.CallOption) (*ReturnPoliciesList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnPoliciesList)
+	err := c.cc.Invoke(ctx, OrdersService_ListReturnPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPoint)
+	err := c.cc.Invoke(ctx, OrdersService_CreatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*PickupPointsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointsList)
+	err := c.cc.Invoke(ctx, OrdersService_ListPickupPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations must embed UnimplementedOrdersServiceServer
 // for forward compatibility.
@@ -143,6 +251,15 @@ type OrdersServiceServer interface {
 	GetHistory(context.Context, *GetHistoryRequest) (*OrderHistoryList, error)
 	ImportOrders(context.Context, *ImportOrdersRequest) (*ImportResult, error)
 	GetOrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error)
+	GetAllowedActions(context.Context, *GetAllowedActionsRequest) (*AllowedActionsResponse, error)
+	ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error)
+	MoveOrder(context.Context, *MoveOrderRequest) (*Order, error)
+	CreateStorageCell(context.Context, *CreateStorageCellRequest) (*StorageCell, error)
+	ListStorageCells(context.Context, *ListStorageCellsRequest) (*StorageCellsList, error)
+	SetReturnPolicy(context.Context, *SetReturnPolicyRequest) (*ReturnPolicy, error)
+	ListReturnPolicies(context.Context, *ListReturnPoliciesRequest) (*ReturnPoliciesList, error)
+	CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*PickupPoint, error)
+	ListPickupPoints(context.Context, *ListPickupPointsRequest) (*PickupPointsList, error)
 	mustEmbedUnimplementedOrdersServiceServer()
 }
 
@@ -177,6 +294,33 @@ func (UnimplementedOrdersServiceServer) ImportOrders(context.Context, *ImportOrd
 func (UnimplementedOrdersServiceServer) GetOrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
 }
+func (UnimplementedOrdersServiceServer) GetAllowedActions(context.Context, *GetAllowedActionsRequest) (*AllowedActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedActions not implemented")
+}
+func (UnimplementedOrdersServiceServer) ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendStorage not implemented")
+}
+func (UnimplementedOrdersServiceServer) MoveOrder(context.Context, *MoveOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreateStorageCell(context.Context, *CreateStorageCellRequest) (*StorageCell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStorageCell not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListStorageCells(context.Context, *ListStorageCellsRequest) (*StorageCellsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageCells not implemented")
+}
+func (UnimplementedOrdersServiceServer) SetReturnPolicy(context.Context, *SetReturnPolicyRequest) (*ReturnPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReturnPolicy not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListReturnPolicies(context.Context, *ListReturnPoliciesRequest) (*ReturnPoliciesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturnPolicies not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*PickupPoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePickupPoint not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListPickupPoints(context.Context, *ListPickupPointsRequest) (*PickupPointsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPickupPoints not implemented")
+}
 func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
 func (UnimplementedOrdersServiceServer) testEmbeddedByValue()                       {}
 
@@ -342,6 +486,168 @@ func _OrdersService_GetOrderHistory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_GetAllowedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowedActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetAllowedActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetAllowedActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetAllowedActions(ctx, req.(*GetAllowedActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ExtendStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ExtendStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ExtendStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ExtendStorage(ctx, req.(*ExtendStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_MoveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).MoveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_MoveOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).MoveOrder(ctx, req.(*MoveOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreateStorageCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStorageCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateStorageCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateStorageCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateStorageCell(ctx, req.(*CreateStorageCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListStorageCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListStorageCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListStorageCells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListStorageCells(ctx, req.(*ListStorageCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_SetReturnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReturnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).SetReturnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_SetReturnPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).SetReturnPolicy(ctx, req.(*SetReturnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListReturnPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListReturnPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListReturnPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListReturnPolicies(ctx, req.(*ListReturnPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreatePickupPoint(ctx, req.(*CreatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListPickupPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPickupPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListPickupPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListPickupPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListPickupPoints(ctx, req.(*ListPickupPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +687,42 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrderHistory",
 			Handler:    _OrdersService_GetOrderHistory_Handler,
 		},
+		{
+			MethodName: "GetAllowedActions",
+			Handler:    _OrdersService_GetAllowedActions_Handler,
+		},
+		{
+			MethodName: "ExtendStorage",
+			Handler:    _OrdersService_ExtendStorage_Handler,
+		},
+		{
+			MethodName: "MoveOrder",
+			Handler:    _OrdersService_MoveOrder_Handler,
+		},
+		{
+			MethodName: "CreateStorageCell",
+			Handler:    _OrdersService_CreateStorageCell_Handler,
+		},
+		{
+			MethodName: "ListStorageCells",
+			Handler:    _OrdersService_ListStorageCells_Handler,
+		},
+		{
+			MethodName: "SetReturnPolicy",
+			Handler:    _OrdersService_SetReturnPolicy_Handler,
+		},
+		{
+			MethodName: "ListReturnPolicies",
+			Handler:    _OrdersService_ListReturnPolicies_Handler,
+		},
+		{
+			MethodName: "CreatePickupPoint",
+			Handler:    _OrdersService_CreatePickupPoint_Handler,
+		},
+		{
+			MethodName: "ListPickupPoints",
+			Handler:    _OrdersService_ListPickupPoints_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders/contract.proto",
@@ -0,0 +1,6071 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: orders/v2/contract.proto
+
+package api
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ActionType int32
+
+const (
+	ActionType_ACTION_TYPE_UNSPECIFIED ActionType = 0
+	ActionType_ACTION_TYPE_ISSUE       ActionType = 1
+	ActionType_ACTION_TYPE_RETURN      ActionType = 2
+)
+
+// Enum value maps for ActionType.
+var (
+	ActionType_name = map[int32]string{
+		0: "ACTION_TYPE_UNSPECIFIED",
+		1: "ACTION_TYPE_ISSUE",
+		2: "ACTION_TYPE_RETURN",
+	}
+	ActionType_value = map[string]int32{
+		"ACTION_TYPE_UNSPECIFIED": 0,
+		"ACTION_TYPE_ISSUE":       1,
+		"ACTION_TYPE_RETURN":      2,
+	}
+)
+
+func (x ActionType) Enum() *ActionType {
+	p := new(ActionType)
+	*p = x
+	return p
+}
+
+func (x ActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[0].Descriptor()
+}
+
+func (ActionType) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[0]
+}
+
+func (x ActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionType.Descriptor instead.
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportJobStatus int32
+
+const (
+	ImportJobStatus_IMPORT_JOB_STATUS_UNSPECIFIED ImportJobStatus = 0
+	ImportJobStatus_IMPORT_JOB_STATUS_PENDING     ImportJobStatus = 1
+	ImportJobStatus_IMPORT_JOB_STATUS_RUNNING     ImportJobStatus = 2
+	ImportJobStatus_IMPORT_JOB_STATUS_COMPLETED   ImportJobStatus = 3
+	ImportJobStatus_IMPORT_JOB_STATUS_CANCELLED   ImportJobStatus = 4
+	ImportJobStatus_IMPORT_JOB_STATUS_FAILED      ImportJobStatus = 5
+)
+
+// Enum value maps for ImportJobStatus.
+var (
+	ImportJobStatus_name = map[int32]string{
+		0: "IMPORT_JOB_STATUS_UNSPECIFIED",
+		1: "IMPORT_JOB_STATUS_PENDING",
+		2: "IMPORT_JOB_STATUS_RUNNING",
+		3: "IMPORT_JOB_STATUS_COMPLETED",
+		4: "IMPORT_JOB_STATUS_CANCELLED",
+		5: "IMPORT_JOB_STATUS_FAILED",
+	}
+	ImportJobStatus_value = map[string]int32{
+		"IMPORT_JOB_STATUS_UNSPECIFIED": 0,
+		"IMPORT_JOB_STATUS_PENDING":     1,
+		"IMPORT_JOB_STATUS_RUNNING":     2,
+		"IMPORT_JOB_STATUS_COMPLETED":   3,
+		"IMPORT_JOB_STATUS_CANCELLED":   4,
+		"IMPORT_JOB_STATUS_FAILED":      5,
+	}
+)
+
+func (x ImportJobStatus) Enum() *ImportJobStatus {
+	p := new(ImportJobStatus)
+	*p = x
+	return p
+}
+
+func (x ImportJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportJobStatus) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[1]
+}
+
+func (x ImportJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportJobStatus.Descriptor instead.
+func (ImportJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{1}
+}
+
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_UNPAID      PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_PAID        PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_REFUNDED    PaymentStatus = 3
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_UNPAID",
+		2: "PAYMENT_STATUS_PAID",
+		3: "PAYMENT_STATUS_REFUNDED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED": 0,
+		"PAYMENT_STATUS_UNPAID":      1,
+		"PAYMENT_STATUS_PAID":        2,
+		"PAYMENT_STATUS_REFUNDED":    3,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[2].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[2]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{2}
+}
+
+type PackageType int32
+
+const (
+	PackageType_PACKAGE_TYPE_UNSPECIFIED PackageType = 0
+	PackageType_PACKAGE_TYPE_BAG         PackageType = 1
+	PackageType_PACKAGE_TYPE_BOX         PackageType = 2
+	PackageType_PACKAGE_TYPE_TAPE        PackageType = 3
+	PackageType_PACKAGE_TYPE_BAG_TAPE    PackageType = 4
+	PackageType_PACKAGE_TYPE_BOX_TAPE    PackageType = 5
+)
+
+// Enum value maps for PackageType.
+var (
+	PackageType_name = map[int32]string{
+		0: "PACKAGE_TYPE_UNSPECIFIED",
+		1: "PACKAGE_TYPE_BAG",
+		2: "PACKAGE_TYPE_BOX",
+		3: "PACKAGE_TYPE_TAPE",
+		4: "PACKAGE_TYPE_BAG_TAPE",
+		5: "PACKAGE_TYPE_BOX_TAPE",
+	}
+	PackageType_value = map[string]int32{
+		"PACKAGE_TYPE_UNSPECIFIED": 0,
+		"PACKAGE_TYPE_BAG":         1,
+		"PACKAGE_TYPE_BOX":         2,
+		"PACKAGE_TYPE_TAPE":        3,
+		"PACKAGE_TYPE_BAG_TAPE":    4,
+		"PACKAGE_TYPE_BOX_TAPE":    5,
+	}
+)
+
+func (x PackageType) Enum() *PackageType {
+	p := new(PackageType)
+	*p = x
+	return p
+}
+
+func (x PackageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[3].Descriptor()
+}
+
+func (PackageType) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[3]
+}
+
+func (x PackageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageType.Descriptor instead.
+func (PackageType) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{3}
+}
+
+// Статусы заказа глазами клиента:
+// EXPECTS — лежит в ПВЗ и ждет получателя, ACCEPTED — получатель забрал заказ,
+// RETURNED — получатель вернул заказ в ПВЗ, DELETED — заказ ушел из ПВЗ курьеру,
+// ANNOUNCED — анонсирован в поставке, но еще не прибыл в ПВЗ
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_EXPECTS     OrderStatus = 1
+	OrderStatus_ORDER_STATUS_ACCEPTED    OrderStatus = 2
+	OrderStatus_ORDER_STATUS_RETURNED    OrderStatus = 3
+	OrderStatus_ORDER_STATUS_DELETED     OrderStatus = 4
+	OrderStatus_ORDER_STATUS_ANNOUNCED   OrderStatus = 5
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_EXPECTS",
+		2: "ORDER_STATUS_ACCEPTED",
+		3: "ORDER_STATUS_RETURNED",
+		4: "ORDER_STATUS_DELETED",
+		5: "ORDER_STATUS_ANNOUNCED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_EXPECTS":     1,
+		"ORDER_STATUS_ACCEPTED":    2,
+		"ORDER_STATUS_RETURNED":    3,
+		"ORDER_STATUS_DELETED":     4,
+		"ORDER_STATUS_ANNOUNCED":   5,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[4].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[4]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{4}
+}
+
+type OrderAction int32
+
+const (
+	OrderAction_ORDER_ACTION_UNSPECIFIED        OrderAction = 0
+	OrderAction_ORDER_ACTION_ISSUE              OrderAction = 1
+	OrderAction_ORDER_ACTION_RETURN_FROM_CLIENT OrderAction = 2
+	OrderAction_ORDER_ACTION_RETURN_TO_COURIER  OrderAction = 3
+	OrderAction_ORDER_ACTION_EXTEND_STORAGE     OrderAction = 4
+	OrderAction_ORDER_ACTION_CONFIRM_ARRIVAL    OrderAction = 5
+)
+
+// Enum value maps for OrderAction.
+var (
+	OrderAction_name = map[int32]string{
+		0: "ORDER_ACTION_UNSPECIFIED",
+		1: "ORDER_ACTION_ISSUE",
+		2: "ORDER_ACTION_RETURN_FROM_CLIENT",
+		3: "ORDER_ACTION_RETURN_TO_COURIER",
+		4: "ORDER_ACTION_EXTEND_STORAGE",
+		5: "ORDER_ACTION_CONFIRM_ARRIVAL",
+	}
+	OrderAction_value = map[string]int32{
+		"ORDER_ACTION_UNSPECIFIED":        0,
+		"ORDER_ACTION_ISSUE":              1,
+		"ORDER_ACTION_RETURN_FROM_CLIENT": 2,
+		"ORDER_ACTION_RETURN_TO_COURIER":  3,
+		"ORDER_ACTION_EXTEND_STORAGE":     4,
+		"ORDER_ACTION_CONFIRM_ARRIVAL":    5,
+	}
+)
+
+func (x OrderAction) Enum() *OrderAction {
+	p := new(OrderAction)
+	*p = x
+	return p
+}
+
+func (x OrderAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[5].Descriptor()
+}
+
+func (OrderAction) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[5]
+}
+
+func (x OrderAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderAction.Descriptor instead.
+func (OrderAction) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{5}
+}
+
+type CellSize int32
+
+const (
+	CellSize_CELL_SIZE_UNSPECIFIED CellSize = 0
+	CellSize_CELL_SIZE_SMALL       CellSize = 1
+	CellSize_CELL_SIZE_MEDIUM      CellSize = 2
+	CellSize_CELL_SIZE_LARGE       CellSize = 3
+)
+
+// Enum value maps for CellSize.
+var (
+	CellSize_name = map[int32]string{
+		0: "CELL_SIZE_UNSPECIFIED",
+		1: "CELL_SIZE_SMALL",
+		2: "CELL_SIZE_MEDIUM",
+		3: "CELL_SIZE_LARGE",
+	}
+	CellSize_value = map[string]int32{
+		"CELL_SIZE_UNSPECIFIED": 0,
+		"CELL_SIZE_SMALL":       1,
+		"CELL_SIZE_MEDIUM":      2,
+		"CELL_SIZE_LARGE":       3,
+	}
+)
+
+func (x CellSize) Enum() *CellSize {
+	p := new(CellSize)
+	*p = x
+	return p
+}
+
+func (x CellSize) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CellSize) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[6].Descriptor()
+}
+
+func (CellSize) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[6]
+}
+
+func (x CellSize) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CellSize.Descriptor instead.
+func (CellSize) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{6}
+}
+
+type PackageKind int32
+
+const (
+	PackageKind_PACKAGE_KIND_UNSPECIFIED PackageKind = 0
+	PackageKind_PACKAGE_KIND_CONTAINER   PackageKind = 1
+	PackageKind_PACKAGE_KIND_WRAPPING    PackageKind = 2
+)
+
+// Enum value maps for PackageKind.
+var (
+	PackageKind_name = map[int32]string{
+		0: "PACKAGE_KIND_UNSPECIFIED",
+		1: "PACKAGE_KIND_CONTAINER",
+		2: "PACKAGE_KIND_WRAPPING",
+	}
+	PackageKind_value = map[string]int32{
+		"PACKAGE_KIND_UNSPECIFIED": 0,
+		"PACKAGE_KIND_CONTAINER":   1,
+		"PACKAGE_KIND_WRAPPING":    2,
+	}
+)
+
+func (x PackageKind) Enum() *PackageKind {
+	p := new(PackageKind)
+	*p = x
+	return p
+}
+
+func (x PackageKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[7].Descriptor()
+}
+
+func (PackageKind) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[7]
+}
+
+func (x PackageKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageKind.Descriptor instead.
+func (PackageKind) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{7}
+}
+
+type DiscrepancyKind int32
+
+const (
+	DiscrepancyKind_DISCREPANCY_KIND_UNSPECIFIED DiscrepancyKind = 0
+	DiscrepancyKind_DISCREPANCY_KIND_MISSING     DiscrepancyKind = 1
+	DiscrepancyKind_DISCREPANCY_KIND_UNEXPECTED  DiscrepancyKind = 2
+)
+
+// Enum value maps for DiscrepancyKind.
+var (
+	DiscrepancyKind_name = map[int32]string{
+		0: "DISCREPANCY_KIND_UNSPECIFIED",
+		1: "DISCREPANCY_KIND_MISSING",
+		2: "DISCREPANCY_KIND_UNEXPECTED",
+	}
+	DiscrepancyKind_value = map[string]int32{
+		"DISCREPANCY_KIND_UNSPECIFIED": 0,
+		"DISCREPANCY_KIND_MISSING":     1,
+		"DISCREPANCY_KIND_UNEXPECTED":  2,
+	}
+)
+
+func (x DiscrepancyKind) Enum() *DiscrepancyKind {
+	p := new(DiscrepancyKind)
+	*p = x
+	return p
+}
+
+func (x DiscrepancyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscrepancyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[8].Descriptor()
+}
+
+func (DiscrepancyKind) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[8]
+}
+
+func (x DiscrepancyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscrepancyKind.Descriptor instead.
+func (DiscrepancyKind) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{8}
+}
+
+type ManifestStatus int32
+
+const (
+	ManifestStatus_MANIFEST_STATUS_UNSPECIFIED ManifestStatus = 0
+	ManifestStatus_MANIFEST_STATUS_OPEN        ManifestStatus = 1
+	ManifestStatus_MANIFEST_STATUS_HANDED_OVER ManifestStatus = 2
+)
+
+// Enum value maps for ManifestStatus.
+var (
+	ManifestStatus_name = map[int32]string{
+		0: "MANIFEST_STATUS_UNSPECIFIED",
+		1: "MANIFEST_STATUS_OPEN",
+		2: "MANIFEST_STATUS_HANDED_OVER",
+	}
+	ManifestStatus_value = map[string]int32{
+		"MANIFEST_STATUS_UNSPECIFIED": 0,
+		"MANIFEST_STATUS_OPEN":        1,
+		"MANIFEST_STATUS_HANDED_OVER": 2,
+	}
+)
+
+func (x ManifestStatus) Enum() *ManifestStatus {
+	p := new(ManifestStatus)
+	*p = x
+	return p
+}
+
+func (x ManifestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ManifestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v2_contract_proto_enumTypes[9].Descriptor()
+}
+
+func (ManifestStatus) Type() protoreflect.EnumType {
+	return &file_orders_v2_contract_proto_enumTypes[9]
+}
+
+func (x ManifestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ManifestStatus.Descriptor instead.
+func (ManifestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{9}
+}
+
+type AcceptOrderRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	OrderId      uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId       uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Package      *PackageType           `protobuf:"varint,4,opt,name=package,proto3,enum=orders.v2.PackageType,oneof" json:"package,omitempty"`
+	WeightGrams  int64                  `protobuf:"varint,5,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	PriceKopecks int64                  `protobuf:"varint,6,opt,name=price_kopecks,json=priceKopecks,proto3" json:"price_kopecks,omitempty"`
+	SellerId     *uint64                `protobuf:"varint,7,opt,name=seller_id,json=sellerId,proto3,oneof" json:"seller_id,omitempty"`
+	// код упаковки из справочника, в том числе составной (box+film); приоритетнее package
+	PackageCode *string `protobuf:"bytes,8,opt,name=package_code,json=packageCode,proto3,oneof" json:"package_code,omitempty"`
+	// оплата при получении: до ConfirmPayment заказ не выдается
+	CashOnDelivery bool `protobuf:"varint,9,opt,name=cash_on_delivery,json=cashOnDelivery,proto3" json:"cash_on_delivery,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcceptOrderRequest) Reset() {
+	*x = AcceptOrderRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrderRequest) ProtoMessage() {}
+
+func (x *AcceptOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrderRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcceptOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AcceptOrderRequest) GetPackage() PackageType {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
+}
+
+func (x *AcceptOrderRequest) GetWeightGrams() int64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetPriceKopecks() int64 {
+	if x != nil {
+		return x.PriceKopecks
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetSellerId() uint64 {
+	if x != nil && x.SellerId != nil {
+		return *x.SellerId
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetPackageCode() string {
+	if x != nil && x.PackageCode != nil {
+		return *x.PackageCode
+	}
+	return ""
+}
+
+func (x *AcceptOrderRequest) GetCashOnDelivery() bool {
+	if x != nil {
+		return x.CashOnDelivery
+	}
+	return false
+}
+
+type OrderIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderIdRequest) Reset() {
+	*x = OrderIdRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderIdRequest) ProtoMessage() {}
+
+func (x *OrderIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderIdRequest.ProtoReflect.Descriptor instead.
+func (*OrderIdRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderIdRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ProcessOrdersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action   ActionType             `protobuf:"varint,2,opt,name=action,proto3,enum=orders.v2.ActionType" json:"action,omitempty"`
+	OrderIds []uint64               `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	// код выдачи из уведомления о приемке; обязателен для ACTION_TYPE_ISSUE
+	PickupCode *string `protobuf:"bytes,4,opt,name=pickup_code,json=pickupCode,proto3,oneof" json:"pickup_code,omitempty"`
+	// все или ничего: заказы обрабатываются одной транзакцией, и ошибка по любому из них отменяет всю пачку
+	Atomic        bool `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessOrdersRequest) Reset() {
+	*x = ProcessOrdersRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessOrdersRequest) ProtoMessage() {}
+
+func (x *ProcessOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ProcessOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProcessOrdersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProcessOrdersRequest) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ProcessOrdersRequest) GetOrderIds() []uint64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *ProcessOrdersRequest) GetPickupCode() string {
+	if x != nil && x.PickupCode != nil {
+		return *x.PickupCode
+	}
+	return ""
+}
+
+func (x *ProcessOrdersRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type ListOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// получатель задается либо user_id, либо телефоном из справочника
+	UserId     uint64      `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InPvz      bool        `protobuf:"varint,2,opt,name=in_pvz,json=inPvz,proto3" json:"in_pvz,omitempty"`
+	LastN      *uint32     `protobuf:"varint,3,opt,name=last_n,json=lastN,proto3,oneof" json:"last_n,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Phone      *string     `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// next_page_token из предыдущего ответа; если задан, pagination.page не учитывается
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// размер страницы; если не задан, берется pagination.count_on_page
+	PageSize      uint32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOrdersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetInPvz() bool {
+	if x != nil {
+		return x.InPvz
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetLastN() uint32 {
+	if x != nil && x.LastN != nil {
+		return *x.LastN
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	CountOnPage   uint32                 `protobuf:"varint,2,opt,name=count_on_page,json=countOnPage,proto3" json:"count_on_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_orders_v2_contract_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Pagination) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Pagination) GetCountOnPage() uint32 {
+	if x != nil {
+		return x.CountOnPage
+	}
+	return 0
+}
+
+type ListReturnsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Pagination *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// next_page_token из предыдущего ответа; если задан, pagination.page не учитывается
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// размер страницы; если не задан, берется pagination.count_on_page
+	PageSize      uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsRequest) ProtoMessage() {}
+
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListReturnsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListReturnsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReturnsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ExportOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// пустой список — заказы в любом статусе
+	Statuses []OrderStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=orders.v2.OrderStatus" json:"statuses,omitempty"`
+	// получатель задается либо user_id, либо телефоном из справочника
+	UserId uint64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Phone  *string `protobuf:"bytes,3,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// интервал времени приемки заказа; accepted_to не включается
+	AcceptedFrom  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accepted_from,json=acceptedFrom,proto3" json:"accepted_from,omitempty"`
+	AcceptedTo    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=accepted_to,json=acceptedTo,proto3" json:"accepted_to,omitempty"`
+	PackageCode   *string                `protobuf:"bytes,6,opt,name=package_code,json=packageCode,proto3,oneof" json:"package_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersRequest) Reset() {
+	*x = ExportOrdersRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersRequest) ProtoMessage() {}
+
+func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportOrdersRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportOrdersRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetAcceptedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedFrom
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetAcceptedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedTo
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetPackageCode() string {
+	if x != nil && x.PackageCode != nil {
+		return *x.PackageCode
+	}
+	return ""
+}
+
+type ImportOrdersRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*AcceptOrderRequest  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// только проверить заказы, ничего не записывая
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersRequest) Reset() {
+	*x = ImportOrdersRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersRequest) ProtoMessage() {}
+
+func (x *ImportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportOrdersRequest) GetOrders() []*AcceptOrderRequest {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ImportOrdersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportOrdersStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// номер строки в исходном файле; возвращается в результате, чтобы сопоставить ответ со строкой
+	Row   uint64              `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Order *AcceptOrderRequest `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// только проверить строки, ничего не записывая; учитывается значение из первого сообщения потока
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersStreamRequest) Reset() {
+	*x = ImportOrdersStreamRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersStreamRequest) ProtoMessage() {}
+
+func (x *ImportOrdersStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersStreamRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersStreamRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportOrdersStreamRequest) GetRow() uint64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportOrdersStreamRequest) GetOrder() *AcceptOrderRequest {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ImportOrdersStreamRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           uint64                 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Result        *OrderResult           `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_orders_v2_contract_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportRowResult) GetRow() uint64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetResult() *OrderResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StartImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*AcceptOrderRequest  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartImportRequest) Reset() {
+	*x = StartImportRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartImportRequest) ProtoMessage() {}
+
+func (x *StartImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartImportRequest.ProtoReflect.Descriptor instead.
+func (*StartImportRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartImportRequest) GetOrders() []*AcceptOrderRequest {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type ImportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobRequest) Reset() {
+	*x = ImportJobRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobRequest) ProtoMessage() {}
+
+func (x *ImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobRequest.ProtoReflect.Descriptor instead.
+func (*ImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportJobRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type ImportJobFailure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// номер строки в задаче, начиная с 1, в порядке заказов запроса
+	Row           uint64 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	OrderId       uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ErrorCode     int64  `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobFailure) Reset() {
+	*x = ImportJobFailure{}
+	mi := &file_orders_v2_contract_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobFailure) ProtoMessage() {}
+
+func (x *ImportJobFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobFailure.ProtoReflect.Descriptor instead.
+func (*ImportJobFailure) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportJobFailure) GetRow() uint64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportJobFailure) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ImportJobFailure) GetErrorCode() int64 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *ImportJobFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportJob struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	JobId  uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status ImportJobStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=orders.v2.ImportJobStatus" json:"status,omitempty"`
+	Total  uint64                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// сколько строк уже обработано; после перезапуска задача продолжается со следующей
+	Processed uint64              `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+	Imported  uint64              `protobuf:"varint,5,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed    uint64              `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Failures  []*ImportJobFailure `protobuf:"bytes,7,rep,name=failures,proto3" json:"failures,omitempty"`
+	// причина, по которой задача остановилась со статусом FAILED
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
+	mi := &file_orders_v2_contract_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJob) ProtoMessage() {}
+
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportJob) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ImportJob) GetStatus() ImportJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportJobStatus_IMPORT_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ImportJob) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportJob) GetProcessed() uint64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetImported() uint64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportJob) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetFailures() []*ImportJobFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetHistoryRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Pagination *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// next_page_token из предыдущего ответа; если задан, pagination.page не учитывается
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// размер страницы; если не задан, берется pagination.count_on_page
+	PageSize      uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetHistoryRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type OrderHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistoryRequest) Reset() {
+	*x = OrderHistoryRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistoryRequest) ProtoMessage() {}
+
+func (x *OrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*OrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OrderHistoryRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type OrderHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*OrderHistory        `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistoryResponse) Reset() {
+	*x = OrderHistoryResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistoryResponse) ProtoMessage() {}
+
+func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*OrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrderHistoryResponse) GetHistory() []*OrderHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type OrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        OrderStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=orders.v2.OrderStatus" json:"status,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderResponse) Reset() {
+	*x = OrderResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderResponse) ProtoMessage() {}
+
+func (x *OrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
+func (*OrderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OrderResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ProcessResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Processed []uint64               `protobuf:"varint,1,rep,packed,name=processed,proto3" json:"processed,omitempty"`
+	Errors    []uint64               `protobuf:"varint,2,rep,packed,name=errors,proto3" json:"errors,omitempty"`
+	// плата за хранение сверх бесплатного срока по выданным заказам
+	StorageFees            []*StorageFee `protobuf:"bytes,3,rep,name=storage_fees,json=storageFees,proto3" json:"storage_fees,omitempty"`
+	TotalStorageFeeKopecks int64         `protobuf:"varint,4,opt,name=total_storage_fee_kopecks,json=totalStorageFeeKopecks,proto3" json:"total_storage_fee_kopecks,omitempty"`
+	// итог по каждому заказу в порядке запроса
+	Results       []*OrderResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResult) Reset() {
+	*x = ProcessResult{}
+	mi := &file_orders_v2_contract_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResult) ProtoMessage() {}
+
+func (x *ProcessResult) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResult.ProtoReflect.Descriptor instead.
+func (*ProcessResult) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProcessResult) GetProcessed() []uint64 {
+	if x != nil {
+		return x.Processed
+	}
+	return nil
+}
+
+func (x *ProcessResult) GetErrors() []uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ProcessResult) GetStorageFees() []*StorageFee {
+	if x != nil {
+		return x.StorageFees
+	}
+	return nil
+}
+
+func (x *ProcessResult) GetTotalStorageFeeKopecks() int64 {
+	if x != nil {
+		return x.TotalStorageFeeKopecks
+	}
+	return 0
+}
+
+func (x *ProcessResult) GetResults() []*OrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// OrderResult — итог обработки одного заказа в пакетной операции
+type OrderResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// код доменной ошибки; 0 — заказ обработан
+	ErrorCode int64  `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// статус заказа после операции; не задан, если заказ не удалось прочитать
+	Status        *OrderStatus `protobuf:"varint,4,opt,name=status,proto3,enum=orders.v2.OrderStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderResult) Reset() {
+	*x = OrderResult{}
+	mi := &file_orders_v2_contract_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderResult) ProtoMessage() {}
+
+func (x *OrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderResult.ProtoReflect.Descriptor instead.
+func (*OrderResult) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OrderResult) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderResult) GetErrorCode() int64 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *OrderResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OrderResult) GetStatus() OrderStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+type StorageFee struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PaidDays         uint32                 `protobuf:"varint,2,opt,name=paid_days,json=paidDays,proto3" json:"paid_days,omitempty"`
+	DailyRateKopecks int64                  `protobuf:"varint,3,opt,name=daily_rate_kopecks,json=dailyRateKopecks,proto3" json:"daily_rate_kopecks,omitempty"`
+	AmountKopecks    int64                  `protobuf:"varint,4,opt,name=amount_kopecks,json=amountKopecks,proto3" json:"amount_kopecks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StorageFee) Reset() {
+	*x = StorageFee{}
+	mi := &file_orders_v2_contract_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageFee) ProtoMessage() {}
+
+func (x *StorageFee) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageFee.ProtoReflect.Descriptor instead.
+func (*StorageFee) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StorageFee) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *StorageFee) GetPaidDays() uint32 {
+	if x != nil {
+		return x.PaidDays
+	}
+	return 0
+}
+
+func (x *StorageFee) GetDailyRateKopecks() int64 {
+	if x != nil {
+		return x.DailyRateKopecks
+	}
+	return 0
+}
+
+func (x *StorageFee) GetAmountKopecks() int64 {
+	if x != nil {
+		return x.AmountKopecks
+	}
+	return 0
+}
+
+type OrdersList struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total  int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// токен следующей страницы; пуст на последней странице
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrdersList) Reset() {
+	*x = OrdersList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrdersList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdersList) ProtoMessage() {}
+
+func (x *OrdersList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdersList.ProtoReflect.Descriptor instead.
+func (*OrdersList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrdersList) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *OrdersList) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrdersList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ReturnsList struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Returns []*Order               `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	Total   int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// токен следующей страницы; пуст на последней странице
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnsList) Reset() {
+	*x = ReturnsList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnsList) ProtoMessage() {}
+
+func (x *ReturnsList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnsList.ProtoReflect.Descriptor instead.
+func (*ReturnsList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReturnsList) GetReturns() []*Order {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+func (x *ReturnsList) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReturnsList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type OrderHistoryList struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	History []*OrderHistory        `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	Total   int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// токен следующей страницы; пуст на последней странице
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistoryList) Reset() {
+	*x = OrderHistoryList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistoryList) ProtoMessage() {}
+
+func (x *OrderHistoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistoryList.ProtoReflect.Descriptor instead.
+func (*OrderHistoryList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OrderHistoryList) GetHistory() []*OrderHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *OrderHistoryList) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrderHistoryList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ImportResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Imported int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Errors   []uint64               `protobuf:"varint,2,rep,packed,name=errors,proto3" json:"errors,omitempty"`
+	// итог по каждому заказу в порядке запроса
+	Results []*OrderResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	// заказы только проверены: imported — сколько заказов прошли бы импорт
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	mi := &file_orders_v2_contract_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportResult) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportResult) GetErrors() []uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportResult) GetResults() []*OrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportResult) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type Order struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrderId           uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId            uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status            OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=orders.v2.OrderStatus" json:"status,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	WeightGrams       int64                  `protobuf:"varint,5,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	TotalPriceKopecks int64                  `protobuf:"varint,6,opt,name=total_price_kopecks,json=totalPriceKopecks,proto3" json:"total_price_kopecks,omitempty"`
+	Package           *PackageType           `protobuf:"varint,7,opt,name=package,proto3,enum=orders.v2.PackageType,oneof" json:"package,omitempty"`
+	PvzId             uint64                 `protobuf:"varint,8,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	CellCode          string                 `protobuf:"bytes,9,opt,name=cell_code,json=cellCode,proto3" json:"cell_code,omitempty"`
+	SellerId          uint64                 `protobuf:"varint,10,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	PackageCode       string                 `protobuf:"bytes,11,opt,name=package_code,json=packageCode,proto3" json:"package_code,omitempty"`
+	CashOnDelivery    bool                   `protobuf:"varint,12,opt,name=cash_on_delivery,json=cashOnDelivery,proto3" json:"cash_on_delivery,omitempty"`
+	PaymentStatus     PaymentStatus          `protobuf:"varint,13,opt,name=payment_status,json=paymentStatus,proto3,enum=orders.v2.PaymentStatus" json:"payment_status,omitempty"`
+	ShipmentId        string                 `protobuf:"bytes,14,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_orders_v2_contract_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Order) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Order) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Order) GetWeightGrams() int64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *Order) GetTotalPriceKopecks() int64 {
+	if x != nil {
+		return x.TotalPriceKopecks
+	}
+	return 0
+}
+
+func (x *Order) GetPackage() PackageType {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
+}
+
+func (x *Order) GetPvzId() uint64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
+
+func (x *Order) GetCellCode() string {
+	if x != nil {
+		return x.CellCode
+	}
+	return ""
+}
+
+func (x *Order) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *Order) GetPackageCode() string {
+	if x != nil {
+		return x.PackageCode
+	}
+	return ""
+}
+
+func (x *Order) GetCashOnDelivery() bool {
+	if x != nil {
+		return x.CashOnDelivery
+	}
+	return false
+}
+
+func (x *Order) GetPaymentStatus() PaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+type ConfirmPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPaymentRequest) Reset() {
+	*x = ConfirmPaymentRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPaymentRequest) ProtoMessage() {}
+
+func (x *ConfirmPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ConfirmPaymentRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type OrderHistory struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status    OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=orders.v2.OrderStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PvzId     uint64                 `protobuf:"varint,4,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	// статус до перехода; не задан у первой записи заказа
+	PrevStatus *OrderStatus `protobuf:"varint,5,opt,name=prev_status,json=prevStatus,proto3,enum=orders.v2.OrderStatus,oneof" json:"prev_status,omitempty"`
+	Actor      *Actor       `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	// код причины: accepted, issued, client_refusal, storage_expired, client_return и т.д.
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment       string `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistory) Reset() {
+	*x = OrderHistory{}
+	mi := &file_orders_v2_contract_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistory) ProtoMessage() {}
+
+func (x *OrderHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistory.ProtoReflect.Descriptor instead.
+func (*OrderHistory) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrderHistory) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderHistory) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderHistory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderHistory) GetPvzId() uint64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
+
+func (x *OrderHistory) GetPrevStatus() OrderStatus {
+	if x != nil && x.PrevStatus != nil {
+		return *x.PrevStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderHistory) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *OrderHistory) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderHistory) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type Actor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// courier, client, operator или system
+	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id            uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Actor) Reset() {
+	*x = Actor{}
+	mi := &file_orders_v2_contract_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Actor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Actor) ProtoMessage() {}
+
+func (x *Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
+func (*Actor) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Actor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Actor) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAllowedActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedActionsRequest) Reset() {
+	*x = GetAllowedActionsRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedActionsRequest) ProtoMessage() {}
+
+func (x *GetAllowedActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowedActionsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAllowedActionsRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type AllowedActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=orders.v2.OrderStatus" json:"status,omitempty"`
+	Actions       []OrderAction          `protobuf:"varint,3,rep,packed,name=actions,proto3,enum=orders.v2.OrderAction" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllowedActionsResponse) Reset() {
+	*x = AllowedActionsResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowedActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedActionsResponse) ProtoMessage() {}
+
+func (x *AllowedActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedActionsResponse.ProtoReflect.Descriptor instead.
+func (*AllowedActionsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AllowedActionsResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AllowedActionsResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *AllowedActionsResponse) GetActions() []OrderAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type ExtendStorageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Days          uint32                 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendStorageRequest) Reset() {
+	*x = ExtendStorageRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendStorageRequest) ProtoMessage() {}
+
+func (x *ExtendStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendStorageRequest.ProtoReflect.Descriptor instead.
+func (*ExtendStorageRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExtendStorageRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ExtendStorageRequest) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type ExtendStorageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	FeeKopecks    int64                  `protobuf:"varint,2,opt,name=fee_kopecks,json=feeKopecks,proto3" json:"fee_kopecks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendStorageResponse) Reset() {
+	*x = ExtendStorageResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendStorageResponse) ProtoMessage() {}
+
+func (x *ExtendStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendStorageResponse.ProtoReflect.Descriptor instead.
+func (*ExtendStorageResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExtendStorageResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ExtendStorageResponse) GetFeeKopecks() int64 {
+	if x != nil {
+		return x.FeeKopecks
+	}
+	return 0
+}
+
+type MoveOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CellCode      string                 `protobuf:"bytes,2,opt,name=cell_code,json=cellCode,proto3" json:"cell_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveOrderRequest) Reset() {
+	*x = MoveOrderRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveOrderRequest) ProtoMessage() {}
+
+func (x *MoveOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveOrderRequest.ProtoReflect.Descriptor instead.
+func (*MoveOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MoveOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *MoveOrderRequest) GetCellCode() string {
+	if x != nil {
+		return x.CellCode
+	}
+	return ""
+}
+
+type CreateStorageCellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Size          CellSize               `protobuf:"varint,2,opt,name=size,proto3,enum=orders.v2.CellSize" json:"size,omitempty"`
+	Capacity      uint32                 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStorageCellRequest) Reset() {
+	*x = CreateStorageCellRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStorageCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStorageCellRequest) ProtoMessage() {}
+
+func (x *CreateStorageCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStorageCellRequest.ProtoReflect.Descriptor instead.
+func (*CreateStorageCellRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateStorageCellRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateStorageCellRequest) GetSize() CellSize {
+	if x != nil {
+		return x.Size
+	}
+	return CellSize_CELL_SIZE_UNSPECIFIED
+}
+
+func (x *CreateStorageCellRequest) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type ListStorageCellsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageCellsRequest) Reset() {
+	*x = ListStorageCellsRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageCellsRequest) ProtoMessage() {}
+
+func (x *ListStorageCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageCellsRequest.ProtoReflect.Descriptor instead.
+func (*ListStorageCellsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{35}
+}
+
+type StorageCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Size          CellSize               `protobuf:"varint,3,opt,name=size,proto3,enum=orders.v2.CellSize" json:"size,omitempty"`
+	Capacity      uint32                 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Occupied      uint32                 `protobuf:"varint,5,opt,name=occupied,proto3" json:"occupied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageCell) Reset() {
+	*x = StorageCell{}
+	mi := &file_orders_v2_contract_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCell) ProtoMessage() {}
+
+func (x *StorageCell) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCell.ProtoReflect.Descriptor instead.
+func (*StorageCell) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StorageCell) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorageCell) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StorageCell) GetSize() CellSize {
+	if x != nil {
+		return x.Size
+	}
+	return CellSize_CELL_SIZE_UNSPECIFIED
+}
+
+func (x *StorageCell) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *StorageCell) GetOccupied() uint32 {
+	if x != nil {
+		return x.Occupied
+	}
+	return 0
+}
+
+type StorageCellsList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*StorageCell         `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageCellsList) Reset() {
+	*x = StorageCellsList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageCellsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCellsList) ProtoMessage() {}
+
+func (x *StorageCellsList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCellsList.ProtoReflect.Descriptor instead.
+func (*StorageCellsList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StorageCellsList) GetCells() []*StorageCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type SetReturnPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Package       *PackageType           `protobuf:"varint,2,opt,name=package,proto3,enum=orders.v2.PackageType,oneof" json:"package,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	WindowHours   uint32                 `protobuf:"varint,4,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	Returnable    bool                   `protobuf:"varint,5,opt,name=returnable,proto3" json:"returnable,omitempty"`
+	PackageCode   *string                `protobuf:"bytes,6,opt,name=package_code,json=packageCode,proto3,oneof" json:"package_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReturnPolicyRequest) Reset() {
+	*x = SetReturnPolicyRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReturnPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReturnPolicyRequest) ProtoMessage() {}
+
+func (x *SetReturnPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReturnPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetReturnPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetReturnPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetReturnPolicyRequest) GetPackage() PackageType {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
+}
+
+func (x *SetReturnPolicyRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *SetReturnPolicyRequest) GetWindowHours() uint32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *SetReturnPolicyRequest) GetReturnable() bool {
+	if x != nil {
+		return x.Returnable
+	}
+	return false
+}
+
+func (x *SetReturnPolicyRequest) GetPackageCode() string {
+	if x != nil && x.PackageCode != nil {
+		return *x.PackageCode
+	}
+	return ""
+}
+
+type ListReturnPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnPoliciesRequest) Reset() {
+	*x = ListReturnPoliciesRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnPoliciesRequest) ProtoMessage() {}
+
+func (x *ListReturnPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{39}
+}
+
+type ReturnPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Package       *PackageType           `protobuf:"varint,3,opt,name=package,proto3,enum=orders.v2.PackageType,oneof" json:"package,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	WindowHours   uint32                 `protobuf:"varint,5,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	Returnable    bool                   `protobuf:"varint,6,opt,name=returnable,proto3" json:"returnable,omitempty"`
+	PackageCode   string                 `protobuf:"bytes,7,opt,name=package_code,json=packageCode,proto3" json:"package_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnPolicy) Reset() {
+	*x = ReturnPolicy{}
+	mi := &file_orders_v2_contract_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnPolicy) ProtoMessage() {}
+
+func (x *ReturnPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnPolicy.ProtoReflect.Descriptor instead.
+func (*ReturnPolicy) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReturnPolicy) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReturnPolicy) GetPackage() PackageType {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
+}
+
+func (x *ReturnPolicy) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetWindowHours() uint32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetReturnable() bool {
+	if x != nil {
+		return x.Returnable
+	}
+	return false
+}
+
+func (x *ReturnPolicy) GetPackageCode() string {
+	if x != nil {
+		return x.PackageCode
+	}
+	return ""
+}
+
+type ReturnPoliciesList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*ReturnPolicy        `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnPoliciesList) Reset() {
+	*x = ReturnPoliciesList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnPoliciesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnPoliciesList) ProtoMessage() {}
+
+func (x *ReturnPoliciesList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnPoliciesList.ProtoReflect.Descriptor instead.
+func (*ReturnPoliciesList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReturnPoliciesList) GetPolicies() []*ReturnPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type CreatePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupPointRequest) Reset() {
+	*x = CreatePickupPointRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupPointRequest) ProtoMessage() {}
+
+func (x *CreatePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*CreatePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePickupPointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePickupPointRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ListPickupPointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupPointsRequest) Reset() {
+	*x = ListPickupPointsRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupPointsRequest) ProtoMessage() {}
+
+func (x *ListPickupPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupPointsRequest.ProtoReflect.Descriptor instead.
+func (*ListPickupPointsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{43}
+}
+
+type PickupPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPoint) Reset() {
+	*x = PickupPoint{}
+	mi := &file_orders_v2_contract_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPoint) ProtoMessage() {}
+
+func (x *PickupPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPoint.ProtoReflect.Descriptor instead.
+func (*PickupPoint) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PickupPoint) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PickupPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PickupPointsList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*PickupPoint         `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointsList) Reset() {
+	*x = PickupPointsList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointsList) ProtoMessage() {}
+
+func (x *PickupPointsList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointsList.ProtoReflect.Descriptor instead.
+func (*PickupPointsList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PickupPointsList) GetPoints() []*PickupPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type PackageTypeDefinition struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Kind           PackageKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=orders.v2.PackageKind" json:"kind,omitempty"`
+	MaxWeightGrams int64                  `protobuf:"varint,3,opt,name=max_weight_grams,json=maxWeightGrams,proto3" json:"max_weight_grams,omitempty"`
+	PriceKopecks   int64                  `protobuf:"varint,4,opt,name=price_kopecks,json=priceKopecks,proto3" json:"price_kopecks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PackageTypeDefinition) Reset() {
+	*x = PackageTypeDefinition{}
+	mi := &file_orders_v2_contract_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageTypeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageTypeDefinition) ProtoMessage() {}
+
+func (x *PackageTypeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageTypeDefinition.ProtoReflect.Descriptor instead.
+func (*PackageTypeDefinition) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PackageTypeDefinition) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PackageTypeDefinition) GetKind() PackageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PackageKind_PACKAGE_KIND_UNSPECIFIED
+}
+
+func (x *PackageTypeDefinition) GetMaxWeightGrams() int64 {
+	if x != nil {
+		return x.MaxWeightGrams
+	}
+	return 0
+}
+
+func (x *PackageTypeDefinition) GetPriceKopecks() int64 {
+	if x != nil {
+		return x.PriceKopecks
+	}
+	return 0
+}
+
+type CreatePackageTypeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Kind           PackageKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=orders.v2.PackageKind" json:"kind,omitempty"`
+	MaxWeightGrams int64                  `protobuf:"varint,3,opt,name=max_weight_grams,json=maxWeightGrams,proto3" json:"max_weight_grams,omitempty"`
+	PriceKopecks   int64                  `protobuf:"varint,4,opt,name=price_kopecks,json=priceKopecks,proto3" json:"price_kopecks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePackageTypeRequest) Reset() {
+	*x = CreatePackageTypeRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePackageTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageTypeRequest) ProtoMessage() {}
+
+func (x *CreatePackageTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackageTypeRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreatePackageTypeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePackageTypeRequest) GetKind() PackageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PackageKind_PACKAGE_KIND_UNSPECIFIED
+}
+
+func (x *CreatePackageTypeRequest) GetMaxWeightGrams() int64 {
+	if x != nil {
+		return x.MaxWeightGrams
+	}
+	return 0
+}
+
+func (x *CreatePackageTypeRequest) GetPriceKopecks() int64 {
+	if x != nil {
+		return x.PriceKopecks
+	}
+	return 0
+}
+
+type UpdatePackageTypeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Kind           PackageKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=orders.v2.PackageKind" json:"kind,omitempty"`
+	MaxWeightGrams int64                  `protobuf:"varint,3,opt,name=max_weight_grams,json=maxWeightGrams,proto3" json:"max_weight_grams,omitempty"`
+	PriceKopecks   int64                  `protobuf:"varint,4,opt,name=price_kopecks,json=priceKopecks,proto3" json:"price_kopecks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePackageTypeRequest) Reset() {
+	*x = UpdatePackageTypeRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePackageTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePackageTypeRequest) ProtoMessage() {}
+
+func (x *UpdatePackageTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePackageTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePackageTypeRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdatePackageTypeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdatePackageTypeRequest) GetKind() PackageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PackageKind_PACKAGE_KIND_UNSPECIFIED
+}
+
+func (x *UpdatePackageTypeRequest) GetMaxWeightGrams() int64 {
+	if x != nil {
+		return x.MaxWeightGrams
+	}
+	return 0
+}
+
+func (x *UpdatePackageTypeRequest) GetPriceKopecks() int64 {
+	if x != nil {
+		return x.PriceKopecks
+	}
+	return 0
+}
+
+type DeletePackageTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePackageTypeRequest) Reset() {
+	*x = DeletePackageTypeRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePackageTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePackageTypeRequest) ProtoMessage() {}
+
+func (x *DeletePackageTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePackageTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeletePackageTypeRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeletePackageTypeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeletePackageTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePackageTypeResponse) Reset() {
+	*x = DeletePackageTypeResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePackageTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePackageTypeResponse) ProtoMessage() {}
+
+func (x *DeletePackageTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePackageTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeletePackageTypeResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{50}
+}
+
+type ListPackageTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackageTypesRequest) Reset() {
+	*x = ListPackageTypesRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackageTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackageTypesRequest) ProtoMessage() {}
+
+func (x *ListPackageTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackageTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListPackageTypesRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{51}
+}
+
+type PackageTypesList struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	PackageTypes  []*PackageTypeDefinition `protobuf:"bytes,1,rep,name=package_types,json=packageTypes,proto3" json:"package_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackageTypesList) Reset() {
+	*x = PackageTypesList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageTypesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageTypesList) ProtoMessage() {}
+
+func (x *PackageTypesList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageTypesList.ProtoReflect.Descriptor instead.
+func (*PackageTypesList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PackageTypesList) GetPackageTypes() []*PackageTypeDefinition {
+	if x != nil {
+		return x.PackageTypes
+	}
+	return nil
+}
+
+type AnnounceOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	Orders        []*AcceptOrderRequest  `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnounceOrdersRequest) Reset() {
+	*x = AnnounceOrdersRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnounceOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceOrdersRequest) ProtoMessage() {}
+
+func (x *AnnounceOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceOrdersRequest.ProtoReflect.Descriptor instead.
+func (*AnnounceOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AnnounceOrdersRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *AnnounceOrdersRequest) GetOrders() []*AcceptOrderRequest {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type AnnounceOrdersResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Announced int32                  `protobuf:"varint,1,opt,name=announced,proto3" json:"announced,omitempty"`
+	Errors    []uint64               `protobuf:"varint,2,rep,packed,name=errors,proto3" json:"errors,omitempty"`
+	// итог по каждому заказу в порядке запроса
+	Results       []*OrderResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnounceOrdersResponse) Reset() {
+	*x = AnnounceOrdersResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnounceOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceOrdersResponse) ProtoMessage() {}
+
+func (x *AnnounceOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceOrdersResponse.ProtoReflect.Descriptor instead.
+func (*AnnounceOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AnnounceOrdersResponse) GetAnnounced() int32 {
+	if x != nil {
+		return x.Announced
+	}
+	return 0
+}
+
+func (x *AnnounceOrdersResponse) GetErrors() []uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *AnnounceOrdersResponse) GetResults() []*OrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ConfirmArrivalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	OrderIds      []uint64               `protobuf:"varint,2,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmArrivalRequest) Reset() {
+	*x = ConfirmArrivalRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmArrivalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmArrivalRequest) ProtoMessage() {}
+
+func (x *ConfirmArrivalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmArrivalRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmArrivalRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ConfirmArrivalRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *ConfirmArrivalRequest) GetOrderIds() []uint64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type Discrepancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Kind          DiscrepancyKind        `protobuf:"varint,3,opt,name=kind,proto3,enum=orders.v2.DiscrepancyKind" json:"kind,omitempty"`
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	PvzId         uint64                 `protobuf:"varint,5,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discrepancy) Reset() {
+	*x = Discrepancy{}
+	mi := &file_orders_v2_contract_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discrepancy) ProtoMessage() {}
+
+func (x *Discrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discrepancy.ProtoReflect.Descriptor instead.
+func (*Discrepancy) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Discrepancy) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Discrepancy) GetKind() DiscrepancyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscrepancyKind_DISCREPANCY_KIND_UNSPECIFIED
+}
+
+func (x *Discrepancy) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *Discrepancy) GetPvzId() uint64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
+
+type ArrivalReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	Confirmed     []uint64               `protobuf:"varint,2,rep,packed,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Discrepancies []*Discrepancy         `protobuf:"bytes,3,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	// итог по каждому заказу, который затронула приемка, включая неудачные
+	Results       []*OrderResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArrivalReport) Reset() {
+	*x = ArrivalReport{}
+	mi := &file_orders_v2_contract_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArrivalReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArrivalReport) ProtoMessage() {}
+
+func (x *ArrivalReport) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArrivalReport.ProtoReflect.Descriptor instead.
+func (*ArrivalReport) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ArrivalReport) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *ArrivalReport) GetConfirmed() []uint64 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return nil
+}
+
+func (x *ArrivalReport) GetDiscrepancies() []*Discrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *ArrivalReport) GetResults() []*OrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetDiscrepancyReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    *string                `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3,oneof" json:"shipment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiscrepancyReportRequest) Reset() {
+	*x = GetDiscrepancyReportRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscrepancyReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscrepancyReportRequest) ProtoMessage() {}
+
+func (x *GetDiscrepancyReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscrepancyReportRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscrepancyReportRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetDiscrepancyReportRequest) GetShipmentId() string {
+	if x != nil && x.ShipmentId != nil {
+		return *x.ShipmentId
+	}
+	return ""
+}
+
+type DiscrepancyReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discrepancies []*Discrepancy         `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscrepancyReport) Reset() {
+	*x = DiscrepancyReport{}
+	mi := &file_orders_v2_contract_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscrepancyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscrepancyReport) ProtoMessage() {}
+
+func (x *DiscrepancyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscrepancyReport.ProtoReflect.Descriptor instead.
+func (*DiscrepancyReport) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DiscrepancyReport) GetDiscrepancies() []*Discrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type ReturnManifestItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrderId     uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId      uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CellCode    string                 `protobuf:"bytes,3,opt,name=cell_code,json=cellCode,proto3" json:"cell_code,omitempty"`
+	WeightGrams int64                  `protobuf:"varint,4,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// storage_expired или client_return
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnManifestItem) Reset() {
+	*x = ReturnManifestItem{}
+	mi := &file_orders_v2_contract_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnManifestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnManifestItem) ProtoMessage() {}
+
+func (x *ReturnManifestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnManifestItem.ProtoReflect.Descriptor instead.
+func (*ReturnManifestItem) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReturnManifestItem) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReturnManifestItem) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReturnManifestItem) GetCellCode() string {
+	if x != nil {
+		return x.CellCode
+	}
+	return ""
+}
+
+func (x *ReturnManifestItem) GetWeightGrams() int64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *ReturnManifestItem) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ReturnManifestItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReturnManifest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ManifestId    uint64                 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	PvzId         uint64                 `protobuf:"varint,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        ManifestStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=orders.v2.ManifestStatus" json:"status,omitempty"`
+	Items         []*ReturnManifestItem  `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	HandedOverAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=handed_over_at,json=handedOverAt,proto3,oneof" json:"handed_over_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnManifest) Reset() {
+	*x = ReturnManifest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnManifest) ProtoMessage() {}
+
+func (x *ReturnManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnManifest.ProtoReflect.Descriptor instead.
+func (*ReturnManifest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReturnManifest) GetManifestId() uint64 {
+	if x != nil {
+		return x.ManifestId
+	}
+	return 0
+}
+
+func (x *ReturnManifest) GetPvzId() uint64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
+
+func (x *ReturnManifest) GetStatus() ManifestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ManifestStatus_MANIFEST_STATUS_UNSPECIFIED
+}
+
+func (x *ReturnManifest) GetItems() []*ReturnManifestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReturnManifest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReturnManifest) GetHandedOverAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HandedOverAt
+	}
+	return nil
+}
+
+type SweepExpiredOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SweepExpiredOrdersRequest) Reset() {
+	*x = SweepExpiredOrdersRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SweepExpiredOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepExpiredOrdersRequest) ProtoMessage() {}
+
+func (x *SweepExpiredOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepExpiredOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SweepExpiredOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{62}
+}
+
+type ListReturnManifestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnManifestsRequest) Reset() {
+	*x = ListReturnManifestsRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnManifestsRequest) ProtoMessage() {}
+
+func (x *ListReturnManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{63}
+}
+
+type ReturnManifestsList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifests     []*ReturnManifest      `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnManifestsList) Reset() {
+	*x = ReturnManifestsList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnManifestsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnManifestsList) ProtoMessage() {}
+
+func (x *ReturnManifestsList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnManifestsList.ProtoReflect.Descriptor instead.
+func (*ReturnManifestsList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReturnManifestsList) GetManifests() []*ReturnManifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+type ReturnManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ManifestId    uint64                 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnManifestRequest) Reset() {
+	*x = ReturnManifestRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnManifestRequest) ProtoMessage() {}
+
+func (x *ReturnManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnManifestRequest.ProtoReflect.Descriptor instead.
+func (*ReturnManifestRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReturnManifestRequest) GetManifestId() uint64 {
+	if x != nil {
+		return x.ManifestId
+	}
+	return 0
+}
+
+type ExportReturnManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ManifestId    uint64                 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReturnManifestRequest) Reset() {
+	*x = ExportReturnManifestRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReturnManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReturnManifestRequest) ProtoMessage() {}
+
+func (x *ExportReturnManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReturnManifestRequest.ProtoReflect.Descriptor instead.
+func (*ExportReturnManifestRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportReturnManifestRequest) GetManifestId() uint64 {
+	if x != nil {
+		return x.ManifestId
+	}
+	return 0
+}
+
+func (x *ExportReturnManifestRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportReturnManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReturnManifestResponse) Reset() {
+	*x = ExportReturnManifestResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReturnManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReturnManifestResponse) ProtoMessage() {}
+
+func (x *ExportReturnManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReturnManifestResponse.ProtoReflect.Descriptor instead.
+func (*ExportReturnManifestResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExportReturnManifestResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportReturnManifestResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type Receiver struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receiver) Reset() {
+	*x = Receiver{}
+	mi := &file_orders_v2_contract_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receiver) ProtoMessage() {}
+
+func (x *Receiver) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receiver.ProtoReflect.Descriptor instead.
+func (*Receiver) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Receiver) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Receiver) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Receiver) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Receiver) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Receiver) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UpsertReceiverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertReceiverRequest) Reset() {
+	*x = UpsertReceiverRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertReceiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertReceiverRequest) ProtoMessage() {}
+
+func (x *UpsertReceiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertReceiverRequest.ProtoReflect.Descriptor instead.
+func (*UpsertReceiverRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpsertReceiverRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpsertReceiverRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpsertReceiverRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SearchReceiversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchReceiversRequest) Reset() {
+	*x = SearchReceiversRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReceiversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReceiversRequest) ProtoMessage() {}
+
+func (x *SearchReceiversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReceiversRequest.ProtoReflect.Descriptor instead.
+func (*SearchReceiversRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SearchReceiversRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchReceiversRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReceiversList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receivers     []*Receiver            `protobuf:"bytes,1,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiversList) Reset() {
+	*x = ReceiversList{}
+	mi := &file_orders_v2_contract_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiversList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiversList) ProtoMessage() {}
+
+func (x *ReceiversList) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiversList.ProtoReflect.Descriptor instead.
+func (*ReceiversList) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ReceiversList) GetReceivers() []*Receiver {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+type AuditRecord struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Method string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	PvzId  uint64                 `protobuf:"varint,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Actor  *Actor                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Sender string                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Peer   string                 `protobuf:"bytes,6,opt,name=peer,proto3" json:"peer,omitempty"`
+	// тело запроса в JSON без кодов выдачи
+	Payload  string   `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	OrderIds []uint64 `protobuf:"varint,8,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	// gRPC-код результата: OK, InvalidArgument и т.д.
+	ResultCode    string                 `protobuf:"bytes,9,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PrevHash      string                 `protobuf:"bytes,12,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash          string                 `protobuf:"bytes,13,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_orders_v2_contract_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AuditRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetPvzId() uint64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *AuditRecord) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetOrderIds() []uint64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *AuditRecord) GetResultCode() string {
+	if x != nil {
+		return x.ResultCode
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditRecord) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type SearchAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorType     *string                `protobuf:"bytes,1,opt,name=actor_type,json=actorType,proto3,oneof" json:"actor_type,omitempty"`
+	ActorId       uint64                 `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         uint32                 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAuditLogRequest) Reset() {
+	*x = SearchAuditLogRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditLogRequest) ProtoMessage() {}
+
+func (x *SearchAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SearchAuditLogRequest) GetActorType() string {
+	if x != nil && x.ActorType != nil {
+		return *x.ActorType
+	}
+	return ""
+}
+
+func (x *SearchAuditLogRequest) GetActorId() uint64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SearchAuditLogRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *SearchAuditLogRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchAuditLogRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SearchAuditLogRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AuditRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_orders_v2_contract_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AuditLog) GetRecords() []*AuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type VerifyAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogRequest) Reset() {
+	*x = VerifyAuditLogRequest{}
+	mi := &file_orders_v2_contract_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogRequest) ProtoMessage() {}
+
+func (x *VerifyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{75}
+}
+
+type VerifyAuditLogResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Valid   bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Checked uint64                 `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// первая запись, на которой цепочка разорвана; 0, если журнал цел
+	BrokenRecordId uint64 `protobuf:"varint,3,opt,name=broken_record_id,json=brokenRecordId,proto3" json:"broken_record_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	mi := &file_orders_v2_contract_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogResponse) ProtoMessage() {}
+
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v2_contract_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v2_contract_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *VerifyAuditLogResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditLogResponse) GetChecked() uint64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetBrokenRecordId() uint64 {
+	if x != nil {
+		return x.BrokenRecordId
+	}
+	return 0
+}
+
+var File_orders_v2_contract_proto protoreflect.FileDescriptor
+
+const file_orders_v2_contract_proto_rawDesc = "" +
+	"\n" +
+	"\x18orders/v2/contract.proto\x12\torders.v2\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf7\x03\n" +
+	"\x12AcceptOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x12E\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\n" +
+	"\xfaB\a\xb2\x01\x04\b\x01@\x01R\texpiresAt\x125\n" +
+	"\apackage\x18\x04 \x01(\x0e2\x16.orders.v2.PackageTypeH\x00R\apackage\x88\x01\x01\x12*\n" +
+	"\fweight_grams\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vweightGrams\x12,\n" +
+	"\rprice_kopecks\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\fpriceKopecks\x12 \n" +
+	"\tseller_id\x18\a \x01(\x04H\x01R\bsellerId\x88\x01\x01\x12L\n" +
+	"\fpackage_code\x18\b \x01(\tB$\xfaB!r\x1f2\x1d^[a-z0-9_-]+(\\+[a-z0-9_-]+)*$H\x02R\vpackageCode\x88\x01\x01\x12(\n" +
+	"\x10cash_on_delivery\x18\t \x01(\bR\x0ecashOnDeliveryB\n" +
+	"\n" +
+	"\b_packageB\f\n" +
+	"\n" +
+	"_seller_idB\x0f\n" +
+	"\r_package_code\"4\n" +
+	"\x0eOrderIdRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"\x81\x02\n" +
+	"\x14ProcessOrdersRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x129\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x15.orders.v2.ActionTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06action\x12+\n" +
+	"\torder_ids\x18\x03 \x03(\x04B\x0e\xfaB\v\x92\x01\b\b\x01\"\x042\x02 \x00R\borderIds\x127\n" +
+	"\vpickup_code\x18\x04 \x01(\tB\x11\xfaB\x0er\f2\n" +
+	"^[0-9]{6}$H\x00R\n" +
+	"pickupCode\x88\x01\x01\x12\x16\n" +
+	"\x06atomic\x18\x05 \x01(\bR\x06atomicB\x0e\n" +
+	"\f_pickup_code\"\xb2\x02\n" +
+	"\x11ListOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06in_pvz\x18\x02 \x01(\bR\x05inPvz\x12#\n" +
+	"\x06last_n\x18\x03 \x01(\rB\a\xfaB\x04*\x02 \x00H\x00R\x05lastN\x88\x01\x01\x12:\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x15.orders.v2.PaginationH\x01R\n" +
+	"pagination\x88\x01\x01\x12\"\n" +
+	"\x05phone\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x02R\x05phone\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\a \x01(\rB\b\xfaB\x05*\x03\x18\xe8\aR\bpageSizeB\t\n" +
+	"\a_last_nB\r\n" +
+	"\v_paginationB\b\n" +
+	"\x06_phone\"V\n" +
+	"\n" +
+	"Pagination\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\rB\a\xfaB\x04*\x02(\x00R\x04page\x12+\n" +
+	"\rcount_on_page\x18\x02 \x01(\rB\a\xfaB\x04*\x02 \x00R\vcountOnPage\"\x91\x01\n" +
+	"\x12ListReturnsRequest\x125\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x15.orders.v2.PaginationR\n" +
+	"pagination\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xfaB\x05*\x03\x18\xe8\aR\bpageSize\"\xfe\x02\n" +
+	"\x13ExportOrdersRequest\x12C\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x16.orders.v2.OrderStatusB\x0f\xfaB\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\bstatuses\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\"\n" +
+	"\x05phone\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\x05phone\x88\x01\x01\x12?\n" +
+	"\raccepted_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\facceptedFrom\x12;\n" +
+	"\vaccepted_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptedTo\x12L\n" +
+	"\fpackage_code\x18\x06 \x01(\tB$\xfaB!r\x1f2\x1d^[a-z0-9_-]+(\\+[a-z0-9_-]+)*$H\x01R\vpackageCode\x88\x01\x01B\b\n" +
+	"\x06_phoneB\x0f\n" +
+	"\r_package_code\"o\n" +
+	"\x13ImportOrdersRequest\x12?\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1d.orders.v2.AcceptOrderRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\x06orders\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x85\x01\n" +
+	"\x19ImportOrdersStreamRequest\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x04R\x03row\x12=\n" +
+	"\x05order\x18\x02 \x01(\v2\x1d.orders.v2.AcceptOrderRequestB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05order\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"S\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x04R\x03row\x12.\n" +
+	"\x06result\x18\x02 \x01(\v2\x16.orders.v2.OrderResultR\x06result\"U\n" +
+	"\x12StartImportRequest\x12?\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1d.orders.v2.AcceptOrderRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\x06orders\"2\n" +
+	"\x10ImportJobRequest\x12\x1e\n" +
+	"\x06job_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x05jobId\"x\n" +
+	"\x10ImportJobFailure\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x04R\x03row\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x04R\aorderId\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x03R\terrorCode\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x83\x03\n" +
+	"\tImportJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.orders.v2.ImportJobStatusR\x06status\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x04R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x04 \x01(\x04R\tprocessed\x12\x1a\n" +
+	"\bimported\x18\x05 \x01(\x04R\bimported\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x04R\x06failed\x127\n" +
+	"\bfailures\x18\a \x03(\v2\x1b.orders.v2.ImportJobFailureR\bfailures\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x90\x01\n" +
+	"\x11GetHistoryRequest\x125\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x15.orders.v2.PaginationR\n" +
+	"pagination\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xfaB\x05*\x03\x18\xe8\aR\bpageSize\"9\n" +
+	"\x13OrderHistoryRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"I\n" +
+	"\x14OrderHistoryResponse\x121\n" +
+	"\ahistory\x18\x01 \x03(\v2\x17.orders.v2.OrderHistoryR\ahistory\"Z\n" +
+	"\rOrderResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.orders.v2.OrderStatusR\x06status\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x04R\aorderId\"\xec\x01\n" +
+	"\rProcessResult\x12\x1c\n" +
+	"\tprocessed\x18\x01 \x03(\x04R\tprocessed\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\x04R\x06errors\x128\n" +
+	"\fstorage_fees\x18\x03 \x03(\v2\x15.orders.v2.StorageFeeR\vstorageFees\x129\n" +
+	"\x19total_storage_fee_kopecks\x18\x04 \x01(\x03R\x16totalStorageFeeKopecks\x120\n" +
+	"\aresults\x18\x05 \x03(\v2\x16.orders.v2.OrderResultR\aresults\"\xa1\x01\n" +
+	"\vOrderResult\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x02 \x01(\x03R\terrorCode\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.orders.v2.OrderStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x99\x01\n" +
+	"\n" +
+	"StorageFee\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x1b\n" +
+	"\tpaid_days\x18\x02 \x01(\rR\bpaidDays\x12,\n" +
+	"\x12daily_rate_kopecks\x18\x03 \x01(\x03R\x10dailyRateKopecks\x12%\n" +
+	"\x0eamount_kopecks\x18\x04 \x01(\x03R\ramountKopecks\"t\n" +
+	"\n" +
+	"OrdersList\x12(\n" +
+	"\x06orders\x18\x01 \x03(\v2\x10.orders.v2.OrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"w\n" +
+	"\vReturnsList\x12*\n" +
+	"\areturns\x18\x01 \x03(\v2\x10.orders.v2.OrderR\areturns\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x83\x01\n" +
+	"\x10OrderHistoryList\x121\n" +
+	"\ahistory\x18\x01 \x03(\v2\x17.orders.v2.OrderHistoryR\ahistory\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x8d\x01\n" +
+	"\fImportResult\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\x04R\x06errors\x120\n" +
+	"\aresults\x18\x03 \x03(\v2\x16.orders.v2.OrderResultR\aresults\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xbc\x04\n" +
+	"\x05Order\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.orders.v2.OrderStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
+	"\fweight_grams\x18\x05 \x01(\x03R\vweightGrams\x12.\n" +
+	"\x13total_price_kopecks\x18\x06 \x01(\x03R\x11totalPriceKopecks\x125\n" +
+	"\apackage\x18\a \x01(\x0e2\x16.orders.v2.PackageTypeH\x00R\apackage\x88\x01\x01\x12\x15\n" +
+	"\x06pvz_id\x18\b \x01(\x04R\x05pvzId\x12\x1b\n" +
+	"\tcell_code\x18\t \x01(\tR\bcellCode\x12\x1b\n" +
+	"\tseller_id\x18\n" +
+	" \x01(\x04R\bsellerId\x12!\n" +
+	"\fpackage_code\x18\v \x01(\tR\vpackageCode\x12(\n" +
+	"\x10cash_on_delivery\x18\f \x01(\bR\x0ecashOnDelivery\x12?\n" +
+	"\x0epayment_status\x18\r \x01(\x0e2\x18.orders.v2.PaymentStatusR\rpaymentStatus\x12\x1f\n" +
+	"\vshipment_id\x18\x0e \x01(\tR\n" +
+	"shipmentIdB\n" +
+	"\n" +
+	"\b_package\";\n" +
+	"\x15ConfirmPaymentRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"\xd3\x02\n" +
+	"\fOrderHistory\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.orders.v2.OrderStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x15\n" +
+	"\x06pvz_id\x18\x04 \x01(\x04R\x05pvzId\x12<\n" +
+	"\vprev_status\x18\x05 \x01(\x0e2\x16.orders.v2.OrderStatusH\x00R\n" +
+	"prevStatus\x88\x01\x01\x12&\n" +
+	"\x05actor\x18\x06 \x01(\v2\x10.orders.v2.ActorR\x05actor\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acommentB\x0e\n" +
+	"\f_prev_status\"+\n" +
+	"\x05Actor\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\">\n" +
+	"\x18GetAllowedActionsRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\"\x95\x01\n" +
+	"\x16AllowedActionsResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.orders.v2.OrderStatusR\x06status\x120\n" +
+	"\aactions\x18\x03 \x03(\x0e2\x16.orders.v2.OrderActionR\aactions\"W\n" +
+	"\x14ExtendStorageRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\x12\x1b\n" +
+	"\x04days\x18\x02 \x01(\rB\a\xfaB\x04*\x02 \x00R\x04days\"`\n" +
+	"\x15ExtendStorageResponse\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.orders.v2.OrderR\x05order\x12\x1f\n" +
+	"\vfee_kopecks\x18\x02 \x01(\x03R\n" +
+	"feeKopecks\"\\\n" +
+	"\x10MoveOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\aorderId\x12$\n" +
+	"\tcell_code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcellCode\"\x91\x01\n" +
+	"\x18CreateStorageCellRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\x123\n" +
+	"\x04size\x18\x02 \x01(\x0e2\x13.orders.v2.CellSizeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04size\x12#\n" +
+	"\bcapacity\x18\x03 \x01(\rB\a\xfaB\x04*\x02 \x00R\bcapacity\"\x19\n" +
+	"\x17ListStorageCellsRequest\"\x92\x01\n" +
+	"\vStorageCell\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12'\n" +
+	"\x04size\x18\x03 \x01(\x0e2\x13.orders.v2.CellSizeR\x04size\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\rR\bcapacity\x12\x1a\n" +
+	"\boccupied\x18\x05 \x01(\rR\boccupied\"@\n" +
+	"\x10StorageCellsList\x12,\n" +
+	"\x05cells\x18\x01 \x03(\v2\x16.orders.v2.StorageCellR\x05cells\"\xb7\x02\n" +
+	"\x16SetReturnPolicyRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x125\n" +
+	"\apackage\x18\x02 \x01(\x0e2\x16.orders.v2.PackageTypeH\x00R\apackage\x88\x01\x01\x12\x1b\n" +
+	"\tseller_id\x18\x03 \x01(\x04R\bsellerId\x12!\n" +
+	"\fwindow_hours\x18\x04 \x01(\rR\vwindowHours\x12\x1e\n" +
+	"\n" +
+	"returnable\x18\x05 \x01(\bR\n" +
+	"returnable\x12L\n" +
+	"\fpackage_code\x18\x06 \x01(\tB$\xfaB!r\x1f2\x1d^[a-z0-9_-]+(\\+[a-z0-9_-]+)*$H\x01R\vpackageCode\x88\x01\x01B\n" +
+	"\n" +
+	"\b_packageB\x0f\n" +
+	"\r_package_code\"\x1b\n" +
+	"\x19ListReturnPoliciesRequest\"\xf8\x01\n" +
+	"\fReturnPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\apackage\x18\x03 \x01(\x0e2\x16.orders.v2.PackageTypeH\x00R\apackage\x88\x01\x01\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\x04R\bsellerId\x12!\n" +
+	"\fwindow_hours\x18\x05 \x01(\rR\vwindowHours\x12\x1e\n" +
+	"\n" +
+	"returnable\x18\x06 \x01(\bR\n" +
+	"returnable\x12!\n" +
+	"\fpackage_code\x18\a \x01(\tR\vpackageCodeB\n" +
+	"\n" +
+	"\b_package\"I\n" +
+	"\x12ReturnPoliciesList\x123\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x17.orders.v2.ReturnPolicyR\bpolicies\"Q\n" +
+	"\x18CreatePickupPointRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x19\n" +
+	"\x17ListPickupPointsRequest\"\x86\x01\n" +
+	"\vPickupPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"B\n" +
+	"\x10PickupPointsList\x12.\n" +
+	"\x06points\x18\x01 \x03(\v2\x16.orders.v2.PickupPointR\x06points\"\xa6\x01\n" +
+	"\x15PackageTypeDefinition\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12*\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.orders.v2.PackageKindR\x04kind\x12(\n" +
+	"\x10max_weight_grams\x18\x03 \x01(\x03R\x0emaxWeightGrams\x12#\n" +
+	"\rprice_kopecks\x18\x04 \x01(\x03R\fpriceKopecks\"\xdd\x01\n" +
+	"\x18CreatePackageTypeRequest\x12(\n" +
+	"\x04code\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f2\r^[a-z0-9_-]+$R\x04code\x126\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.orders.v2.PackageKindB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04kind\x121\n" +
+	"\x10max_weight_grams\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0emaxWeightGrams\x12,\n" +
+	"\rprice_kopecks\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fpriceKopecks\"\xdd\x01\n" +
+	"\x18UpdatePackageTypeRequest\x12(\n" +
+	"\x04code\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f2\r^[a-z0-9_-]+$R\x04code\x126\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.orders.v2.PackageKindB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04kind\x121\n" +
+	"\x10max_weight_grams\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0emaxWeightGrams\x12,\n" +
+	"\rprice_kopecks\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fpriceKopecks\"7\n" +
+	"\x18DeletePackageTypeRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"\x1b\n" +
+	"\x19DeletePackageTypeResponse\"\x19\n" +
+	"\x17ListPackageTypesRequest\"Y\n" +
+	"\x10PackageTypesList\x12E\n" +
+	"\rpackage_types\x18\x01 \x03(\v2 .orders.v2.PackageTypeDefinitionR\fpackageTypes\"\x82\x01\n" +
+	"\x15AnnounceOrdersRequest\x12(\n" +
+	"\vshipment_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"shipmentId\x12?\n" +
+	"\x06orders\x18\x02 \x03(\v2\x1d.orders.v2.AcceptOrderRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\x06orders\"\x80\x01\n" +
+	"\x16AnnounceOrdersResponse\x12\x1c\n" +
+	"\tannounced\x18\x01 \x01(\x05R\tannounced\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\x04R\x06errors\x120\n" +
+	"\aresults\x18\x03 \x03(\v2\x16.orders.v2.OrderResultR\aresults\"l\n" +
+	"\x15ConfirmArrivalRequest\x12(\n" +
+	"\vshipment_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"shipmentId\x12)\n" +
+	"\torder_ids\x18\x02 \x03(\x04B\f\xfaB\t\x92\x01\x06\"\x042\x02 \x00R\borderIds\"\xcd\x01\n" +
+	"\vDiscrepancy\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\tR\n" +
+	"shipmentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x04R\aorderId\x12.\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1a.orders.v2.DiscrepancyKindR\x04kind\x12;\n" +
+	"\vdetected_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\x12\x15\n" +
+	"\x06pvz_id\x18\x05 \x01(\x04R\x05pvzId\"\xbe\x01\n" +
+	"\rArrivalReport\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\tR\n" +
+	"shipmentId\x12\x1c\n" +
+	"\tconfirmed\x18\x02 \x03(\x04R\tconfirmed\x12<\n" +
+	"\rdiscrepancies\x18\x03 \x03(\v2\x16.orders.v2.DiscrepancyR\rdiscrepancies\x120\n" +
+	"\aresults\x18\x04 \x03(\v2\x16.orders.v2.OrderResultR\aresults\"S\n" +
+	"\x1bGetDiscrepancyReportRequest\x12$\n" +
+	"\vshipment_id\x18\x01 \x01(\tH\x00R\n" +
+	"shipmentId\x88\x01\x01B\x0e\n" +
+	"\f_shipment_id\"Q\n" +
+	"\x11DiscrepancyReport\x12<\n" +
+	"\rdiscrepancies\x18\x01 \x03(\v2\x16.orders.v2.DiscrepancyR\rdiscrepancies\"\xdb\x01\n" +
+	"\x12ReturnManifestItem\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tcell_code\x18\x03 \x01(\tR\bcellCode\x12!\n" +
+	"\fweight_grams\x18\x04 \x01(\x03R\vweightGrams\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xc5\x02\n" +
+	"\x0eReturnManifest\x12\x1f\n" +
+	"\vmanifest_id\x18\x01 \x01(\x04R\n" +
+	"manifestId\x12\x15\n" +
+	"\x06pvz_id\x18\x02 \x01(\x04R\x05pvzId\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.orders.v2.ManifestStatusR\x06status\x123\n" +
+	"\x05items\x18\x04 \x03(\v2\x1d.orders.v2.ReturnManifestItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12E\n" +
+	"\x0ehanded_over_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\fhandedOverAt\x88\x01\x01B\x11\n" +
+	"\x0f_handed_over_at\"\x1b\n" +
+	"\x19SweepExpiredOrdersRequest\"\x1c\n" +
+	"\x1aListReturnManifestsRequest\"N\n" +
+	"\x13ReturnManifestsList\x127\n" +
+	"\tmanifests\x18\x01 \x03(\v2\x19.orders.v2.ReturnManifestR\tmanifests\"A\n" +
+	"\x15ReturnManifestRequest\x12(\n" +
+	"\vmanifest_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\n" +
+	"manifestId\"q\n" +
+	"\x1bExportReturnManifestRequest\x12(\n" +
+	"\vmanifest_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\n" +
+	"manifestId\x12(\n" +
+	"\x06format\x18\x02 \x01(\tB\x10\xfaB\rr\vR\x03csvR\x04jsonR\x06format\"[\n" +
+	"\x1cExportReturnManifestResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\xc3\x01\n" +
+	"\bReceiver\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"l\n" +
+	"\x15UpsertReceiverRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06userId\x12\x1d\n" +
+	"\x05phone\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05phone\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"V\n" +
+	"\x16SearchReceiversRequest\x12\x1d\n" +
+	"\x05query\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05query\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\rB\a\xfaB\x04*\x02\x18dR\x05limit\"B\n" +
+	"\rReceiversList\x121\n" +
+	"\treceivers\x18\x01 \x03(\v2\x13.orders.v2.ReceiverR\treceivers\"\xfa\x02\n" +
+	"\vAuditRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\x04R\x05pvzId\x12&\n" +
+	"\x05actor\x18\x04 \x01(\v2\x10.orders.v2.ActorR\x05actor\x12\x16\n" +
+	"\x06sender\x18\x05 \x01(\tR\x06sender\x12\x12\n" +
+	"\x04peer\x18\x06 \x01(\tR\x04peer\x12\x18\n" +
+	"\apayload\x18\a \x01(\tR\apayload\x12\x1b\n" +
+	"\torder_ids\x18\b \x03(\x04R\borderIds\x12\x1f\n" +
+	"\vresult_code\x18\t \x01(\tR\n" +
+	"resultCode\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tprev_hash\x18\f \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\r \x01(\tR\x04hash\"\xa6\x02\n" +
+	"\x15SearchAuditLogRequest\x12L\n" +
+	"\n" +
+	"actor_type\x18\x01 \x01(\tB(\xfaB%r#R\acourierR\x06clientR\boperatorR\x06systemH\x00R\tactorType\x88\x01\x01\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x04R\aactorId\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x04R\aorderId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1e\n" +
+	"\x05limit\x18\x06 \x01(\rB\b\xfaB\x05*\x03\x18\xe8\aR\x05limitB\r\n" +
+	"\v_actor_type\"<\n" +
+	"\bAuditLog\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.orders.v2.AuditRecordR\arecords\"\x17\n" +
+	"\x15VerifyAuditLogRequest\"r\n" +
+	"\x16VerifyAuditLogResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\x04R\achecked\x12(\n" +
+	"\x10broken_record_id\x18\x03 \x01(\x04R\x0ebrokenRecordId*X\n" +
+	"\n" +
+	"ActionType\x12\x1b\n" +
+	"\x17ACTION_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ACTION_TYPE_ISSUE\x10\x01\x12\x16\n" +
+	"\x12ACTION_TYPE_RETURN\x10\x02*\xd2\x01\n" +
+	"\x0fImportJobStatus\x12!\n" +
+	"\x1dIMPORT_JOB_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19IMPORT_JOB_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19IMPORT_JOB_STATUS_RUNNING\x10\x02\x12\x1f\n" +
+	"\x1bIMPORT_JOB_STATUS_COMPLETED\x10\x03\x12\x1f\n" +
+	"\x1bIMPORT_JOB_STATUS_CANCELLED\x10\x04\x12\x1c\n" +
+	"\x18IMPORT_JOB_STATUS_FAILED\x10\x05*\x80\x01\n" +
+	"\rPaymentStatus\x12\x1e\n" +
+	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PAYMENT_STATUS_UNPAID\x10\x01\x12\x17\n" +
+	"\x13PAYMENT_STATUS_PAID\x10\x02\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_REFUNDED\x10\x03*\xa4\x01\n" +
+	"\vPackageType\x12\x1c\n" +
+	"\x18PACKAGE_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10PACKAGE_TYPE_BAG\x10\x01\x12\x14\n" +
+	"\x10PACKAGE_TYPE_BOX\x10\x02\x12\x15\n" +
+	"\x11PACKAGE_TYPE_TAPE\x10\x03\x12\x19\n" +
+	"\x15PACKAGE_TYPE_BAG_TAPE\x10\x04\x12\x19\n" +
+	"\x15PACKAGE_TYPE_BOX_TAPE\x10\x05*\xb1\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ORDER_STATUS_EXPECTS\x10\x01\x12\x19\n" +
+	"\x15ORDER_STATUS_ACCEPTED\x10\x02\x12\x19\n" +
+	"\x15ORDER_STATUS_RETURNED\x10\x03\x12\x18\n" +
+	"\x14ORDER_STATUS_DELETED\x10\x04\x12\x1a\n" +
+	"\x16ORDER_STATUS_ANNOUNCED\x10\x05*\xcf\x01\n" +
+	"\vOrderAction\x12\x1c\n" +
+	"\x18ORDER_ACTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ORDER_ACTION_ISSUE\x10\x01\x12#\n" +
+	"\x1fORDER_ACTION_RETURN_FROM_CLIENT\x10\x02\x12\"\n" +
+	"\x1eORDER_ACTION_RETURN_TO_COURIER\x10\x03\x12\x1f\n" +
+	"\x1bORDER_ACTION_EXTEND_STORAGE\x10\x04\x12 \n" +
+	"\x1cORDER_ACTION_CONFIRM_ARRIVAL\x10\x05*e\n" +
+	"\bCellSize\x12\x19\n" +
+	"\x15CELL_SIZE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fCELL_SIZE_SMALL\x10\x01\x12\x14\n" +
+	"\x10CELL_SIZE_MEDIUM\x10\x02\x12\x13\n" +
+	"\x0fCELL_SIZE_LARGE\x10\x03*b\n" +
+	"\vPackageKind\x12\x1c\n" +
+	"\x18PACKAGE_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PACKAGE_KIND_CONTAINER\x10\x01\x12\x19\n" +
+	"\x15PACKAGE_KIND_WRAPPING\x10\x02*r\n" +
+	"\x0fDiscrepancyKind\x12 \n" +
+	"\x1cDISCREPANCY_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DISCREPANCY_KIND_MISSING\x10\x01\x12\x1f\n" +
+	"\x1bDISCREPANCY_KIND_UNEXPECTED\x10\x02*l\n" +
+	"\x0eManifestStatus\x12\x1f\n" +
+	"\x1bMANIFEST_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14MANIFEST_STATUS_OPEN\x10\x01\x12\x1f\n" +
+	"\x1bMANIFEST_STATUS_HANDED_OVER\x10\x022Б\x01\n" +
+	"\rOrdersService\x12\xe8\x04\n" +
+	"\vAcceptOrder\x12\x1d.orders.v2.AcceptOrderRequest\x1a\x18.orders.v2.OrderResponse\"\x9f\x04\x92A\xff\x03\x12-Принять заказ от курьера\x1a\xcd\x03Принимает заказ с указанным ID, ID получателя и сроком хранения. Вес передается в граммах, цена — в копейках. Заказ нельзя принять дважды. Если срок хранения в прошлом, выдается ошибка. Повтор с тем же заголовком Idempotency-Key и телом возвращает исходный ответ.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/orders/accept\x12\xc8\x03\n" +
+	"\vReturnOrder\x12\x19.orders.v2.OrderIdRequest\x1a\x18.orders.v2.OrderResponse\"\x83\x03\x92A\xe3\x02\x12(Вернуть заказ курьеру\x1a\xb6\x02Возвращает заказ курьеру по указанному ID. Можно вернуть только заказы, которые не находятся у клиентов или у которых истек срок хранения. Заказ помечается как удаленный.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/orders/return\x12\xa2\f\n" +
+	"\rProcessOrders\x12\x1f.orders.v2.ProcessOrdersRequest\x1a\x18.orders.v2.ProcessResult\"\xd5\v\x92A\xb4\v\x12OВыдать заказы или принять возвраты клиента\x1a\xe0\n" +
+	"Обрабатывает выдачу заказов или прием возвратов для указанного пользователя и списка заказов. Выдача возможна только для принятых заказов с неистекшим сроком хранения и только по коду выдачи, который получатель получает в уведомлении о приемке; после нескольких неверных кодов выдача временно блокируется. Возврат возможен в течение окна, заданного политикой возврата для типа упаковки или продавца (по умолчанию двое суток с момента выдачи). Все заказы должны принадлежать одному клиенту. С флагом atomic заказы обрабатываются по принципу «все или ничего», без него — каждый независимо. Ошибки отдельных заказов возвращаются в results с кодом и сообщением, а не ошибкой всего вызова. Повтор с тем же заголовком Idempotency-Key и телом возвращает исходный ответ.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v2/orders/process\x12\x9b\x05\n" +
+	"\n" +
+	"ListOrders\x12\x1c.orders.v2.ListOrdersRequest\x1a\x15.orders.v2.OrdersList\"\xd7\x04\x92A\xb2\x04\x12,Получить список заказов\x1a\x81\x04Возвращает список заказов для указанного пользователя по возрастанию ID. Поддерживает получение последних N заказов или заказов, находящихся в ПВЗ, с опциональной пагинацией: по номеру страницы или по next_page_token из предыдущего ответа. total — число всех заказов, подходящих под фильтр.\x82\xd3\xe4\x93\x02\x1b\x12\x19/v2/orders/list/{user_id}\x12\xc3\x04\n" +
+	"\vListReturns\x12\x1d.orders.v2.ListReturnsRequest\x1a\x16.orders.v2.ReturnsList\"\xfc\x03\x92A\xde\x03\x12AПолучить список возвратов клиентов\x1a\x98\x03Возвращает список возвращенных заказов, отсортированный от свежих возвратов к старым. Страницы выбираются по номеру или по next_page_token из предыдущего ответа; токен не сбивается, если между запросами появились новые возвраты.\x82\xd3\xe4\x93\x02\x14\x12\x12/v2/orders/returns\x12\xd5\x03\n" +
+	"\n" +
+	"GetHistory\x12\x1c.orders.v2.GetHistoryRequest\x1a\x1b.orders.v2.OrderHistoryList\"\x8b\x03\x92A\xed\x02\x12.Получить историю заказов\x1a\xba\x02Возвращает историю изменений статуса всех заказов, отсортированную по времени последнего обновления. Страницы выбираются по номеру или по next_page_token из предыдущего ответа.\x82\xd3\xe4\x93\x02\x14\x12\x12/v2/orders/history\x12\x93\x05\n" +
+	"\fExportOrders\x12\x1e.orders.v2.ExportOrdersRequest\x1a\x10.orders.v2.Order\"\xce\x04\x92A\xb1\x04\x12\x1fВыгрузить заказы\x1a\x8d\x04Отдает заказы пункта потоком по одному сообщению на заказ, отсортированные по ID. Заказы читаются из базы курсором, поэтому выгрузка не ограничена размером ответа. Фильтры по статусу, получателю, времени приемки и коду упаковки можно сочетать; не заданный фильтр не ограничивает выборку.\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/orders/export0\x01\x12\xd2\x05\n" +
+	"\fImportOrders\x12\x1e.orders.v2.ImportOrdersRequest\x1a\x17.orders.v2.ImportResult\"\x88\x05\x92A\xe8\x04\x12'Импортировать заказы\x1a\xbc\x04Импортирует несколько заказов из предоставленного списка, валидируя каждый заказ. Итог по каждому заказу возвращается в results. С dry_run заказы только проверяются: ничего не записывается, а results показывают, какие заказы были бы отклонены и почему. Повтор с тем же заголовком Idempotency-Key и телом возвращает исходный ответ.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/orders/import\x12\xae\x05\n" +
+	"\x12ImportOrdersStream\x12$.orders.v2.ImportOrdersStreamRequest\x1a\x1a.orders.v2.ImportRowResult\"\xd1\x04\x92A\xaa\x04\x126Импортировать заказы потоком\x1a\xef\x03Принимает заказы по одному сообщению на строку файла и возвращает результат каждой строки по мере обработки, не дожидаясь конца потока. Невалидная строка не обрывает поток: ее ошибка приходит в результате. Режим dry_run задается первым сообщением и действует на весь поток.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v2/orders/import:stream(\x010\x01\x12\xf0\x04\n" +
+	"\vStartImport\x12\x1d.orders.v2.StartImportRequest\x1a\x14.orders.v2.ImportJob\"\xab\x04\x92A\x8d\x04\x12.Запустить фоновый импорт\x1a\xda\x03Сохраняет заказы как задачу импорта и сразу возвращает ее, не дожидаясь обработки. Задача выполняется в фоне и после перезапуска сервиса продолжается с последней зафиксированной строки. Повтор с тем же заголовком Idempotency-Key и телом возвращает исходную задачу.\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v2/import-jobs\x12\xfb\x02\n" +
+	"\fGetImportJob\x12\x1b.orders.v2.ImportJobRequest\x1a\x14.orders.v2.ImportJob\"\xb7\x02\x92A\x93\x02\x12.Состояние задачи импорта\x1a\xe0\x01Возвращает статус задачи, число обработанных, принятых и отклоненных строк и причину отказа по каждой отклоненной строке.\x82\xd3\xe4\x93\x02\x1a\x12\x18/v2/import-jobs/{job_id}\x12\xe1\x02\n" +
+	"\x0fCancelImportJob\x12\x1b.orders.v2.ImportJobRequest\x1a\x14.orders.v2.ImportJob\"\x9a\x02\x92A\xec\x01\x12,Отменить задачу импорта\x1a\xbb\x01Останавливает задачу, которая ждет очереди или выполняется. Уже принятые заказы остаются на хранении.\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v2/import-jobs/{job_id}/cancel\x12\xda\x03\n" +
+	"\x0fGetOrderHistory\x12\x1e.orders.v2.OrderHistoryRequest\x1a\x1f.orders.v2.OrderHistoryResponse\"\x85\x03\x92A\xdc\x02\x12BПолучить историю статусов по заказу\x1a\x95\x02Возвращает историю изменений статуса для указанного заказа, отсортированную по убыванию времени изменения. Если заказ не найден, возвращается ошибка.\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v2/orders/{order_id}/history\x12\xdc\x03\n" +
+	"\x11GetAllowedActions\x12#.orders.v2.GetAllowedActionsRequest\x1a!.orders.v2.AllowedActionsResponse\"\xfe\x02\x92A\xd5\x02\x12FПолучить доступные действия по заказу\x1a\x8a\x02Возвращает текущий статус заказа и действия, которые можно выполнить с ним прямо сейчас, с учетом таблицы переходов и сроков хранения и возврата.\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v2/orders/{order_id}/actions\x12\x88\x04\n" +
+	"\rExtendStorage\x12\x1f.orders.v2.ExtendStorageRequest\x1a .orders.v2.ExtendStorageResponse\"\xb3\x03\x92A\x88\x03\x12.Продлить хранение заказа\x1a\xd5\x02Переносит срок хранения заказа на указанное число дней. Суммарное продление ограничено настройкой сервиса, за каждый день может взиматься плата, которая добавляется к стоимости заказа.\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v2/orders/{order_id}/extend\x12\xa7\x03\n" +
+	"\tMoveOrder\x12\x1b.orders.v2.MoveOrderRequest\x1a\x10.orders.v2.Order\"\xea\x02\x92A\xc1\x02\x12<Переложить заказ в другую ячейку\x1a\x80\x02Перемещает заказ, находящийся в ПВЗ, в указанную ячейку хранения. Предыдущая ячейка освобождается. Если в ячейке нет места, выдается ошибка.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v2/orders/{order_id}/move\x12\xd9\x03\n" +
+	"\x0eConfirmPayment\x12 .orders.v2.ConfirmPaymentRequest\x1a\x10.orders.v2.Order\"\x92\x03\x92A\xe6\x02\x12=Подтвердить оплату при получении\x1a\xa4\x02Отмечает заказ с наложенным платежом как оплаченный. Пока оплата не подтверждена, такой заказ нельзя выдать клиенту. Повторное подтверждение ничего не меняет.\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v2/orders/{order_id}/payment\x12\xc8\x03\n" +
+	"\x0eAnnounceOrders\x12 .orders.v2.AnnounceOrdersRequest\x1a!.orders.v2.AnnounceOrdersResponse\"\xf0\x02\x92A\xbd\x02\x12)Анонсировать поставку\x1a\x8f\x02Регистрирует заказы поставки до их физического прибытия в ПВЗ. Анонсированные заказы не занимают ячеек и не выдаются, пока приемка не подтверждена.\x82\xd3\xe4\x93\x02):\x01*\"$/v2/shipments/{shipment_id}/announce\x12\xab\x03\n" +
+	"\x0eConfirmArrival\x12 .orders.v2.ConfirmArrivalRequest\x1a\x18.orders.v2.ArrivalReport\"\xdc\x02\x92A\xaa\x02\x128Подтвердить прибытие поставки\x1a\xed\x01Переводит прибывшие анонсированные заказы на хранение. Не прибывшие заказы и посылки без анонса попадают в отчет о расхождениях.\x82\xd3\xe4\x93\x02(:\x01*\"#/v2/shipments/{shipment_id}/arrival\x12\xe3\x02\n" +
+	"\x14GetDiscrepancyReport\x12&.orders.v2.GetDiscrepancyReportRequest\x1a\x1c.orders.v2.DiscrepancyReport\"\x84\x02\x92A\xe7\x01\x12&Отчет о расхождениях\x1a\xbc\x01Возвращает расхождения между анонсами и фактической приемкой по ПВЗ; можно отфильтровать по поставке.\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/discrepancies\x12\x9a\x04\n" +
+	"\x12SweepExpiredOrders\x12$.orders.v2.SweepExpiredOrdersRequest\x1a\x19.orders.v2.ReturnManifest\"\xc2\x03\x92A\x99\x03\x122Собрать ведомость возврата\x1a\xe2\x02Собирает в ведомость истекшие заказы и возвраты клиентов, еще не попавшие в несданную ведомость. Если таких заказов нет, ведомость не создается. Та же сборка выполняется сервисом по расписанию.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v2/return-manifests/sweep\x12\x90\x02\n" +
+	"\x13ListReturnManifests\x12%.orders.v2.ListReturnManifestsRequest\x1a\x1e.orders.v2.ReturnManifestsList\"\xb1\x01\x92A\x91\x01\x122Список ведомостей возврата\x1a[Возвращает ведомости возврата ПВЗ, новые первыми.\x82\xd3\xe4\x93\x02\x16\x12\x14/v2/return-manifests\x12\x84\x02\n" +
+	"\x11GetReturnManifest\x12 .orders.v2.ReturnManifestRequest\x1a\x19.orders.v2.ReturnManifest\"\xb1\x01\x92A\x83\x01\x12#Ведомость возврата\x1a\\Возвращает ведомость возврата со списком заказов.\x82\xd3\xe4\x93\x02$\x12\"/v2/return-manifests/{manifest_id}\x12\xbb\x02\n" +
+	"\x14ExportReturnManifest\x12&.orders.v2.ExportReturnManifestRequest\x1a'.orders.v2.ExportReturnManifestResponse\"\xd1\x01\x92A\x9c\x01\x126Выгрузить ведомость возврата\x1abВыгружает ведомость в CSV или JSON для курьерской службы.\x82\xd3\xe4\x93\x02+\x12)/v2/return-manifests/{manifest_id}/export\x12\xff\x03\n" +
+	"\x16HandOverReturnManifest\x12 .orders.v2.ReturnManifestRequest\x1a\x19.orders.v2.ReturnManifest\"\xa7\x03\x92A\xed\x02\x122Передать ведомость курьеру\x1a\xb6\x02Одной транзакцией переводит все заказы ведомости в статус возврата курьеру и освобождает их ячейки. Заказы, которые после сборки нельзя вернуть, из ведомости убираются.\x82\xd3\xe4\x93\x020:\x01*\"+/v2/return-manifests/{manifest_id}/handover\x12\x80\x03\n" +
+	"\x0eUpsertReceiver\x12 .orders.v2.UpsertReceiverRequest\x1a\x13.orders.v2.Receiver\"\xb6\x02\x92A\x90\x02\x12'Сохранить получателя\x1a\xe4\x01Создает получателя в справочнике или обновляет его телефон и имя. Телефон приводится к виду +7XXXXXXXXXX и должен быть уникальным.\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v2/receivers/{user_id}\x12\x8d\x02\n" +
+	"\x0fSearchReceivers\x12!.orders.v2.SearchReceiversRequest\x1a\x18.orders.v2.ReceiversList\"\xbc\x01\x92A\xa3\x01\x12!Найти получателей\x1a~Ищет получателей по фрагменту телефона или имени без учета регистра.\x82\xd3\xe4\x93\x02\x0f\x12\r/v2/receivers\x12\xfa\x02\n" +
+	"\x0eSearchAuditLog\x12 .orders.v2.SearchAuditLogRequest\x1a\x13.orders.v2.AuditLog\"\xb0\x02\x92A\x9b\x02\x123Найти записи журнала аудита\x1a\xe3\x01Ищет вызовы AcceptOrder, ReturnOrder, ProcessOrders, ImportOrders, StartImport и CancelImportJob по исполнителю, заказу и интервалу времени. Новые записи первыми.\x82\xd3\xe4\x93\x02\v\x12\t/v2/audit\x12\xdf\x02\n" +
+	"\x0eVerifyAuditLog\x12 .orders.v2.VerifyAuditLogRequest\x1a!.orders.v2.VerifyAuditLogResponse\"\x87\x02\x92A\xeb\x01\x12EПроверить целостность журнала аудита\x1a\xa1\x01Пересчитывает цепочку хешей журнала и сообщает первую измененную или удаленную запись.\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/audit/verify\x12\xd6\x02\n" +
+	"\x11CreateStorageCell\x12#.orders.v2.CreateStorageCellRequest\x1a\x16.orders.v2.StorageCell\"\x83\x02\x92A\xe3\x01\x12,Создать ячейку хранения\x1a\xb2\x01Добавляет ячейку хранения с указанным кодом, размером и вместимостью в пункт выдачи вызывающего.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/storage-cells\x12\xc4\x02\n" +
+	"\x10ListStorageCells\x12\".orders.v2.ListStorageCellsRequest\x1a\x1b.orders.v2.StorageCellsList\"\xee\x01\x92A\xd1\x01\x129Получить список ячеек хранения\x1a\x93\x01Возвращает ячейки хранения пункта выдачи вызывающего с текущей заполненностью.\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/storage-cells\x12\xbb\x04\n" +
+	"\x0fSetReturnPolicy\x12!.orders.v2.SetReturnPolicyRequest\x1a\x17.orders.v2.ReturnPolicy\"\xeb\x03\x92A\xc9\x03\x12.Задать политику возврата\x1a\x96\x03Создает или обновляет политику возврата для типа упаковки и/или продавца. Если ни упаковка, ни продавец не указаны, политика действует для всех заказов. Более конкретная политика (продавец, затем упаковка) имеет приоритет.\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v2/return-policies\x12\x91\x02\n" +
+	"\x12ListReturnPolicies\x12$.orders.v2.ListReturnPoliciesRequest\x1a\x1d.orders.v2.ReturnPoliciesList\"\xb5\x01\x92A\x96\x01\x12=Получить список политик возврата\x1aUВозвращает все настроенные политики возврата.\x82\xd3\xe4\x93\x02\x15\x12\x13/v2/return-policies\x12\x81\x03\n" +
+	"\x11CreatePickupPoint\x12#.orders.v2.CreatePickupPointRequest\x1a\x16.orders.v2.PickupPoint\"\xae\x02\x92A\x8e\x02\x12&Создать пункт выдачи\x1a\xe3\x01Регистрирует новый пункт выдачи заказов. ID пункта передается в остальные методы через метаданные x-pvz-id (заголовок X-Pvz-Id в HTTP).\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/pickup-points\x12\x9a\x02\n" +
+	"\x10ListPickupPoints\x12\".orders.v2.ListPickupPointsRequest\x1a\x1b.orders.v2.PickupPointsList\"\xc4\x01\x92A\xa7\x01\x129Получить список пунктов выдачи\x1ajВозвращает все зарегистрированные пункты выдачи заказов.\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/pickup-points\x12\xac\x04\n" +
+	"\x11CreatePackageType\x12#.orders.v2.CreatePackageTypeRequest\x1a .orders.v2.PackageTypeDefinition\"\xcf\x03\x92A\xaf\x03\x12(Добавить тип упаковки\x1a\x82\x03Добавляет в справочник простую упаковку: внешнюю (container) или обертку (wrapping). Комбинации вида box+film заводить не нужно: их правила собираются из частей — предельный вес берется у внешней упаковки, цены складываются.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/package-types\x12\xf9\x02\n" +
+	"\x11UpdatePackageType\x12#.orders.v2.UpdatePackageTypeRequest\x1a .orders.v2.PackageTypeDefinition\"\x9c\x02\x92A\xf5\x01\x12(Изменить тип упаковки\x1a\xc8\x01Меняет вид, предельный вес и цену упаковки. Новые правила сразу действуют и для всех комбинаций с ее участием.\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v2/package-types/{code}\x12\xab\x03\n" +
+	"\x11DeletePackageType\x12#.orders.v2.DeletePackageTypeRequest\x1a$.orders.v2.DeletePackageTypeResponse\"\xca\x02\x92A\xa6\x02\x12&Удалить тип упаковки\x1a\xfb\x01Удаляет упаковку из справочника. Упаковку, на которую ссылается политика возврата, удалить нельзя. Уже принятые заказы не затрагиваются.\x82\xd3\xe4\x93\x02\x1a*\x18/v2/package-types/{code}\x12\xb5\x02\n" +
+	"\x10ListPackageTypes\x12\".orders.v2.ListPackageTypesRequest\x1a\x1b.orders.v2.PackageTypesList\"\xdf\x01\x92A\xc2\x01\x126Получить справочник упаковок\x1a\x87\x01Возвращает все простые типы упаковки с их видом, предельным весом и ценой.\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/package-typesB\xf6\x02\x92A\xbd\x02\x12\x83\x02\n" +
+	"\x12PVZ Orders Service\x12\xe5\x01API для управления заказами в системе пункта выдачи заказов. Суммы передаются целым числом копеек, вес — целым числом граммов.2\x052.0.0\x1a\x0elocalhost:8081*\x01\x012\x10application/json:\x10application/jsonZ3gitlab.ozon.dev/safariproxd/homework/pkg/api/v2;apib\x06proto3"
+
+var (
+	file_orders_v2_contract_proto_rawDescOnce sync.Once
+	file_orders_v2_contract_proto_rawDescData []byte
+)
+
+func file_orders_v2_contract_proto_rawDescGZIP() []byte {
+	file_orders_v2_contract_proto_rawDescOnce.Do(func() {
+		file_orders_v2_contract_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_orders_v2_contract_proto_rawDesc), len(file_orders_v2_contract_proto_rawDesc)))
+	})
+	return file_orders_v2_contract_proto_rawDescData
+}
+
+var file_orders_v2_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_orders_v2_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_orders_v2_contract_proto_goTypes = []any{
+	(ActionType)(0),                      // 0: orders.v2.ActionType
+	(ImportJobStatus)(0),                 // 1: orders.v2.ImportJobStatus
+	(PaymentStatus)(0),                   // 2: orders.v2.PaymentStatus
+	(PackageType)(0),                     // 3: orders.v2.PackageType
+	(OrderStatus)(0),                     // 4: orders.v2.OrderStatus
+	(OrderAction)(0),                     // 5: orders.v2.OrderAction
+	(CellSize)(0),                        // 6: orders.v2.CellSize
+	(PackageKind)(0),                     // 7: orders.v2.PackageKind
+	(DiscrepancyKind)(0),                 // 8: orders.v2.DiscrepancyKind
+	(ManifestStatus)(0),                  // 9: orders.v2.ManifestStatus
+	(*AcceptOrderRequest)(nil),           // 10: orders.v2.AcceptOrderRequest
+	(*OrderIdRequest)(nil),               // 11: orders.v2.OrderIdRequest
+	(*ProcessOrdersRequest)(nil),         // 12: orders.v2.ProcessOrdersRequest
+	(*ListOrdersRequest)(nil),            // 13: orders.v2.ListOrdersRequest
+	(*Pagination)(nil),                   // 14: orders.v2.Pagination
+	(*ListReturnsRequest)(nil),           // 15: orders.v2.ListReturnsRequest
+	(*ExportOrdersRequest)(nil),          // 16: orders.v2.ExportOrdersRequest
+	(*ImportOrdersRequest)(nil),          // 17: orders.v2.ImportOrdersRequest
+	(*ImportOrdersStreamRequest)(nil),    // 18: orders.v2.ImportOrdersStreamRequest
+	(*ImportRowResult)(nil),              // 19: orders.v2.ImportRowResult
+	(*StartImportRequest)(nil),           // 20: orders.v2.StartImportRequest
+	(*ImportJobRequest)(nil),             // 21: orders.v2.ImportJobRequest
+	(*ImportJobFailure)(nil),             // 22: orders.v2.ImportJobFailure
+	(*ImportJob)(nil),                    // 23: orders.v2.ImportJob
+	(*GetHistoryRequest)(nil),            // 24: orders.v2.GetHistoryRequest
+	(*OrderHistoryRequest)(nil),          // 25: orders.v2.OrderHistoryRequest
+	(*OrderHistoryResponse)(nil),         // 26: orders.v2.OrderHistoryResponse
+	(*OrderResponse)(nil),                // 27: orders.v2.OrderResponse
+	(*ProcessResult)(nil),                // 28: orders.v2.ProcessResult
+	(*OrderResult)(nil),                  // 29: orders.v2.OrderResult
+	(*StorageFee)(nil),                   // 30: orders.v2.StorageFee
+	(*OrdersList)(nil),                   // 31: orders.v2.OrdersList
+	(*ReturnsList)(nil),                  // 32: orders.v2.ReturnsList
+	(*OrderHistoryList)(nil),             // 33: orders.v2.OrderHistoryList
+	(*ImportResult)(nil),                 // 34: orders.v2.ImportResult
+	(*Order)(nil),                        // 35: orders.v2.Order
+	(*ConfirmPaymentRequest)(nil),        // 36: orders.v2.ConfirmPaymentRequest
+	(*OrderHistory)(nil),                 // 37: orders.v2.OrderHistory
+	(*Actor)(nil),                        // 38: orders.v2.Actor
+	(*GetAllowedActionsRequest)(nil),     // 39: orders.v2.GetAllowedActionsRequest
+	(*AllowedActionsResponse)(nil),       // 40: orders.v2.AllowedActionsResponse
+	(*ExtendStorageRequest)(nil),         // 41: orders.v2.ExtendStorageRequest
+	(*ExtendStorageResponse)(nil),        // 42: orders.v2.ExtendStorageResponse
+	(*MoveOrderRequest)(nil),             // 43: orders.v2.MoveOrderRequest
+	(*CreateStorageCellRequest)(nil),     // 44: orders.v2.CreateStorageCellRequest
+	(*ListStorageCellsRequest)(nil),      // 45: orders.v2.ListStorageCellsRequest
+	(*StorageCell)(nil),                  // 46: orders.v2.StorageCell
+	(*StorageCellsList)(nil),             // 47: orders.v2.StorageCellsList
+	(*SetReturnPolicyRequest)(nil),       // 48: orders.v2.SetReturnPolicyRequest
+	(*ListReturnPoliciesRequest)(nil),    // 49: orders.v2.ListReturnPoliciesRequest
+	(*ReturnPolicy)(nil),                 // 50: orders.v2.ReturnPolicy
+	(*ReturnPoliciesList)(nil),           // 51: orders.v2.ReturnPoliciesList
+	(*CreatePickupPointRequest)(nil),     // 52: orders.v2.CreatePickupPointRequest
+	(*ListPickupPointsRequest)(nil),      // 53: orders.v2.ListPickupPointsRequest
+	(*PickupPoint)(nil),                  // 54: orders.v2.PickupPoint
+	(*PickupPointsList)(nil),             // 55: orders.v2.PickupPointsList
+	(*PackageTypeDefinition)(nil),        // 56: orders.v2.PackageTypeDefinition
+	(*CreatePackageTypeRequest)(nil),     // 57: orders.v2.CreatePackageTypeRequest
+	(*UpdatePackageTypeRequest)(nil),     // 58: orders.v2.UpdatePackageTypeRequest
+	(*DeletePackageTypeRequest)(nil),     // 59: orders.v2.DeletePackageTypeRequest
+	(*DeletePackageTypeResponse)(nil),    // 60: orders.v2.DeletePackageTypeResponse
+	(*ListPackageTypesRequest)(nil),      // 61: orders.v2.ListPackageTypesRequest
+	(*PackageTypesList)(nil),             // 62: orders.v2.PackageTypesList
+	(*AnnounceOrdersRequest)(nil),        // 63: orders.v2.AnnounceOrdersRequest
+	(*AnnounceOrdersResponse)(nil),       // 64: orders.v2.AnnounceOrdersResponse
+	(*ConfirmArrivalRequest)(nil),        // 65: orders.v2.ConfirmArrivalRequest
+	(*Discrepancy)(nil),                  // 66: orders.v2.Discrepancy
+	(*ArrivalReport)(nil),                // 67: orders.v2.ArrivalReport
+	(*GetDiscrepancyReportRequest)(nil),  // 68: orders.v2.GetDiscrepancyReportRequest
+	(*DiscrepancyReport)(nil),            // 69: orders.v2.DiscrepancyReport
+	(*ReturnManifestItem)(nil),           // 70: orders.v2.ReturnManifestItem
+	(*ReturnManifest)(nil),               // 71: orders.v2.ReturnManifest
+	(*SweepExpiredOrdersRequest)(nil),    // 72: orders.v2.SweepExpiredOrdersRequest
+	(*ListReturnManifestsRequest)(nil),   // 73: orders.v2.ListReturnManifestsRequest
+	(*ReturnManifestsList)(nil),          // 74: orders.v2.ReturnManifestsList
+	(*ReturnManifestRequest)(nil),        // 75: orders.v2.ReturnManifestRequest
+	(*ExportReturnManifestRequest)(nil),  // 76: orders.v2.ExportReturnManifestRequest
+	(*ExportReturnManifestResponse)(nil), // 77: orders.v2.ExportReturnManifestResponse
+	(*Receiver)(nil),                     // 78: orders.v2.Receiver
+	(*UpsertReceiverRequest)(nil),        // 79: orders.v2.UpsertReceiverRequest
+	(*SearchReceiversRequest)(nil),       // 80: orders.v2.SearchReceiversRequest
+	(*ReceiversList)(nil),                // 81: orders.v2.ReceiversList
+	(*AuditRecord)(nil),                  // 82: orders.v2.AuditRecord
+	(*SearchAuditLogRequest)(nil),        // 83: orders.v2.SearchAuditLogRequest
+	(*AuditLog)(nil),                     // 84: orders.v2.AuditLog
+	(*VerifyAuditLogRequest)(nil),        // 85: orders.v2.VerifyAuditLogRequest
+	(*VerifyAuditLogResponse)(nil),       // 86: orders.v2.VerifyAuditLogResponse
+	(*timestamppb.Timestamp)(nil),        // 87: google.protobuf.Timestamp
+}
+var file_orders_v2_contract_proto_depIdxs = []int32{
+	87,  // 0: orders.v2.AcceptOrderRequest.expires_at:type_name -> google.protobuf.Timestamp
+	3,   // 1: orders.v2.AcceptOrderRequest.package:type_name -> orders.v2.PackageType
+	0,   // 2: orders.v2.ProcessOrdersRequest.action:type_name -> orders.v2.ActionType
+	14,  // 3: orders.v2.ListOrdersRequest.pagination:type_name -> orders.v2.Pagination
+	14,  // 4: orders.v2.ListReturnsRequest.pagination:type_name -> orders.v2.Pagination
+	4,   // 5: orders.v2.ExportOrdersRequest.statuses:type_name -> orders.v2.OrderStatus
+	87,  // 6: orders.v2.ExportOrdersRequest.accepted_from:type_name -> google.protobuf.Timestamp
+	87,  // 7: orders.v2.ExportOrdersRequest.accepted_to:type_name -> google.protobuf.Timestamp
+	10,  // 8: orders.v2.ImportOrdersRequest.orders:type_name -> orders.v2.AcceptOrderRequest
+	10,  // 9: orders.v2.ImportOrdersStreamRequest.order:type_name -> orders.v2.AcceptOrderRequest
+	29,  // 10: orders.v2.ImportRowResult.result:type_name -> orders.v2.OrderResult
+	10,  // 11: orders.v2.StartImportRequest.orders:type_name -> orders.v2.AcceptOrderRequest
+	1,   // 12: orders.v2.ImportJob.status:type_name -> orders.v2.ImportJobStatus
+	22,  // 13: orders.v2.ImportJob.failures:type_name -> orders.v2.ImportJobFailure
+	87,  // 14: orders.v2.ImportJob.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 15: orders.v2.ImportJob.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 16: orders.v2.GetHistoryRequest.pagination:type_name -> orders.v2.Pagination
+	37,  // 17: orders.v2.OrderHistoryResponse.history:type_name -> orders.v2.OrderHistory
+	4,   // 18: orders.v2.OrderResponse.status:type_name -> orders.v2.OrderStatus
+	30,  // 19: orders.v2.ProcessResult.storage_fees:type_name -> orders.v2.StorageFee
+	29,  // 20: orders.v2.ProcessResult.results:type_name -> orders.v2.OrderResult
+	4,   // 21: orders.v2.OrderResult.status:type_name -> orders.v2.OrderStatus
+	35,  // 22: orders.v2.OrdersList.orders:type_name -> orders.v2.Order
+	35,  // 23: orders.v2.ReturnsList.returns:type_name -> orders.v2.Order
+	37,  // 24: orders.v2.OrderHistoryList.history:type_name -> orders.v2.OrderHistory
+	29,  // 25: orders.v2.ImportResult.results:type_name -> orders.v2.OrderResult
+	4,   // 26: orders.v2.Order.status:type_name -> orders.v2.OrderStatus
+	87,  // 27: orders.v2.Order.expires_at:type_name -> google.protobuf.Timestamp
+	3,   // 28: orders.v2.Order.package:type_name -> orders.v2.PackageType
+	2,   // 29: orders.v2.Order.payment_status:type_name -> orders.v2.PaymentStatus
+	4,   // 30: orders.v2.OrderHistory.status:type_name -> orders.v2.OrderStatus
+	87,  // 31: orders.v2.OrderHistory.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 32: orders.v2.OrderHistory.prev_status:type_name -> orders.v2.OrderStatus
+	38,  // 33: orders.v2.OrderHistory.actor:type_name -> orders.v2.Actor
+	4,   // 34: orders.v2.AllowedActionsResponse.status:type_name -> orders.v2.OrderStatus
+	5,   // 35: orders.v2.AllowedActionsResponse.actions:type_name -> orders.v2.OrderAction
+	35,  // 36: orders.v2.ExtendStorageResponse.order:type_name -> orders.v2.Order
+	6,   // 37: orders.v2.CreateStorageCellRequest.size:type_name -> orders.v2.CellSize
+	6,   // 38: orders.v2.StorageCell.size:type_name -> orders.v2.CellSize
+	46,  // 39: orders.v2.StorageCellsList.cells:type_name -> orders.v2.StorageCell
+	3,   // 40: orders.v2.SetReturnPolicyRequest.package:type_name -> orders.v2.PackageType
+	3,   // 41: orders.v2.ReturnPolicy.package:type_name -> orders.v2.PackageType
+	50,  // 42: orders.v2.ReturnPoliciesList.policies:type_name -> orders.v2.ReturnPolicy
+	87,  // 43: orders.v2.PickupPoint.created_at:type_name -> google.protobuf.Timestamp
+	54,  // 44: orders.v2.PickupPointsList.points:type_name -> orders.v2.PickupPoint
+	7,   // 45: orders.v2.PackageTypeDefinition.kind:type_name -> orders.v2.PackageKind
+	7,   // 46: orders.v2.CreatePackageTypeRequest.kind:type_name -> orders.v2.PackageKind
+	7,   // 47: orders.v2.UpdatePackageTypeRequest.kind:type_name -> orders.v2.PackageKind
+	56,  // 48: orders.v2.PackageTypesList.package_types:type_name -> orders.v2.PackageTypeDefinition
+	10,  // 49: orders.v2.AnnounceOrdersRequest.orders:type_name -> orders.v2.AcceptOrderRequest
+	29,  // 50: orders.v2.AnnounceOrdersResponse.results:type_name -> orders.v2.OrderResult
+	8,   // 51: orders.v2.Discrepancy.kind:type_name -> orders.v2.DiscrepancyKind
+	87,  // 52: orders.v2.Discrepancy.detected_at:type_name -> google.protobuf.Timestamp
+	66,  // 53: orders.v2.ArrivalReport.discrepancies:type_name -> orders.v2.Discrepancy
+	29,  // 54: orders.v2.ArrivalReport.results:type_name -> orders.v2.OrderResult
+	66,  // 55: orders.v2.DiscrepancyReport.discrepancies:type_name -> orders.v2.Discrepancy
+	87,  // 56: orders.v2.ReturnManifestItem.expires_at:type_name -> google.protobuf.Timestamp
+	9,   // 57: orders.v2.ReturnManifest.status:type_name -> orders.v2.ManifestStatus
+	70,  // 58: orders.v2.ReturnManifest.items:type_name -> orders.v2.ReturnManifestItem
+	87,  // 59: orders.v2.ReturnManifest.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 60: orders.v2.ReturnManifest.handed_over_at:type_name -> google.protobuf.Timestamp
+	71,  // 61: orders.v2.ReturnManifestsList.manifests:type_name -> orders.v2.ReturnManifest
+	87,  // 62: orders.v2.Receiver.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 63: orders.v2.Receiver.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 64: orders.v2.ReceiversList.receivers:type_name -> orders.v2.Receiver
+	38,  // 65: orders.v2.AuditRecord.actor:type_name -> orders.v2.Actor
+	87,  // 66: orders.v2.AuditRecord.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 67: orders.v2.SearchAuditLogRequest.from:type_name -> google.protobuf.Timestamp
+	87,  // 68: orders.v2.SearchAuditLogRequest.to:type_name -> google.protobuf.Timestamp
+	82,  // 69: orders.v2.AuditLog.records:type_name -> orders.v2.AuditRecord
+	10,  // 70: orders.v2.OrdersService.AcceptOrder:input_type -> orders.v2.AcceptOrderRequest
+	11,  // 71: orders.v2.OrdersService.ReturnOrder:input_type -> orders.v2.OrderIdRequest
+	12,  // 72: orders.v2.OrdersService.ProcessOrders:input_type -> orders.v2.ProcessOrdersRequest
+	13,  // 73: orders.v2.OrdersService.ListOrders:input_type -> orders.v2.ListOrdersRequest
+	15,  // 74: orders.v2.OrdersService.ListReturns:input_type -> orders.v2.ListReturnsRequest
+	24,  // 75: orders.v2.OrdersService.GetHistory:input_type -> orders.v2.GetHistoryRequest
+	16,  // 76: orders.v2.OrdersService.ExportOrders:input_type -> orders.v2.ExportOrdersRequest
+	17,  // 77: orders.v2.OrdersService.ImportOrders:input_type -> orders.v2.ImportOrdersRequest
+	18,  // 78: orders.v2.OrdersService.ImportOrdersStream:input_type -> orders.v2.ImportOrdersStreamRequest
+	20,  // 79: orders.v2.OrdersService.StartImport:input_type -> orders.v2.StartImportRequest
+	21,  // 80: orders.v2.OrdersService.GetImportJob:input_type -> orders.v2.ImportJobRequest
+	21,  // 81: orders.v2.OrdersService.CancelImportJob:input_type -> orders.v2.ImportJobRequest
+	25,  // 82: orders.v2.OrdersService.GetOrderHistory:input_type -> orders.v2.OrderHistoryRequest
+	39,  // 83: orders.v2.OrdersService.GetAllowedActions:input_type -> orders.v2.GetAllowedActionsRequest
+	41,  // 84: orders.v2.OrdersService.ExtendStorage:input_type -> orders.v2.ExtendStorageRequest
+	43,  // 85: orders.v2.OrdersService.MoveOrder:input_type -> orders.v2.MoveOrderRequest
+	36,  // 86: orders.v2.OrdersService.ConfirmPayment:input_type -> orders.v2.ConfirmPaymentRequest
+	63,  // 87: orders.v2.OrdersService.AnnounceOrders:input_type -> orders.v2.AnnounceOrdersRequest
+	65,  // 88: orders.v2.OrdersService.ConfirmArrival:input_type -> orders.v2.ConfirmArrivalRequest
+	68,  // 89: orders.v2.OrdersService.GetDiscrepancyReport:input_type -> orders.v2.GetDiscrepancyReportRequest
+	72,  // 90: orders.v2.OrdersService.SweepExpiredOrders:input_type -> orders.v2.SweepExpiredOrdersRequest
+	73,  // 91: orders.v2.OrdersService.ListReturnManifests:input_type -> orders.v2.ListReturnManifestsRequest
+	75,  // 92: orders.v2.OrdersService.GetReturnManifest:input_type -> orders.v2.ReturnManifestRequest
+	76,  // 93: orders.v2.OrdersService.ExportReturnManifest:input_type -> orders.v2.ExportReturnManifestRequest
+	75,  // 94: orders.v2.OrdersService.HandOverReturnManifest:input_type -> orders.v2.ReturnManifestRequest
+	79,  // 95: orders.v2.OrdersService.UpsertReceiver:input_type -> orders.v2.UpsertReceiverRequest
+	80,  // 96: orders.v2.OrdersService.SearchReceivers:input_type -> orders.v2.SearchReceiversRequest
+	83,  // 97: orders.v2.OrdersService.SearchAuditLog:input_type -> orders.v2.SearchAuditLogRequest
+	85,  // 98: orders.v2.OrdersService.VerifyAuditLog:input_type -> orders.v2.VerifyAuditLogRequest
+	44,  // 99: orders.v2.OrdersService.CreateStorageCell:input_type -> orders.v2.CreateStorageCellRequest
+	45,  // 100: orders.v2.OrdersService.ListStorageCells:input_type -> orders.v2.ListStorageCellsRequest
+	48,  // 101: orders.v2.OrdersService.SetReturnPolicy:input_type -> orders.v2.SetReturnPolicyRequest
+	49,  // 102: orders.v2.OrdersService.ListReturnPolicies:input_type -> orders.v2.ListReturnPoliciesRequest
+	52,  // 103: orders.v2.OrdersService.CreatePickupPoint:input_type -> orders.v2.CreatePickupPointRequest
+	53,  // 104: orders.v2.OrdersService.ListPickupPoints:input_type -> orders.v2.ListPickupPointsRequest
+	57,  // 105: orders.v2.OrdersService.CreatePackageType:input_type -> orders.v2.CreatePackageTypeRequest
+	58,  // 106: orders.v2.OrdersService.UpdatePackageType:input_type -> orders.v2.UpdatePackageTypeRequest
+	59,  // 107: orders.v2.OrdersService.DeletePackageType:input_type -> orders.v2.DeletePackageTypeRequest
+	61,  // 108: orders.v2.OrdersService.ListPackageTypes:input_type -> orders.v2.ListPackageTypesRequest
+	27,  // 109: orders.v2.OrdersService.AcceptOrder:output_type -> orders.v2.OrderResponse
+	27,  // 110: orders.v2.OrdersService.ReturnOrder:output_type -> orders.v2.OrderResponse
+	28,  // 111: orders.v2.OrdersService.ProcessOrders:output_type -> orders.v2.ProcessResult
+	31,  // 112: orders.v2.OrdersService.ListOrders:output_type -> orders.v2.OrdersList
+	32,  // 113: orders.v2.OrdersService.ListReturns:output_type -> orders.v2.ReturnsList
+	33,  // 114: orders.v2.OrdersService.GetHistory:output_type -> orders.v2.OrderHistoryList
+	35,  // 115: orders.v2.OrdersService.ExportOrders:output_type -> orders.v2.Order
+	34,  // 116: orders.v2.OrdersService.ImportOrders:output_type -> orders.v2.ImportResult
+	19,  // 117: orders.v2.OrdersService.ImportOrdersStream:output_type -> orders.v2.ImportRowResult
+	23,  // 118: orders.v2.OrdersService.StartImport:output_type -> orders.v2.ImportJob
+	23,  // 119: orders.v2.OrdersService.GetImportJob:output_type -> orders.v2.ImportJob
+	23,  // 120: orders.v2.OrdersService.CancelImportJob:output_type -> orders.v2.ImportJob
+	26,  // 121: orders.v2.OrdersService.GetOrderHistory:output_type -> orders.v2.OrderHistoryResponse
+	40,  // 122: orders.v2.OrdersService.GetAllowedActions:output_type -> orders.v2.AllowedActionsResponse
+	42,  // 123: orders.v2.OrdersService.ExtendStorage:output_type -> orders.v2.ExtendStorageResponse
+	35,  // 124: orders.v2.OrdersService.MoveOrder:output_type -> orders.v2.Order
+	35,  // 125: orders.v2.OrdersService.ConfirmPayment:output_type -> orders.v2.Order
+	64,  // 126: orders.v2.OrdersService.AnnounceOrders:output_type -> orders.v2.AnnounceOrdersResponse
+	67,  // 127: orders.v2.OrdersService.ConfirmArrival:output_type -> orders.v2.ArrivalReport
+	69,  // 128: orders.v2.OrdersService.GetDiscrepancyReport:output_type -> orders.v2.DiscrepancyReport
+	71,  // 129: orders.v2.OrdersService.SweepExpiredOrders:output_type -> orders.v2.ReturnManifest
+	74,  // 130: orders.v2.OrdersService.ListReturnManifests:output_type -> orders.v2.ReturnManifestsList
+	71,  // 131: orders.v2.OrdersService.GetReturnManifest:output_type -> orders.v2.ReturnManifest
+	77,  // 132: orders.v2.OrdersService.ExportReturnManifest:output_type -> orders.v2.ExportReturnManifestResponse
+	71,  // 133: orders.v2.OrdersService.HandOverReturnManifest:output_type -> orders.v2.ReturnManifest
+	78,  // 134: orders.v2.OrdersService.UpsertReceiver:output_type -> orders.v2.Receiver
+	81,  // 135: orders.v2.OrdersService.SearchReceivers:output_type -> orders.v2.ReceiversList
+	84,  // 136: orders.v2.OrdersService.SearchAuditLog:output_type -> orders.v2.AuditLog
+	86,  // 137: orders.v2.OrdersService.VerifyAuditLog:output_type -> orders.v2.VerifyAuditLogResponse
+	46,  // 138: orders.v2.OrdersService.CreateStorageCell:output_type -> orders.v2.StorageCell
+	47,  // 139: orders.v2.OrdersService.ListStorageCells:output_type -> orders.v2.StorageCellsList
+	50,  // 140: orders.v2.OrdersService.SetReturnPolicy:output_type -> orders.v2.ReturnPolicy
+	51,  // 141: orders.v2.OrdersService.ListReturnPolicies:output_type -> orders.v2.ReturnPoliciesList
+	54,  // 142: orders.v2.OrdersService.CreatePickupPoint:output_type -> orders.v2.PickupPoint
+	55,  // 143: orders.v2.OrdersService.ListPickupPoints:output_type -> orders.v2.PickupPointsList
+	56,  // 144: orders.v2.OrdersService.CreatePackageType:output_type -> orders.v2.PackageTypeDefinition
+	56,  // 145: orders.v2.OrdersService.UpdatePackageType:output_type -> orders.v2.PackageTypeDefinition
+	60,  // 146: orders.v2.OrdersService.DeletePackageType:output_type -> orders.v2.DeletePackageTypeResponse
+	62,  // 147: orders.v2.OrdersService.ListPackageTypes:output_type -> orders.v2.PackageTypesList
+	109, // [109:148] is the sub-list for method output_type
+	70,  // [70:109] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
+}
+
+func init() { file_orders_v2_contract_proto_init() }
+func file_orders_v2_contract_proto_init() {
+	if File_orders_v2_contract_proto != nil {
+		return
+	}
+	file_orders_v2_contract_proto_msgTypes[0].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[2].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[3].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[6].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[19].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[25].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[27].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[38].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[40].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[58].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[61].OneofWrappers = []any{}
+	file_orders_v2_contract_proto_msgTypes[73].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orders_v2_contract_proto_rawDesc), len(file_orders_v2_contract_proto_rawDesc)),
+			NumEnums:      10,
+			NumMessages:   77,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_orders_v2_contract_proto_goTypes,
+		DependencyIndexes: file_orders_v2_contract_proto_depIdxs,
+		EnumInfos:         file_orders_v2_contract_proto_enumTypes,
+		MessageInfos:      file_orders_v2_contract_proto_msgTypes,
+	}.Build()
+	File_orders_v2_contract_proto = out.File
+	file_orders_v2_contract_proto_goTypes = nil
+	file_orders_v2_contract_proto_depIdxs = nil
+}
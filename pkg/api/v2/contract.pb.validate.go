@@ -0,0 +1,10417 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: orders/v2/contract.proto
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AcceptOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptOrderRequestMultiError, or nil if none found.
+func (m *AcceptOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiresAt() == nil {
+		err := AcceptOrderRequestValidationError{
+			field:  "ExpiresAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = AcceptOrderRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := AcceptOrderRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetWeightGrams() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "WeightGrams",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPriceKopecks() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "PriceKopecks",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CashOnDelivery
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if m.SellerId != nil {
+		// no validation rules for SellerId
+	}
+
+	if m.PackageCode != nil {
+
+		if !_AcceptOrderRequest_PackageCode_Pattern.MatchString(m.GetPackageCode()) {
+			err := AcceptOrderRequestValidationError{
+				field:  "PackageCode",
+				reason: "value does not match regex pattern \"^[a-z0-9_-]+(\\\\+[a-z0-9_-]+)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AcceptOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by AcceptOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AcceptOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptOrderRequestMultiError) AllErrors() []error { return m }
+
+// AcceptOrderRequestValidationError is the validation error returned by
+// AcceptOrderRequest.Validate if the designated constraints aren't met.
+type AcceptOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOrderRequestValidationError) ErrorName() string {
+	return "AcceptOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOrderRequestValidationError{}
+
+var _AcceptOrderRequest_PackageCode_Pattern = regexp.MustCompile("^[a-z0-9_-]+(\\+[a-z0-9_-]+)*$")
+
+// Validate checks the field values on OrderIdRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderIdRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderIdRequestMultiError,
+// or nil if none found.
+func (m *OrderIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := OrderIdRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderIdRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderIdRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderIdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderIdRequestMultiError) AllErrors() []error { return m }
+
+// OrderIdRequestValidationError is the validation error returned by
+// OrderIdRequest.Validate if the designated constraints aren't met.
+type OrderIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderIdRequestValidationError) ErrorName() string { return "OrderIdRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderIdRequestValidationError{}
+
+// Validate checks the field values on ProcessOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessOrdersRequestMultiError, or nil if none found.
+func (m *ProcessOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ProcessOrdersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ProcessOrdersRequest_Action_NotInLookup[m.GetAction()]; ok {
+		err := ProcessOrdersRequestValidationError{
+			field:  "Action",
+			reason: "value must not be in list [ACTION_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ActionType_name[int32(m.GetAction())]; !ok {
+		err := ProcessOrdersRequestValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOrderIds()) < 1 {
+		err := ProcessOrdersRequestValidationError{
+			field:  "OrderIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ProcessOrdersRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Atomic
+
+	if m.PickupCode != nil {
+
+		if !_ProcessOrdersRequest_PickupCode_Pattern.MatchString(m.GetPickupCode()) {
+			err := ProcessOrdersRequestValidationError{
+				field:  "PickupCode",
+				reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProcessOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ProcessOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ProcessOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ProcessOrdersRequestValidationError is the validation error returned by
+// ProcessOrdersRequest.Validate if the designated constraints aren't met.
+type ProcessOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessOrdersRequestValidationError) ErrorName() string {
+	return "ProcessOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessOrdersRequestValidationError{}
+
+var _ProcessOrdersRequest_Action_NotInLookup = map[ActionType]struct{}{
+	0: {},
+}
+
+var _ProcessOrdersRequest_PickupCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on ListOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersRequestMultiError, or nil if none found.
+func (m *ListOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for InPvz
+
+	// no validation rules for PageToken
+
+	if m.GetPageSize() > 1000 {
+		err := ListOrdersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.LastN != nil {
+
+		if m.GetLastN() <= 0 {
+			err := ListOrdersRequestValidationError{
+				field:  "LastN",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Pagination != nil {
+
+		if all {
+			switch v := interface{}(m.GetPagination()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Phone != nil {
+
+		if utf8.RuneCountInString(m.GetPhone()) < 1 {
+			err := ListOrdersRequestValidationError{
+				field:  "Phone",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListOrdersRequestValidationError is the validation error returned by
+// ListOrdersRequest.Validate if the designated constraints aren't met.
+type ListOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersRequestValidationError) ErrorName() string {
+	return "ListOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersRequestValidationError{}
+
+// Validate checks the field values on Pagination with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Pagination) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Pagination with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PaginationMultiError, or
+// nil if none found.
+func (m *Pagination) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Pagination) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := PaginationValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCountOnPage() <= 0 {
+		err := PaginationValidationError{
+			field:  "CountOnPage",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PaginationMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaginationMultiError is an error wrapping multiple validation errors
+// returned by Pagination.ValidateAll() if the designated constraints aren't met.
+type PaginationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaginationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaginationMultiError) AllErrors() []error { return m }
+
+// PaginationValidationError is the validation error returned by
+// Pagination.Validate if the designated constraints aren't met.
+type PaginationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaginationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaginationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaginationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaginationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaginationValidationError) ErrorName() string { return "PaginationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaginationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPagination.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaginationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaginationValidationError{}
+
+// Validate checks the field values on ListReturnsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReturnsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReturnsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReturnsRequestMultiError, or nil if none found.
+func (m *ListReturnsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReturnsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReturnsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReturnsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReturnsRequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetPageSize() > 1000 {
+		err := ListReturnsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReturnsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReturnsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReturnsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReturnsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReturnsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReturnsRequestMultiError) AllErrors() []error { return m }
+
+// ListReturnsRequestValidationError is the validation error returned by
+// ListReturnsRequest.Validate if the designated constraints aren't met.
+type ListReturnsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReturnsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReturnsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReturnsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReturnsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReturnsRequestValidationError) ErrorName() string {
+	return "ListReturnsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReturnsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReturnsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReturnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReturnsRequestValidationError{}
+
+// Validate checks the field values on ExportOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportOrdersRequestMultiError, or nil if none found.
+func (m *ExportOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if _, ok := _ExportOrdersRequest_Statuses_NotInLookup[item]; ok {
+			err := ExportOrdersRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := OrderStatus_name[int32(item)]; !ok {
+			err := ExportOrdersRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetAcceptedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportOrdersRequestValidationError{
+					field:  "AcceptedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportOrdersRequestValidationError{
+					field:  "AcceptedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcceptedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportOrdersRequestValidationError{
+				field:  "AcceptedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAcceptedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportOrdersRequestValidationError{
+					field:  "AcceptedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportOrdersRequestValidationError{
+					field:  "AcceptedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcceptedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportOrdersRequestValidationError{
+				field:  "AcceptedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Phone != nil {
+
+		if utf8.RuneCountInString(m.GetPhone()) < 1 {
+			err := ExportOrdersRequestValidationError{
+				field:  "Phone",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PackageCode != nil {
+
+		if !_ExportOrdersRequest_PackageCode_Pattern.MatchString(m.GetPackageCode()) {
+			err := ExportOrdersRequestValidationError{
+				field:  "PackageCode",
+				reason: "value does not match regex pattern \"^[a-z0-9_-]+(\\\\+[a-z0-9_-]+)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ExportOrdersRequestValidationError is the validation error returned by
+// ExportOrdersRequest.Validate if the designated constraints aren't met.
+type ExportOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportOrdersRequestValidationError) ErrorName() string {
+	return "ExportOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportOrdersRequestValidationError{}
+
+var _ExportOrdersRequest_Statuses_NotInLookup = map[OrderStatus]struct{}{
+	0: {},
+}
+
+var _ExportOrdersRequest_PackageCode_Pattern = regexp.MustCompile("^[a-z0-9_-]+(\\+[a-z0-9_-]+)*$")
+
+// Validate checks the field values on ImportOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportOrdersRequestMultiError, or nil if none found.
+func (m *ImportOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOrders()) < 1 {
+		err := ImportOrdersRequestValidationError{
+			field:  "Orders",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOrdersRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOrdersRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportOrdersRequestValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ImportOrdersRequestValidationError is the validation error returned by
+// ImportOrdersRequest.Validate if the designated constraints aren't met.
+type ImportOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOrdersRequestValidationError) ErrorName() string {
+	return "ImportOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOrdersRequestValidationError{}
+
+// Validate checks the field values on ImportOrdersStreamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrdersStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOrdersStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportOrdersStreamRequestMultiError, or nil if none found.
+func (m *ImportOrdersStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOrdersStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	if m.GetOrder() == nil {
+		err := ImportOrdersStreamRequestValidationError{
+			field:  "Order",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportOrdersStreamRequestValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportOrdersStreamRequestValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportOrdersStreamRequestValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportOrdersStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOrdersStreamRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportOrdersStreamRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ImportOrdersStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOrdersStreamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOrdersStreamRequestMultiError) AllErrors() []error { return m }
+
+// ImportOrdersStreamRequestValidationError is the validation error returned by
+// ImportOrdersStreamRequest.Validate if the designated constraints aren't met.
+type ImportOrdersStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOrdersStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOrdersStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOrdersStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOrdersStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOrdersStreamRequestValidationError) ErrorName() string {
+	return "ImportOrdersStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOrdersStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOrdersStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOrdersStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOrdersStreamRequestValidationError{}
+
+// Validate checks the field values on ImportRowResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportRowResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRowResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRowResultMultiError, or nil if none found.
+func (m *ImportRowResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRowResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportRowResultValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportRowResultValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportRowResultValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportRowResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRowResultMultiError is an error wrapping multiple validation errors
+// returned by ImportRowResult.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRowResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRowResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRowResultMultiError) AllErrors() []error { return m }
+
+// ImportRowResultValidationError is the validation error returned by
+// ImportRowResult.Validate if the designated constraints aren't met.
+type ImportRowResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRowResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRowResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRowResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRowResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRowResultValidationError) ErrorName() string { return "ImportRowResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRowResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRowResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRowResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRowResultValidationError{}
+
+// Validate checks the field values on StartImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartImportRequestMultiError, or nil if none found.
+func (m *StartImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOrders()) < 1 {
+		err := StartImportRequestValidationError{
+			field:  "Orders",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartImportRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartImportRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartImportRequestValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StartImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartImportRequestMultiError is an error wrapping multiple validation errors
+// returned by StartImportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartImportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartImportRequestMultiError) AllErrors() []error { return m }
+
+// StartImportRequestValidationError is the validation error returned by
+// StartImportRequest.Validate if the designated constraints aren't met.
+type StartImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartImportRequestValidationError) ErrorName() string {
+	return "StartImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartImportRequestValidationError{}
+
+// Validate checks the field values on ImportJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportJobRequestMultiError, or nil if none found.
+func (m *ImportJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetJobId() <= 0 {
+		err := ImportJobRequestValidationError{
+			field:  "JobId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobRequestMultiError) AllErrors() []error { return m }
+
+// ImportJobRequestValidationError is the validation error returned by
+// ImportJobRequest.Validate if the designated constraints aren't met.
+type ImportJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobRequestValidationError) ErrorName() string { return "ImportJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobRequestValidationError{}
+
+// Validate checks the field values on ImportJobFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportJobFailureMultiError, or nil if none found.
+func (m *ImportJobFailure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobFailure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	// no validation rules for OrderId
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ImportJobFailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobFailureMultiError is an error wrapping multiple validation errors
+// returned by ImportJobFailure.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobFailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobFailureMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobFailureMultiError) AllErrors() []error { return m }
+
+// ImportJobFailureValidationError is the validation error returned by
+// ImportJobFailure.Validate if the designated constraints aren't met.
+type ImportJobFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobFailureValidationError) ErrorName() string { return "ImportJobFailureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobFailureValidationError{}
+
+// Validate checks the field values on ImportJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportJobMultiError, or nil
+// if none found.
+func (m *ImportJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Status
+
+	// no validation rules for Total
+
+	// no validation rules for Processed
+
+	// no validation rules for Imported
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJobValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobMultiError is an error wrapping multiple validation errors returned
+// by ImportJob.ValidateAll() if the designated constraints aren't met.
+type ImportJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobMultiError) AllErrors() []error { return m }
+
+// ImportJobValidationError is the validation error returned by
+// ImportJob.Validate if the designated constraints aren't met.
+type ImportJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobValidationError) ErrorName() string { return "ImportJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobValidationError{}
+
+// Validate checks the field values on GetHistoryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetHistoryRequestMultiError, or nil if none found.
+func (m *GetHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryRequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetPageSize() > 1000 {
+		err := GetHistoryRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetHistoryRequestMultiError is an error wrapping multiple validation errors
+// returned by GetHistoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetHistoryRequestValidationError is the validation error returned by
+// GetHistoryRequest.Validate if the designated constraints aren't met.
+type GetHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHistoryRequestValidationError) ErrorName() string {
+	return "GetHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHistoryRequestValidationError{}
+
+// Validate checks the field values on OrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderHistoryRequestMultiError, or nil if none found.
+func (m *OrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := OrderHistoryRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by OrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// OrderHistoryRequestValidationError is the validation error returned by
+// OrderHistoryRequest.Validate if the designated constraints aren't met.
+type OrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryRequestValidationError) ErrorName() string {
+	return "OrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryRequestValidationError{}
+
+// Validate checks the field values on OrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderHistoryResponseMultiError, or nil if none found.
+func (m *OrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by OrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// OrderHistoryResponseValidationError is the validation error returned by
+// OrderHistoryResponse.Validate if the designated constraints aren't met.
+type OrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryResponseValidationError) ErrorName() string {
+	return "OrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryResponseValidationError{}
+
+// Validate checks the field values on OrderResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderResponseMultiError, or
+// nil if none found.
+func (m *OrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return OrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderResponseMultiError) AllErrors() []error { return m }
+
+// OrderResponseValidationError is the validation error returned by
+// OrderResponse.Validate if the designated constraints aren't met.
+type OrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderResponseValidationError) ErrorName() string { return "OrderResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderResponseValidationError{}
+
+// Validate checks the field values on ProcessResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProcessResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProcessResultMultiError, or
+// nil if none found.
+func (m *ProcessResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStorageFees() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessResultValidationError{
+						field:  fmt.Sprintf("StorageFees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessResultValidationError{
+						field:  fmt.Sprintf("StorageFees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessResultValidationError{
+					field:  fmt.Sprintf("StorageFees[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalStorageFeeKopecks
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessResultValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessResultValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessResultValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProcessResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessResultMultiError is an error wrapping multiple validation errors
+// returned by ProcessResult.ValidateAll() if the designated constraints
+// aren't met.
+type ProcessResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessResultMultiError) AllErrors() []error { return m }
+
+// ProcessResultValidationError is the validation error returned by
+// ProcessResult.Validate if the designated constraints aren't met.
+type ProcessResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessResultValidationError) ErrorName() string { return "ProcessResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProcessResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessResultValidationError{}
+
+// Validate checks the field values on OrderResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderResultMultiError, or
+// nil if none found.
+func (m *OrderResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for Message
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return OrderResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderResultMultiError is an error wrapping multiple validation errors
+// returned by OrderResult.ValidateAll() if the designated constraints aren't met.
+type OrderResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderResultMultiError) AllErrors() []error { return m }
+
+// OrderResultValidationError is the validation error returned by
+// OrderResult.Validate if the designated constraints aren't met.
+type OrderResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderResultValidationError) ErrorName() string { return "OrderResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderResultValidationError{}
+
+// Validate checks the field values on StorageFee with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageFee) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageFee with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageFeeMultiError, or
+// nil if none found.
+func (m *StorageFee) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageFee) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for PaidDays
+
+	// no validation rules for DailyRateKopecks
+
+	// no validation rules for AmountKopecks
+
+	if len(errors) > 0 {
+		return StorageFeeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageFeeMultiError is an error wrapping multiple validation errors
+// returned by StorageFee.ValidateAll() if the designated constraints aren't met.
+type StorageFeeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageFeeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageFeeMultiError) AllErrors() []error { return m }
+
+// StorageFeeValidationError is the validation error returned by
+// StorageFee.Validate if the designated constraints aren't met.
+type StorageFeeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageFeeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageFeeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageFeeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageFeeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageFeeValidationError) ErrorName() string { return "StorageFeeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageFeeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageFee.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageFeeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageFeeValidationError{}
+
+// Validate checks the field values on OrdersList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrdersList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrdersList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrdersListMultiError, or
+// nil if none found.
+func (m *OrdersList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrdersList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrdersListValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrdersListValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrdersListValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return OrdersListMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrdersListMultiError is an error wrapping multiple validation errors
+// returned by OrdersList.ValidateAll() if the designated constraints aren't met.
+type OrdersListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrdersListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrdersListMultiError) AllErrors() []error { return m }
+
+// OrdersListValidationError is the validation error returned by
+// OrdersList.Validate if the designated constraints aren't met.
+type OrdersListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrdersListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrdersListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrdersListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrdersListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrdersListValidationError) ErrorName() string { return "OrdersListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrdersListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrdersList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrdersListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrdersListValidationError{}
+
+// Validate checks the field values on ReturnsList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnsList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnsList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnsListMultiError, or
+// nil if none found.
+func (m *ReturnsList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnsList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReturns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnsListValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnsListValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnsListValidationError{
+					field:  fmt.Sprintf("Returns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ReturnsListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnsListMultiError is an error wrapping multiple validation errors
+// returned by ReturnsList.ValidateAll() if the designated constraints aren't met.
+type ReturnsListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnsListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnsListMultiError) AllErrors() []error { return m }
+
+// ReturnsListValidationError is the validation error returned by
+// ReturnsList.Validate if the designated constraints aren't met.
+type ReturnsListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnsListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnsListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnsListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnsListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnsListValidationError) ErrorName() string { return "ReturnsListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReturnsListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnsList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnsListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnsListValidationError{}
+
+// Validate checks the field values on OrderHistoryList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderHistoryList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistoryList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderHistoryListMultiError, or nil if none found.
+func (m *OrderHistoryList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistoryList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderHistoryListValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderHistoryListValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderHistoryListValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return OrderHistoryListMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryListMultiError is an error wrapping multiple validation errors
+// returned by OrderHistoryList.ValidateAll() if the designated constraints
+// aren't met.
+type OrderHistoryListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryListMultiError) AllErrors() []error { return m }
+
+// OrderHistoryListValidationError is the validation error returned by
+// OrderHistoryList.Validate if the designated constraints aren't met.
+type OrderHistoryListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryListValidationError) ErrorName() string { return "OrderHistoryListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistoryList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryListValidationError{}
+
+// Validate checks the field values on ImportResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportResultMultiError, or
+// nil if none found.
+func (m *ImportResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Imported
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportResultValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportResultValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportResultValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportResultMultiError is an error wrapping multiple validation errors
+// returned by ImportResult.ValidateAll() if the designated constraints aren't met.
+type ImportResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportResultMultiError) AllErrors() []error { return m }
+
+// ImportResultValidationError is the validation error returned by
+// ImportResult.Validate if the designated constraints aren't met.
+type ImportResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportResultValidationError) ErrorName() string { return "ImportResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportResultValidationError{}
+
+// Validate checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Order) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OrderMultiError, or nil if none found.
+func (m *Order) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Order) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WeightGrams
+
+	// no validation rules for TotalPriceKopecks
+
+	// no validation rules for PvzId
+
+	// no validation rules for CellCode
+
+	// no validation rules for SellerId
+
+	// no validation rules for PackageCode
+
+	// no validation rules for CashOnDelivery
+
+	// no validation rules for PaymentStatus
+
+	// no validation rules for ShipmentId
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if len(errors) > 0 {
+		return OrderMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderMultiError is an error wrapping multiple validation errors returned by
+// Order.ValidateAll() if the designated constraints aren't met.
+type OrderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderMultiError) AllErrors() []error { return m }
+
+// OrderValidationError is the validation error returned by Order.Validate if
+// the designated constraints aren't met.
+type OrderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderValidationError) ErrorName() string { return "OrderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderValidationError{}
+
+// Validate checks the field values on ConfirmPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmPaymentRequestMultiError, or nil if none found.
+func (m *ConfirmPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := ConfirmPaymentRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmPaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmPaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmPaymentRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmPaymentRequestValidationError is the validation error returned by
+// ConfirmPaymentRequest.Validate if the designated constraints aren't met.
+type ConfirmPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmPaymentRequestValidationError) ErrorName() string {
+	return "ConfirmPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmPaymentRequestValidationError{}
+
+// Validate checks the field values on OrderHistory with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderHistory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistory with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderHistoryMultiError, or
+// nil if none found.
+func (m *OrderHistory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderHistoryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PvzId
+
+	if all {
+		switch v := interface{}(m.GetActor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderHistoryValidationError{
+				field:  "Actor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	// no validation rules for Comment
+
+	if m.PrevStatus != nil {
+		// no validation rules for PrevStatus
+	}
+
+	if len(errors) > 0 {
+		return OrderHistoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryMultiError is an error wrapping multiple validation errors
+// returned by OrderHistory.ValidateAll() if the designated constraints aren't met.
+type OrderHistoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryMultiError) AllErrors() []error { return m }
+
+// OrderHistoryValidationError is the validation error returned by
+// OrderHistory.Validate if the designated constraints aren't met.
+type OrderHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryValidationError) ErrorName() string { return "OrderHistoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryValidationError{}
+
+// Validate checks the field values on Actor with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Actor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Actor with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ActorMultiError, or nil if none found.
+func (m *Actor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Actor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ActorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActorMultiError is an error wrapping multiple validation errors returned by
+// Actor.ValidateAll() if the designated constraints aren't met.
+type ActorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActorMultiError) AllErrors() []error { return m }
+
+// ActorValidationError is the validation error returned by Actor.Validate if
+// the designated constraints aren't met.
+type ActorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActorValidationError) ErrorName() string { return "ActorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActorValidationError{}
+
+// Validate checks the field values on GetAllowedActionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllowedActionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllowedActionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllowedActionsRequestMultiError, or nil if none found.
+func (m *GetAllowedActionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllowedActionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := GetAllowedActionsRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAllowedActionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllowedActionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllowedActionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllowedActionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllowedActionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllowedActionsRequestMultiError) AllErrors() []error { return m }
+
+// GetAllowedActionsRequestValidationError is the validation error returned by
+// GetAllowedActionsRequest.Validate if the designated constraints aren't met.
+type GetAllowedActionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllowedActionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllowedActionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllowedActionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllowedActionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllowedActionsRequestValidationError) ErrorName() string {
+	return "GetAllowedActionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllowedActionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllowedActionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllowedActionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllowedActionsRequestValidationError{}
+
+// Validate checks the field values on AllowedActionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AllowedActionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllowedActionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllowedActionsResponseMultiError, or nil if none found.
+func (m *AllowedActionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllowedActionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AllowedActionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllowedActionsResponseMultiError is an error wrapping multiple validation
+// errors returned by AllowedActionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AllowedActionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllowedActionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllowedActionsResponseMultiError) AllErrors() []error { return m }
+
+// AllowedActionsResponseValidationError is the validation error returned by
+// AllowedActionsResponse.Validate if the designated constraints aren't met.
+type AllowedActionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllowedActionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllowedActionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllowedActionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllowedActionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllowedActionsResponseValidationError) ErrorName() string {
+	return "AllowedActionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AllowedActionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllowedActionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllowedActionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllowedActionsResponseValidationError{}
+
+// Validate checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendStorageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendStorageRequestMultiError, or nil if none found.
+func (m *ExtendStorageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendStorageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := ExtendStorageRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDays() <= 0 {
+		err := ExtendStorageRequestValidationError{
+			field:  "Days",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExtendStorageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendStorageRequestMultiError is an error wrapping multiple validation
+// errors returned by ExtendStorageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendStorageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendStorageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendStorageRequestMultiError) AllErrors() []error { return m }
+
+// ExtendStorageRequestValidationError is the validation error returned by
+// ExtendStorageRequest.Validate if the designated constraints aren't met.
+type ExtendStorageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendStorageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendStorageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendStorageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendStorageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendStorageRequestValidationError) ErrorName() string {
+	return "ExtendStorageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendStorageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendStorageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendStorageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendStorageRequestValidationError{}
+
+// Validate checks the field values on ExtendStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendStorageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendStorageResponseMultiError, or nil if none found.
+func (m *ExtendStorageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendStorageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendStorageResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendStorageResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendStorageResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FeeKopecks
+
+	if len(errors) > 0 {
+		return ExtendStorageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendStorageResponseMultiError is an error wrapping multiple validation
+// errors returned by ExtendStorageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendStorageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendStorageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendStorageResponseMultiError) AllErrors() []error { return m }
+
+// ExtendStorageResponseValidationError is the validation error returned by
+// ExtendStorageResponse.Validate if the designated constraints aren't met.
+type ExtendStorageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendStorageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendStorageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendStorageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendStorageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendStorageResponseValidationError) ErrorName() string {
+	return "ExtendStorageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendStorageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendStorageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendStorageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendStorageResponseValidationError{}
+
+// Validate checks the field values on MoveOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveOrderRequestMultiError, or nil if none found.
+func (m *MoveOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := MoveOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCellCode()) < 1 {
+		err := MoveOrderRequestValidationError{
+			field:  "CellCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveOrderRequestMultiError) AllErrors() []error { return m }
+
+// MoveOrderRequestValidationError is the validation error returned by
+// MoveOrderRequest.Validate if the designated constraints aren't met.
+type MoveOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveOrderRequestValidationError) ErrorName() string { return "MoveOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveOrderRequestValidationError{}
+
+// Validate checks the field values on CreateStorageCellRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateStorageCellRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateStorageCellRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateStorageCellRequestMultiError, or nil if none found.
+func (m *CreateStorageCellRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateStorageCellRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateStorageCellRequest_Size_NotInLookup[m.GetSize()]; ok {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Size",
+			reason: "value must not be in list [CELL_SIZE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CellSize_name[int32(m.GetSize())]; !ok {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Size",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCapacity() <= 0 {
+		err := CreateStorageCellRequestValidationError{
+			field:  "Capacity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateStorageCellRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateStorageCellRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateStorageCellRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateStorageCellRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateStorageCellRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateStorageCellRequestMultiError) AllErrors() []error { return m }
+
+// CreateStorageCellRequestValidationError is the validation error returned by
+// CreateStorageCellRequest.Validate if the designated constraints aren't met.
+type CreateStorageCellRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateStorageCellRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateStorageCellRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateStorageCellRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateStorageCellRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateStorageCellRequestValidationError) ErrorName() string {
+	return "CreateStorageCellRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateStorageCellRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateStorageCellRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateStorageCellRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateStorageCellRequestValidationError{}
+
+var _CreateStorageCellRequest_Size_NotInLookup = map[CellSize]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListStorageCellsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStorageCellsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStorageCellsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStorageCellsRequestMultiError, or nil if none found.
+func (m *ListStorageCellsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStorageCellsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListStorageCellsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStorageCellsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStorageCellsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStorageCellsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStorageCellsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStorageCellsRequestMultiError) AllErrors() []error { return m }
+
+// ListStorageCellsRequestValidationError is the validation error returned by
+// ListStorageCellsRequest.Validate if the designated constraints aren't met.
+type ListStorageCellsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStorageCellsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStorageCellsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStorageCellsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStorageCellsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStorageCellsRequestValidationError) ErrorName() string {
+	return "ListStorageCellsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStorageCellsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStorageCellsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStorageCellsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStorageCellsRequestValidationError{}
+
+// Validate checks the field values on StorageCell with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageCell) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageCell with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageCellMultiError, or
+// nil if none found.
+func (m *StorageCell) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageCell) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Size
+
+	// no validation rules for Capacity
+
+	// no validation rules for Occupied
+
+	if len(errors) > 0 {
+		return StorageCellMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageCellMultiError is an error wrapping multiple validation errors
+// returned by StorageCell.ValidateAll() if the designated constraints aren't met.
+type StorageCellMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageCellMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageCellMultiError) AllErrors() []error { return m }
+
+// StorageCellValidationError is the validation error returned by
+// StorageCell.Validate if the designated constraints aren't met.
+type StorageCellValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageCellValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageCellValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageCellValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageCellValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageCellValidationError) ErrorName() string { return "StorageCellValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageCellValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageCell.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageCellValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageCellValidationError{}
+
+// Validate checks the field values on StorageCellsList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StorageCellsList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageCellsList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageCellsListMultiError, or nil if none found.
+func (m *StorageCellsList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageCellsList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCells() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageCellsListValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageCellsListValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageCellsListValidationError{
+					field:  fmt.Sprintf("Cells[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StorageCellsListMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageCellsListMultiError is an error wrapping multiple validation errors
+// returned by StorageCellsList.ValidateAll() if the designated constraints
+// aren't met.
+type StorageCellsListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageCellsListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageCellsListMultiError) AllErrors() []error { return m }
+
+// StorageCellsListValidationError is the validation error returned by
+// StorageCellsList.Validate if the designated constraints aren't met.
+type StorageCellsListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageCellsListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageCellsListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageCellsListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageCellsListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageCellsListValidationError) ErrorName() string { return "StorageCellsListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageCellsListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageCellsList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageCellsListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageCellsListValidationError{}
+
+// Validate checks the field values on SetReturnPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetReturnPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetReturnPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetReturnPolicyRequestMultiError, or nil if none found.
+func (m *SetReturnPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetReturnPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := SetReturnPolicyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SellerId
+
+	// no validation rules for WindowHours
+
+	// no validation rules for Returnable
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if m.PackageCode != nil {
+
+		if !_SetReturnPolicyRequest_PackageCode_Pattern.MatchString(m.GetPackageCode()) {
+			err := SetReturnPolicyRequestValidationError{
+				field:  "PackageCode",
+				reason: "value does not match regex pattern \"^[a-z0-9_-]+(\\\\+[a-z0-9_-]+)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetReturnPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetReturnPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetReturnPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetReturnPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetReturnPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetReturnPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetReturnPolicyRequestValidationError is the validation error returned by
+// SetReturnPolicyRequest.Validate if the designated constraints aren't met.
+type SetReturnPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetReturnPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetReturnPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetReturnPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetReturnPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetReturnPolicyRequestValidationError) ErrorName() string {
+	return "SetReturnPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetReturnPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetReturnPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetReturnPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetReturnPolicyRequestValidationError{}
+
+var _SetReturnPolicyRequest_PackageCode_Pattern = regexp.MustCompile("^[a-z0-9_-]+(\\+[a-z0-9_-]+)*$")
+
+// Validate checks the field values on ListReturnPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReturnPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReturnPoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReturnPoliciesRequestMultiError, or nil if none found.
+func (m *ListReturnPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReturnPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListReturnPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReturnPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReturnPoliciesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListReturnPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReturnPoliciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReturnPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// ListReturnPoliciesRequestValidationError is the validation error returned by
+// ListReturnPoliciesRequest.Validate if the designated constraints aren't met.
+type ListReturnPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReturnPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReturnPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReturnPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReturnPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReturnPoliciesRequestValidationError) ErrorName() string {
+	return "ListReturnPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReturnPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReturnPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReturnPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReturnPoliciesRequestValidationError{}
+
+// Validate checks the field values on ReturnPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnPolicyMultiError, or
+// nil if none found.
+func (m *ReturnPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for SellerId
+
+	// no validation rules for WindowHours
+
+	// no validation rules for Returnable
+
+	// no validation rules for PackageCode
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if len(errors) > 0 {
+		return ReturnPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnPolicyMultiError is an error wrapping multiple validation errors
+// returned by ReturnPolicy.ValidateAll() if the designated constraints aren't met.
+type ReturnPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnPolicyMultiError) AllErrors() []error { return m }
+
+// ReturnPolicyValidationError is the validation error returned by
+// ReturnPolicy.Validate if the designated constraints aren't met.
+type ReturnPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnPolicyValidationError) ErrorName() string { return "ReturnPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReturnPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnPolicyValidationError{}
+
+// Validate checks the field values on ReturnPoliciesList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnPoliciesList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnPoliciesList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnPoliciesListMultiError, or nil if none found.
+func (m *ReturnPoliciesList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnPoliciesList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnPoliciesListValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnPoliciesListValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnPoliciesListValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReturnPoliciesListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnPoliciesListMultiError is an error wrapping multiple validation errors
+// returned by ReturnPoliciesList.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnPoliciesListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnPoliciesListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnPoliciesListMultiError) AllErrors() []error { return m }
+
+// ReturnPoliciesListValidationError is the validation error returned by
+// ReturnPoliciesList.Validate if the designated constraints aren't met.
+type ReturnPoliciesListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnPoliciesListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnPoliciesListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnPoliciesListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnPoliciesListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnPoliciesListValidationError) ErrorName() string {
+	return "ReturnPoliciesListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnPoliciesListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnPoliciesList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnPoliciesListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnPoliciesListValidationError{}
+
+// Validate checks the field values on CreatePickupPointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePickupPointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePickupPointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePickupPointRequestMultiError, or nil if none found.
+func (m *CreatePickupPointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePickupPointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreatePickupPointRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return CreatePickupPointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePickupPointRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePickupPointRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePickupPointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePickupPointRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePickupPointRequestMultiError) AllErrors() []error { return m }
+
+// CreatePickupPointRequestValidationError is the validation error returned by
+// CreatePickupPointRequest.Validate if the designated constraints aren't met.
+type CreatePickupPointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePickupPointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePickupPointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePickupPointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePickupPointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePickupPointRequestValidationError) ErrorName() string {
+	return "CreatePickupPointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePickupPointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePickupPointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePickupPointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePickupPointRequestValidationError{}
+
+// Validate checks the field values on ListPickupPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPickupPointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPickupPointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPickupPointsRequestMultiError, or nil if none found.
+func (m *ListPickupPointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPickupPointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPickupPointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPickupPointsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPickupPointsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPickupPointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPickupPointsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPickupPointsRequestMultiError) AllErrors() []error { return m }
+
+// ListPickupPointsRequestValidationError is the validation error returned by
+// ListPickupPointsRequest.Validate if the designated constraints aren't met.
+type ListPickupPointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPickupPointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPickupPointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPickupPointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPickupPointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPickupPointsRequestValidationError) ErrorName() string {
+	return "ListPickupPointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPickupPointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPickupPointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPickupPointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPickupPointsRequestValidationError{}
+
+// Validate checks the field values on PickupPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PickupPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PickupPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PickupPointMultiError, or
+// nil if none found.
+func (m *PickupPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PickupPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Address
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PickupPointValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PickupPointValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PickupPointValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PickupPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// PickupPointMultiError is an error wrapping multiple validation errors
+// returned by PickupPoint.ValidateAll() if the designated constraints aren't met.
+type PickupPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PickupPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PickupPointMultiError) AllErrors() []error { return m }
+
+// PickupPointValidationError is the validation error returned by
+// PickupPoint.Validate if the designated constraints aren't met.
+type PickupPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PickupPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PickupPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PickupPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PickupPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PickupPointValidationError) ErrorName() string { return "PickupPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PickupPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPickupPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PickupPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PickupPointValidationError{}
+
+// Validate checks the field values on PickupPointsList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PickupPointsList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PickupPointsList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PickupPointsListMultiError, or nil if none found.
+func (m *PickupPointsList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PickupPointsList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PickupPointsListValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PickupPointsListValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PickupPointsListValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PickupPointsListMultiError(errors)
+	}
+
+	return nil
+}
+
+// PickupPointsListMultiError is an error wrapping multiple validation errors
+// returned by PickupPointsList.ValidateAll() if the designated constraints
+// aren't met.
+type PickupPointsListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PickupPointsListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PickupPointsListMultiError) AllErrors() []error { return m }
+
+// PickupPointsListValidationError is the validation error returned by
+// PickupPointsList.Validate if the designated constraints aren't met.
+type PickupPointsListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PickupPointsListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PickupPointsListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PickupPointsListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PickupPointsListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PickupPointsListValidationError) ErrorName() string { return "PickupPointsListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PickupPointsListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPickupPointsList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PickupPointsListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PickupPointsListValidationError{}
+
+// Validate checks the field values on PackageTypeDefinition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PackageTypeDefinition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackageTypeDefinition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PackageTypeDefinitionMultiError, or nil if none found.
+func (m *PackageTypeDefinition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackageTypeDefinition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Kind
+
+	// no validation rules for MaxWeightGrams
+
+	// no validation rules for PriceKopecks
+
+	if len(errors) > 0 {
+		return PackageTypeDefinitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackageTypeDefinitionMultiError is an error wrapping multiple validation
+// errors returned by PackageTypeDefinition.ValidateAll() if the designated
+// constraints aren't met.
+type PackageTypeDefinitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackageTypeDefinitionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackageTypeDefinitionMultiError) AllErrors() []error { return m }
+
+// PackageTypeDefinitionValidationError is the validation error returned by
+// PackageTypeDefinition.Validate if the designated constraints aren't met.
+type PackageTypeDefinitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackageTypeDefinitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackageTypeDefinitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackageTypeDefinitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackageTypeDefinitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackageTypeDefinitionValidationError) ErrorName() string {
+	return "PackageTypeDefinitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PackageTypeDefinitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackageTypeDefinition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackageTypeDefinitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackageTypeDefinitionValidationError{}
+
+// Validate checks the field values on CreatePackageTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePackageTypeRequestMultiError, or nil if none found.
+func (m *CreatePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreatePackageTypeRequest_Code_Pattern.MatchString(m.GetCode()) {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[a-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreatePackageTypeRequest_Kind_NotInLookup[m.GetKind()]; ok {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "Kind",
+			reason: "value must not be in list [PACKAGE_KIND_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PackageKind_name[int32(m.GetKind())]; !ok {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "Kind",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWeightGrams() < 0 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "MaxWeightGrams",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPriceKopecks() < 0 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "PriceKopecks",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreatePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePackageTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePackageTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePackageTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// CreatePackageTypeRequestValidationError is the validation error returned by
+// CreatePackageTypeRequest.Validate if the designated constraints aren't met.
+type CreatePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePackageTypeRequestValidationError) ErrorName() string {
+	return "CreatePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePackageTypeRequestValidationError{}
+
+var _CreatePackageTypeRequest_Code_Pattern = regexp.MustCompile("^[a-z0-9_-]+$")
+
+var _CreatePackageTypeRequest_Kind_NotInLookup = map[PackageKind]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UpdatePackageTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePackageTypeRequestMultiError, or nil if none found.
+func (m *UpdatePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_UpdatePackageTypeRequest_Code_Pattern.MatchString(m.GetCode()) {
+		err := UpdatePackageTypeRequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[a-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdatePackageTypeRequest_Kind_NotInLookup[m.GetKind()]; ok {
+		err := UpdatePackageTypeRequestValidationError{
+			field:  "Kind",
+			reason: "value must not be in list [PACKAGE_KIND_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PackageKind_name[int32(m.GetKind())]; !ok {
+		err := UpdatePackageTypeRequestValidationError{
+			field:  "Kind",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWeightGrams() < 0 {
+		err := UpdatePackageTypeRequestValidationError{
+			field:  "MaxWeightGrams",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPriceKopecks() < 0 {
+		err := UpdatePackageTypeRequestValidationError{
+			field:  "PriceKopecks",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdatePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePackageTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePackageTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePackageTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePackageTypeRequestValidationError is the validation error returned by
+// UpdatePackageTypeRequest.Validate if the designated constraints aren't met.
+type UpdatePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePackageTypeRequestValidationError) ErrorName() string {
+	return "UpdatePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePackageTypeRequestValidationError{}
+
+var _UpdatePackageTypeRequest_Code_Pattern = regexp.MustCompile("^[a-z0-9_-]+$")
+
+var _UpdatePackageTypeRequest_Kind_NotInLookup = map[PackageKind]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on DeletePackageTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePackageTypeRequestMultiError, or nil if none found.
+func (m *DeletePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := DeletePackageTypeRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePackageTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePackageTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePackageTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// DeletePackageTypeRequestValidationError is the validation error returned by
+// DeletePackageTypeRequest.Validate if the designated constraints aren't met.
+type DeletePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePackageTypeRequestValidationError) ErrorName() string {
+	return "DeletePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePackageTypeRequestValidationError{}
+
+// Validate checks the field values on DeletePackageTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePackageTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePackageTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePackageTypeResponseMultiError, or nil if none found.
+func (m *DeletePackageTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePackageTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePackageTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePackageTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePackageTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeletePackageTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePackageTypeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePackageTypeResponseMultiError) AllErrors() []error { return m }
+
+// DeletePackageTypeResponseValidationError is the validation error returned by
+// DeletePackageTypeResponse.Validate if the designated constraints aren't met.
+type DeletePackageTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePackageTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePackageTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePackageTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePackageTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePackageTypeResponseValidationError) ErrorName() string {
+	return "DeletePackageTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePackageTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePackageTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePackageTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePackageTypeResponseValidationError{}
+
+// Validate checks the field values on ListPackageTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPackageTypesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPackageTypesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPackageTypesRequestMultiError, or nil if none found.
+func (m *ListPackageTypesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPackageTypesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPackageTypesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPackageTypesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPackageTypesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPackageTypesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPackageTypesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPackageTypesRequestMultiError) AllErrors() []error { return m }
+
+// ListPackageTypesRequestValidationError is the validation error returned by
+// ListPackageTypesRequest.Validate if the designated constraints aren't met.
+type ListPackageTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPackageTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPackageTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPackageTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPackageTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPackageTypesRequestValidationError) ErrorName() string {
+	return "ListPackageTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPackageTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPackageTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPackageTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPackageTypesRequestValidationError{}
+
+// Validate checks the field values on PackageTypesList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PackageTypesList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackageTypesList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PackageTypesListMultiError, or nil if none found.
+func (m *PackageTypesList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackageTypesList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPackageTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PackageTypesListValidationError{
+						field:  fmt.Sprintf("PackageTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PackageTypesListValidationError{
+						field:  fmt.Sprintf("PackageTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PackageTypesListValidationError{
+					field:  fmt.Sprintf("PackageTypes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PackageTypesListMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackageTypesListMultiError is an error wrapping multiple validation errors
+// returned by PackageTypesList.ValidateAll() if the designated constraints
+// aren't met.
+type PackageTypesListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackageTypesListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackageTypesListMultiError) AllErrors() []error { return m }
+
+// PackageTypesListValidationError is the validation error returned by
+// PackageTypesList.Validate if the designated constraints aren't met.
+type PackageTypesListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackageTypesListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackageTypesListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackageTypesListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackageTypesListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackageTypesListValidationError) ErrorName() string { return "PackageTypesListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackageTypesListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackageTypesList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackageTypesListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackageTypesListValidationError{}
+
+// Validate checks the field values on AnnounceOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnnounceOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnnounceOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnnounceOrdersRequestMultiError, or nil if none found.
+func (m *AnnounceOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnnounceOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShipmentId()) < 1 {
+		err := AnnounceOrdersRequestValidationError{
+			field:  "ShipmentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOrders()) < 1 {
+		err := AnnounceOrdersRequestValidationError{
+			field:  "Orders",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnnounceOrdersRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnnounceOrdersRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnnounceOrdersRequestValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnnounceOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnounceOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by AnnounceOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AnnounceOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnounceOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnounceOrdersRequestMultiError) AllErrors() []error { return m }
+
+// AnnounceOrdersRequestValidationError is the validation error returned by
+// AnnounceOrdersRequest.Validate if the designated constraints aren't met.
+type AnnounceOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnounceOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnounceOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnounceOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnounceOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnounceOrdersRequestValidationError) ErrorName() string {
+	return "AnnounceOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnnounceOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnounceOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnounceOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnounceOrdersRequestValidationError{}
+
+// Validate checks the field values on AnnounceOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnnounceOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnnounceOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnnounceOrdersResponseMultiError, or nil if none found.
+func (m *AnnounceOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnnounceOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Announced
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnnounceOrdersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnnounceOrdersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnnounceOrdersResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnnounceOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnounceOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by AnnounceOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AnnounceOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnounceOrdersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnounceOrdersResponseMultiError) AllErrors() []error { return m }
+
+// AnnounceOrdersResponseValidationError is the validation error returned by
+// AnnounceOrdersResponse.Validate if the designated constraints aren't met.
+type AnnounceOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnounceOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnounceOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnounceOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnounceOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnounceOrdersResponseValidationError) ErrorName() string {
+	return "AnnounceOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnnounceOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnounceOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnounceOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnounceOrdersResponseValidationError{}
+
+// Validate checks the field values on ConfirmArrivalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmArrivalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmArrivalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmArrivalRequestMultiError, or nil if none found.
+func (m *ConfirmArrivalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmArrivalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShipmentId()) < 1 {
+		err := ConfirmArrivalRequestValidationError{
+			field:  "ShipmentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ConfirmArrivalRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConfirmArrivalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmArrivalRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmArrivalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmArrivalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmArrivalRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmArrivalRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmArrivalRequestValidationError is the validation error returned by
+// ConfirmArrivalRequest.Validate if the designated constraints aren't met.
+type ConfirmArrivalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmArrivalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmArrivalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmArrivalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmArrivalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmArrivalRequestValidationError) ErrorName() string {
+	return "ConfirmArrivalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmArrivalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmArrivalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmArrivalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmArrivalRequestValidationError{}
+
+// Validate checks the field values on Discrepancy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Discrepancy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Discrepancy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiscrepancyMultiError, or
+// nil if none found.
+func (m *Discrepancy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Discrepancy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShipmentId
+
+	// no validation rules for OrderId
+
+	// no validation rules for Kind
+
+	if all {
+		switch v := interface{}(m.GetDetectedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscrepancyValidationError{
+					field:  "DetectedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscrepancyValidationError{
+					field:  "DetectedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDetectedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscrepancyValidationError{
+				field:  "DetectedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PvzId
+
+	if len(errors) > 0 {
+		return DiscrepancyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscrepancyMultiError is an error wrapping multiple validation errors
+// returned by Discrepancy.ValidateAll() if the designated constraints aren't met.
+type DiscrepancyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscrepancyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscrepancyMultiError) AllErrors() []error { return m }
+
+// DiscrepancyValidationError is the validation error returned by
+// Discrepancy.Validate if the designated constraints aren't met.
+type DiscrepancyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscrepancyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscrepancyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscrepancyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscrepancyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscrepancyValidationError) ErrorName() string { return "DiscrepancyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiscrepancyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscrepancy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscrepancyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscrepancyValidationError{}
+
+// Validate checks the field values on ArrivalReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ArrivalReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArrivalReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ArrivalReportMultiError, or
+// nil if none found.
+func (m *ArrivalReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArrivalReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShipmentId
+
+	for idx, item := range m.GetDiscrepancies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ArrivalReportValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ArrivalReportValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ArrivalReportValidationError{
+					field:  fmt.Sprintf("Discrepancies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ArrivalReportValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ArrivalReportValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ArrivalReportValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ArrivalReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArrivalReportMultiError is an error wrapping multiple validation errors
+// returned by ArrivalReport.ValidateAll() if the designated constraints
+// aren't met.
+type ArrivalReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArrivalReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArrivalReportMultiError) AllErrors() []error { return m }
+
+// ArrivalReportValidationError is the validation error returned by
+// ArrivalReport.Validate if the designated constraints aren't met.
+type ArrivalReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArrivalReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArrivalReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArrivalReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArrivalReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArrivalReportValidationError) ErrorName() string { return "ArrivalReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArrivalReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArrivalReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArrivalReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArrivalReportValidationError{}
+
+// Validate checks the field values on GetDiscrepancyReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDiscrepancyReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDiscrepancyReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDiscrepancyReportRequestMultiError, or nil if none found.
+func (m *GetDiscrepancyReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDiscrepancyReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.ShipmentId != nil {
+		// no validation rules for ShipmentId
+	}
+
+	if len(errors) > 0 {
+		return GetDiscrepancyReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDiscrepancyReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDiscrepancyReportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDiscrepancyReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDiscrepancyReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDiscrepancyReportRequestMultiError) AllErrors() []error { return m }
+
+// GetDiscrepancyReportRequestValidationError is the validation error returned
+// by GetDiscrepancyReportRequest.Validate if the designated constraints
+// aren't met.
+type GetDiscrepancyReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDiscrepancyReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDiscrepancyReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDiscrepancyReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDiscrepancyReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDiscrepancyReportRequestValidationError) ErrorName() string {
+	return "GetDiscrepancyReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDiscrepancyReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDiscrepancyReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDiscrepancyReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDiscrepancyReportRequestValidationError{}
+
+// Validate checks the field values on DiscrepancyReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiscrepancyReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscrepancyReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscrepancyReportMultiError, or nil if none found.
+func (m *DiscrepancyReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscrepancyReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDiscrepancies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscrepancyReportValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscrepancyReportValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscrepancyReportValidationError{
+					field:  fmt.Sprintf("Discrepancies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiscrepancyReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscrepancyReportMultiError is an error wrapping multiple validation errors
+// returned by DiscrepancyReport.ValidateAll() if the designated constraints
+// aren't met.
+type DiscrepancyReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscrepancyReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscrepancyReportMultiError) AllErrors() []error { return m }
+
+// DiscrepancyReportValidationError is the validation error returned by
+// DiscrepancyReport.Validate if the designated constraints aren't met.
+type DiscrepancyReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscrepancyReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscrepancyReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscrepancyReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscrepancyReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscrepancyReportValidationError) ErrorName() string {
+	return "DiscrepancyReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscrepancyReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscrepancyReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscrepancyReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscrepancyReportValidationError{}
+
+// Validate checks the field values on ReturnManifestItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnManifestItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnManifestItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnManifestItemMultiError, or nil if none found.
+func (m *ReturnManifestItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnManifestItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	// no validation rules for CellCode
+
+	// no validation rules for WeightGrams
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnManifestItemValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnManifestItemValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnManifestItemValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ReturnManifestItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnManifestItemMultiError is an error wrapping multiple validation errors
+// returned by ReturnManifestItem.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnManifestItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnManifestItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnManifestItemMultiError) AllErrors() []error { return m }
+
+// ReturnManifestItemValidationError is the validation error returned by
+// ReturnManifestItem.Validate if the designated constraints aren't met.
+type ReturnManifestItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnManifestItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnManifestItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnManifestItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnManifestItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnManifestItemValidationError) ErrorName() string {
+	return "ReturnManifestItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnManifestItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnManifestItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnManifestItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnManifestItemValidationError{}
+
+// Validate checks the field values on ReturnManifest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnManifest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnManifest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnManifestMultiError,
+// or nil if none found.
+func (m *ReturnManifest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnManifest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ManifestId
+
+	// no validation rules for PvzId
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnManifestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnManifestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnManifestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnManifestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnManifestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnManifestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.HandedOverAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetHandedOverAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnManifestValidationError{
+						field:  "HandedOverAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnManifestValidationError{
+						field:  "HandedOverAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHandedOverAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnManifestValidationError{
+					field:  "HandedOverAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReturnManifestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnManifestMultiError is an error wrapping multiple validation errors
+// returned by ReturnManifest.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnManifestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnManifestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnManifestMultiError) AllErrors() []error { return m }
+
+// ReturnManifestValidationError is the validation error returned by
+// ReturnManifest.Validate if the designated constraints aren't met.
+type ReturnManifestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnManifestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnManifestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnManifestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnManifestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnManifestValidationError) ErrorName() string { return "ReturnManifestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReturnManifestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnManifest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnManifestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnManifestValidationError{}
+
+// Validate checks the field values on SweepExpiredOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SweepExpiredOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SweepExpiredOrdersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SweepExpiredOrdersRequestMultiError, or nil if none found.
+func (m *SweepExpiredOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SweepExpiredOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SweepExpiredOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SweepExpiredOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by SweepExpiredOrdersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SweepExpiredOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SweepExpiredOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SweepExpiredOrdersRequestMultiError) AllErrors() []error { return m }
+
+// SweepExpiredOrdersRequestValidationError is the validation error returned by
+// SweepExpiredOrdersRequest.Validate if the designated constraints aren't met.
+type SweepExpiredOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SweepExpiredOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SweepExpiredOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SweepExpiredOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SweepExpiredOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SweepExpiredOrdersRequestValidationError) ErrorName() string {
+	return "SweepExpiredOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SweepExpiredOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSweepExpiredOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SweepExpiredOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SweepExpiredOrdersRequestValidationError{}
+
+// Validate checks the field values on ListReturnManifestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReturnManifestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReturnManifestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReturnManifestsRequestMultiError, or nil if none found.
+func (m *ListReturnManifestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReturnManifestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListReturnManifestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReturnManifestsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReturnManifestsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReturnManifestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReturnManifestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReturnManifestsRequestMultiError) AllErrors() []error { return m }
+
+// ListReturnManifestsRequestValidationError is the validation error returned
+// by ListReturnManifestsRequest.Validate if the designated constraints aren't met.
+type ListReturnManifestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReturnManifestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReturnManifestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReturnManifestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReturnManifestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReturnManifestsRequestValidationError) ErrorName() string {
+	return "ListReturnManifestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReturnManifestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReturnManifestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReturnManifestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReturnManifestsRequestValidationError{}
+
+// Validate checks the field values on ReturnManifestsList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnManifestsList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnManifestsList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnManifestsListMultiError, or nil if none found.
+func (m *ReturnManifestsList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnManifestsList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetManifests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnManifestsListValidationError{
+						field:  fmt.Sprintf("Manifests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnManifestsListValidationError{
+						field:  fmt.Sprintf("Manifests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnManifestsListValidationError{
+					field:  fmt.Sprintf("Manifests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReturnManifestsListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnManifestsListMultiError is an error wrapping multiple validation
+// errors returned by ReturnManifestsList.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnManifestsListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnManifestsListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnManifestsListMultiError) AllErrors() []error { return m }
+
+// ReturnManifestsListValidationError is the validation error returned by
+// ReturnManifestsList.Validate if the designated constraints aren't met.
+type ReturnManifestsListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnManifestsListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnManifestsListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnManifestsListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnManifestsListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnManifestsListValidationError) ErrorName() string {
+	return "ReturnManifestsListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnManifestsListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnManifestsList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnManifestsListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnManifestsListValidationError{}
+
+// Validate checks the field values on ReturnManifestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnManifestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnManifestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnManifestRequestMultiError, or nil if none found.
+func (m *ReturnManifestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnManifestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetManifestId() <= 0 {
+		err := ReturnManifestRequestValidationError{
+			field:  "ManifestId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReturnManifestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnManifestRequestMultiError is an error wrapping multiple validation
+// errors returned by ReturnManifestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnManifestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnManifestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnManifestRequestMultiError) AllErrors() []error { return m }
+
+// ReturnManifestRequestValidationError is the validation error returned by
+// ReturnManifestRequest.Validate if the designated constraints aren't met.
+type ReturnManifestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnManifestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnManifestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnManifestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnManifestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnManifestRequestValidationError) ErrorName() string {
+	return "ReturnManifestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnManifestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnManifestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnManifestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnManifestRequestValidationError{}
+
+// Validate checks the field values on ExportReturnManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReturnManifestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReturnManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReturnManifestRequestMultiError, or nil if none found.
+func (m *ExportReturnManifestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReturnManifestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetManifestId() <= 0 {
+		err := ExportReturnManifestRequestValidationError{
+			field:  "ManifestId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ExportReturnManifestRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportReturnManifestRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv json]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportReturnManifestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReturnManifestRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportReturnManifestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExportReturnManifestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReturnManifestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReturnManifestRequestMultiError) AllErrors() []error { return m }
+
+// ExportReturnManifestRequestValidationError is the validation error returned
+// by ExportReturnManifestRequest.Validate if the designated constraints
+// aren't met.
+type ExportReturnManifestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReturnManifestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReturnManifestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReturnManifestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReturnManifestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReturnManifestRequestValidationError) ErrorName() string {
+	return "ExportReturnManifestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportReturnManifestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportReturnManifestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportReturnManifestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportReturnManifestRequestValidationError{}
+
+var _ExportReturnManifestRequest_Format_InLookup = map[string]struct{}{
+	"csv":  {},
+	"json": {},
+}
+
+// Validate checks the field values on ExportReturnManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReturnManifestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReturnManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReturnManifestResponseMultiError, or nil if none found.
+func (m *ExportReturnManifestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReturnManifestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ExportReturnManifestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReturnManifestResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportReturnManifestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportReturnManifestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReturnManifestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReturnManifestResponseMultiError) AllErrors() []error { return m }
+
+// ExportReturnManifestResponseValidationError is the validation error returned
+// by ExportReturnManifestResponse.Validate if the designated constraints
+// aren't met.
+type ExportReturnManifestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReturnManifestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReturnManifestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReturnManifestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReturnManifestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReturnManifestResponseValidationError) ErrorName() string {
+	return "ExportReturnManifestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportReturnManifestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportReturnManifestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportReturnManifestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportReturnManifestResponseValidationError{}
+
+// Validate checks the field values on Receiver with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Receiver) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Receiver with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceiverMultiError, or nil
+// if none found.
+func (m *Receiver) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Receiver) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Phone
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceiverValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReceiverValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiverValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceiverValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReceiverValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiverValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReceiverMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiverMultiError is an error wrapping multiple validation errors returned
+// by Receiver.ValidateAll() if the designated constraints aren't met.
+type ReceiverMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiverMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiverMultiError) AllErrors() []error { return m }
+
+// ReceiverValidationError is the validation error returned by
+// Receiver.Validate if the designated constraints aren't met.
+type ReceiverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiverValidationError) ErrorName() string { return "ReceiverValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiver.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiverValidationError{}
+
+// Validate checks the field values on UpsertReceiverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertReceiverRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertReceiverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertReceiverRequestMultiError, or nil if none found.
+func (m *UpsertReceiverRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertReceiverRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UpsertReceiverRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPhone()) < 1 {
+		err := UpsertReceiverRequestValidationError{
+			field:  "Phone",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return UpsertReceiverRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertReceiverRequestMultiError is an error wrapping multiple validation
+// errors returned by UpsertReceiverRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertReceiverRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertReceiverRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertReceiverRequestMultiError) AllErrors() []error { return m }
+
+// UpsertReceiverRequestValidationError is the validation error returned by
+// UpsertReceiverRequest.Validate if the designated constraints aren't met.
+type UpsertReceiverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertReceiverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertReceiverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertReceiverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertReceiverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertReceiverRequestValidationError) ErrorName() string {
+	return "UpsertReceiverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertReceiverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertReceiverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertReceiverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertReceiverRequestValidationError{}
+
+// Validate checks the field values on SearchReceiversRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchReceiversRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReceiversRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchReceiversRequestMultiError, or nil if none found.
+func (m *SearchReceiversRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReceiversRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQuery()) < 1 {
+		err := SearchReceiversRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := SearchReceiversRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchReceiversRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReceiversRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchReceiversRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchReceiversRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReceiversRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReceiversRequestMultiError) AllErrors() []error { return m }
+
+// SearchReceiversRequestValidationError is the validation error returned by
+// SearchReceiversRequest.Validate if the designated constraints aren't met.
+type SearchReceiversRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReceiversRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReceiversRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReceiversRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReceiversRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReceiversRequestValidationError) ErrorName() string {
+	return "SearchReceiversRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchReceiversRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReceiversRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReceiversRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReceiversRequestValidationError{}
+
+// Validate checks the field values on ReceiversList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReceiversList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiversList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceiversListMultiError, or
+// nil if none found.
+func (m *ReceiversList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiversList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReceivers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiversListValidationError{
+						field:  fmt.Sprintf("Receivers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiversListValidationError{
+						field:  fmt.Sprintf("Receivers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiversListValidationError{
+					field:  fmt.Sprintf("Receivers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReceiversListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiversListMultiError is an error wrapping multiple validation errors
+// returned by ReceiversList.ValidateAll() if the designated constraints
+// aren't met.
+type ReceiversListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiversListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiversListMultiError) AllErrors() []error { return m }
+
+// ReceiversListValidationError is the validation error returned by
+// ReceiversList.Validate if the designated constraints aren't met.
+type ReceiversListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiversListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiversListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiversListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiversListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiversListValidationError) ErrorName() string { return "ReceiversListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiversListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiversList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiversListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiversListValidationError{}
+
+// Validate checks the field values on AuditRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditRecordMultiError, or
+// nil if none found.
+func (m *AuditRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Method
+
+	// no validation rules for PvzId
+
+	if all {
+		switch v := interface{}(m.GetActor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditRecordValidationError{
+				field:  "Actor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sender
+
+	// no validation rules for Peer
+
+	// no validation rules for Payload
+
+	// no validation rules for ResultCode
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditRecordValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PrevHash
+
+	// no validation rules for Hash
+
+	if len(errors) > 0 {
+		return AuditRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditRecordMultiError is an error wrapping multiple validation errors
+// returned by AuditRecord.ValidateAll() if the designated constraints aren't met.
+type AuditRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditRecordMultiError) AllErrors() []error { return m }
+
+// AuditRecordValidationError is the validation error returned by
+// AuditRecord.Validate if the designated constraints aren't met.
+type AuditRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditRecordValidationError) ErrorName() string { return "AuditRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditRecordValidationError{}
+
+// Validate checks the field values on SearchAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAuditLogRequestMultiError, or nil if none found.
+func (m *SearchAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActorId
+
+	// no validation rules for OrderId
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchAuditLogRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchAuditLogRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchAuditLogRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchAuditLogRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchAuditLogRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchAuditLogRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLimit() > 1000 {
+		err := SearchAuditLogRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.ActorType != nil {
+
+		if _, ok := _SearchAuditLogRequest_ActorType_InLookup[m.GetActorType()]; !ok {
+			err := SearchAuditLogRequestValidationError{
+				field:  "ActorType",
+				reason: "value must be in list [courier client operator system]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAuditLogRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchAuditLogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAuditLogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// SearchAuditLogRequestValidationError is the validation error returned by
+// SearchAuditLogRequest.Validate if the designated constraints aren't met.
+type SearchAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAuditLogRequestValidationError) ErrorName() string {
+	return "SearchAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAuditLogRequestValidationError{}
+
+var _SearchAuditLogRequest_ActorType_InLookup = map[string]struct{}{
+	"courier":  {},
+	"client":   {},
+	"operator": {},
+	"system":   {},
+}
+
+// Validate checks the field values on AuditLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditLogMultiError, or nil
+// if none found.
+func (m *AuditLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditLogValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditLogValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditLogValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuditLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditLogMultiError is an error wrapping multiple validation errors returned
+// by AuditLog.ValidateAll() if the designated constraints aren't met.
+type AuditLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogMultiError) AllErrors() []error { return m }
+
+// AuditLogValidationError is the validation error returned by
+// AuditLog.Validate if the designated constraints aren't met.
+type AuditLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogValidationError) ErrorName() string { return "AuditLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogValidationError{}
+
+// Validate checks the field values on VerifyAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAuditLogRequestMultiError, or nil if none found.
+func (m *VerifyAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAuditLogRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyAuditLogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAuditLogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// VerifyAuditLogRequestValidationError is the validation error returned by
+// VerifyAuditLogRequest.Validate if the designated constraints aren't met.
+type VerifyAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAuditLogRequestValidationError) ErrorName() string {
+	return "VerifyAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAuditLogRequestValidationError{}
+
+// Validate checks the field values on VerifyAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAuditLogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAuditLogResponseMultiError, or nil if none found.
+func (m *VerifyAuditLogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAuditLogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	// no validation rules for Checked
+
+	// no validation rules for BrokenRecordId
+
+	if len(errors) > 0 {
+		return VerifyAuditLogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAuditLogResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyAuditLogResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAuditLogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAuditLogResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAuditLogResponseMultiError) AllErrors() []error { return m }
+
+// VerifyAuditLogResponseValidationError is the validation error returned by
+// VerifyAuditLogResponse.Validate if the designated constraints aren't met.
+type VerifyAuditLogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAuditLogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAuditLogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAuditLogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAuditLogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAuditLogResponseValidationError) ErrorName() string {
+	return "VerifyAuditLogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAuditLogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAuditLogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAuditLogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAuditLogResponseValidationError{}
@@ -0,0 +1,1563 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: orders/v2/contract.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OrdersService_AcceptOrder_FullMethodName            = "/orders.v2.OrdersService/AcceptOrder"
+	OrdersService_ReturnOrder_FullMethodName            = "/orders.v2.OrdersService/ReturnOrder"
+	OrdersService_ProcessOrders_FullMethodName          = "/orders.v2.OrdersService/ProcessOrders"
+	OrdersService_ListOrders_FullMethodName             = "/orders.v2.OrdersService/ListOrders"
+	OrdersService_ListReturns_FullMethodName            = "/orders.v2.OrdersService/ListReturns"
+	OrdersService_GetHistory_FullMethodName             = "/orders.v2.OrdersService/GetHistory"
+	OrdersService_ExportOrders_FullMethodName           = "/orders.v2.OrdersService/ExportOrders"
+	OrdersService_ImportOrders_FullMethodName           = "/orders.v2.OrdersService/ImportOrders"
+	OrdersService_ImportOrdersStream_FullMethodName     = "/orders.v2.OrdersService/ImportOrdersStream"
+	OrdersService_StartImport_FullMethodName            = "/orders.v2.OrdersService/StartImport"
+	OrdersService_GetImportJob_FullMethodName           = "/orders.v2.OrdersService/GetImportJob"
+	OrdersService_CancelImportJob_FullMethodName        = "/orders.v2.OrdersService/CancelImportJob"
+	OrdersService_GetOrderHistory_FullMethodName        = "/orders.v2.OrdersService/GetOrderHistory"
+	OrdersService_GetAllowedActions_FullMethodName      = "/orders.v2.OrdersService/GetAllowedActions"
+	OrdersService_ExtendStorage_FullMethodName          = "/orders.v2.OrdersService/ExtendStorage"
+	OrdersService_MoveOrder_FullMethodName              = "/orders.v2.OrdersService/MoveOrder"
+	OrdersService_ConfirmPayment_FullMethodName         = "/orders.v2.OrdersService/ConfirmPayment"
+	OrdersService_AnnounceOrders_FullMethodName         = "/orders.v2.OrdersService/AnnounceOrders"
+	OrdersService_ConfirmArrival_FullMethodName         = "/orders.v2.OrdersService/ConfirmArrival"
+	OrdersService_GetDiscrepancyReport_FullMethodName   = "/orders.v2.OrdersService/GetDiscrepancyReport"
+	OrdersService_SweepExpiredOrders_FullMethodName     = "/orders.v2.OrdersService/SweepExpiredOrders"
+	OrdersService_ListReturnManifests_FullMethodName    = "/orders.v2.OrdersService/ListReturnManifests"
+	OrdersService_GetReturnManifest_FullMethodName      = "/orders.v2.OrdersService/GetReturnManifest"
+	OrdersService_ExportReturnManifest_FullMethodName   = "/orders.v2.OrdersService/ExportReturnManifest"
+	OrdersService_HandOverReturnManifest_FullMethodName = "/orders.v2.OrdersService/HandOverReturnManifest"
+	OrdersService_UpsertReceiver_FullMethodName         = "/orders.v2.OrdersService/UpsertReceiver"
+	OrdersService_SearchReceivers_FullMethodName        = "/orders.v2.OrdersService/SearchReceivers"
+	OrdersService_SearchAuditLog_FullMethodName         = "/orders.v2.OrdersService/SearchAuditLog"
+	OrdersService_VerifyAuditLog_FullMethodName         = "/orders.v2.OrdersService/VerifyAuditLog"
+	OrdersService_CreateStorageCell_FullMethodName      = "/orders.v2.OrdersService/CreateStorageCell"
+	OrdersService_ListStorageCells_FullMethodName       = "/orders.v2.OrdersService/ListStorageCells"
+	OrdersService_SetReturnPolicy_FullMethodName        = "/orders.v2.OrdersService/SetReturnPolicy"
+	OrdersService_ListReturnPolicies_FullMethodName     = "/orders.v2.OrdersService/ListReturnPolicies"
+	OrdersService_CreatePickupPoint_FullMethodName      = "/orders.v2.OrdersService/CreatePickupPoint"
+	OrdersService_ListPickupPoints_FullMethodName       = "/orders.v2.OrdersService/ListPickupPoints"
+	OrdersService_CreatePackageType_FullMethodName      = "/orders.v2.OrdersService/CreatePackageType"
+	OrdersService_UpdatePackageType_FullMethodName      = "/orders.v2.OrdersService/UpdatePackageType"
+	OrdersService_DeletePackageType_FullMethodName      = "/orders.v2.OrdersService/DeletePackageType"
+	OrdersService_ListPackageTypes_FullMethodName       = "/orders.v2.OrdersService/ListPackageTypes"
+)
+
+// OrdersServiceClient is the client API for OrdersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrdersServiceClient interface {
+	AcceptOrder(ctx context.Context, in *AcceptOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	ReturnOrder(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	ProcessOrders(ctx context.Context, in *ProcessOrdersRequest, opts ...grpc.CallOption) (*ProcessResult, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*OrdersList, error)
+	ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ReturnsList, error)
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryList, error)
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Order], error)
+	ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportResult, error)
+	ImportOrdersStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportOrdersStreamRequest, ImportRowResult], error)
+	StartImport(ctx context.Context, in *StartImportRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	GetImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	CancelImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	GetOrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error)
+	GetAllowedActions(ctx context.Context, in *GetAllowedActionsRequest, opts ...grpc.CallOption) (*AllowedActionsResponse, error)
+	ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error)
+	MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*Order, error)
+	AnnounceOrders(ctx context.Context, in *AnnounceOrdersRequest, opts ...grpc.CallOption) (*AnnounceOrdersResponse, error)
+	ConfirmArrival(ctx context.Context, in *ConfirmArrivalRequest, opts ...grpc.CallOption) (*ArrivalReport, error)
+	GetDiscrepancyReport(ctx context.Context, in *GetDiscrepancyReportRequest, opts ...grpc.CallOption) (*DiscrepancyReport, error)
+	SweepExpiredOrders(ctx context.Context, in *SweepExpiredOrdersRequest, opts ...grpc.CallOption) (*ReturnManifest, error)
+	ListReturnManifests(ctx context.Context, in *ListReturnManifestsRequest, opts ...grpc.CallOption) (*ReturnManifestsList, error)
+	GetReturnManifest(ctx context.Context, in *ReturnManifestRequest, opts ...grpc.CallOption) (*ReturnManifest, error)
+	ExportReturnManifest(ctx context.Context, in *ExportReturnManifestRequest, opts ...grpc.CallOption) (*ExportReturnManifestResponse, error)
+	HandOverReturnManifest(ctx context.Context, in *ReturnManifestRequest, opts ...grpc.CallOption) (*ReturnManifest, error)
+	UpsertReceiver(ctx context.Context, in *UpsertReceiverRequest, opts ...grpc.CallOption) (*Receiver, error)
+	SearchReceivers(ctx context.Context, in *SearchReceiversRequest, opts ...grpc.CallOption) (*ReceiversList, error)
+	SearchAuditLog(ctx context.Context, in *SearchAuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*StorageCell, error)
+	ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*StorageCellsList, error)
+	SetReturnPolicy(ctx context.Context, in *SetReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error)
+	ListReturnPolicies(ctx context.Context, in *ListReturnPoliciesRequest, opts ...grpc.CallOption) (*ReturnPoliciesList, error)
+	CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error)
+	ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*PickupPointsList, error)
+	CreatePackageType(ctx context.Context, in *CreatePackageTypeRequest, opts ...grpc.CallOption) (*PackageTypeDefinition, error)
+	UpdatePackageType(ctx context.Context, in *UpdatePackageTypeRequest, opts ...grpc.CallOption) (*PackageTypeDefinition, error)
+	DeletePackageType(ctx context.Context, in *DeletePackageTypeRequest, opts ...grpc.CallOption) (*DeletePackageTypeResponse, error)
+	ListPackageTypes(ctx context.Context, in *ListPackageTypesRequest, opts ...grpc.CallOption) (*PackageTypesList, error)
+}
+
+type ordersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrdersServiceClient(cc grpc.ClientConnInterface) OrdersServiceClient {
+	return &ordersServiceClient{cc}
+}
+
+func (c *ordersServiceClient) AcceptOrder(ctx context.Context, in *AcceptOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, OrdersService_AcceptOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ReturnOrder(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ReturnOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ProcessOrders(ctx context.Context, in *ProcessOrdersRequest, opts ...grpc.CallOption) (*ProcessResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessResult)
+	err := c.cc.Invoke(ctx, OrdersService_ProcessOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*OrdersList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrdersList)
+	err := c.cc.Invoke(ctx, OrdersService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ReturnsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnsList)
+	err := c.cc.Invoke(ctx, OrdersService_ListReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderHistoryList)
+	err := c.cc.Invoke(ctx, OrdersService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Order], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[0], OrdersService_ExportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportOrdersRequest, Order]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ExportOrdersClient = grpc.ServerStreamingClient[Order]
+
+func (c *ordersServiceClient) ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResult)
+	err := c.cc.Invoke(ctx, OrdersService_ImportOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ImportOrdersStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportOrdersStreamRequest, ImportRowResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[1], OrdersService_ImportOrdersStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportOrdersStreamRequest, ImportRowResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ImportOrdersStreamClient = grpc.BidiStreamingClient[ImportOrdersStreamRequest, ImportRowResult]
+
+func (c *ordersServiceClient) StartImport(ctx context.Context, in *StartImportRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrdersService_StartImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrdersService_GetImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CancelImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrdersService_CancelImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetOrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderHistoryResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetAllowedActions(ctx context.Context, in *GetAllowedActionsRequest, opts ...grpc.CallOption) (*AllowedActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllowedActionsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetAllowedActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendStorageResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ExtendStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrdersService_MoveOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrdersService_ConfirmPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) AnnounceOrders(ctx context.Context, in *AnnounceOrdersRequest, opts ...grpc.CallOption) (*AnnounceOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnounceOrdersResponse)
+	err := c.cc.Invoke(ctx, OrdersService_AnnounceOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ConfirmArrival(ctx context.Context, in *ConfirmArrivalRequest, opts ...grpc.CallOption) (*ArrivalReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArrivalReport)
+	err := c.cc.Invoke(ctx, OrdersService_ConfirmArrival_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetDiscrepancyReport(ctx context.Context, in *GetDiscrepancyReportRequest, opts ...grpc.CallOption) (*DiscrepancyReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscrepancyReport)
+	err := c.cc.Invoke(ctx, OrdersService_GetDiscrepancyReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) SweepExpiredOrders(ctx context.Context, in *SweepExpiredOrdersRequest, opts ...grpc.CallOption) (*ReturnManifest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnManifest)
+	err := c.cc.Invoke(ctx, OrdersService_SweepExpiredOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListReturnManifests(ctx context.Context, in *ListReturnManifestsRequest, opts ...grpc.CallOption) (*ReturnManifestsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnManifestsList)
+	err := c.cc.Invoke(ctx, OrdersService_ListReturnManifests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetReturnManifest(ctx context.Context, in *ReturnManifestRequest, opts ...grpc.CallOption) (*ReturnManifest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnManifest)
+	err := c.cc.Invoke(ctx, OrdersService_GetReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ExportReturnManifest(ctx context.Context, in *ExportReturnManifestRequest, opts ...grpc.CallOption) (*ExportReturnManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportReturnManifestResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ExportReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) HandOverReturnManifest(ctx context.Context, in *ReturnManifestRequest, opts ...grpc.CallOption) (*ReturnManifest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnManifest)
+	err := c.cc.Invoke(ctx, OrdersService_HandOverReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) UpsertReceiver(ctx context.Context, in *UpsertReceiverRequest, opts ...grpc.CallOption) (*Receiver, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receiver)
+	err := c.cc.Invoke(ctx, OrdersService_UpsertReceiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) SearchReceivers(ctx context.Context, in *SearchReceiversRequest, opts ...grpc.CallOption) (*ReceiversList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiversList)
+	err := c.cc.Invoke(ctx, OrdersService_SearchReceivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) SearchAuditLog(ctx context.Context, in *SearchAuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, OrdersService_SearchAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, OrdersService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*StorageCell, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageCell)
+	err := c.cc.Invoke(ctx, OrdersService_CreateStorageCell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*StorageCellsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageCellsList)
+	err := c.cc.Invoke(ctx, OrdersService_ListStorageCells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) SetReturnPolicy(ctx context.Context, in *SetReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnPolicy)
+	err := c.cc.Invoke(ctx, OrdersService_SetReturnPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListReturnPolicies(ctx context.Context, in *ListReturnPoliciesRequest, opts ...grpc.CallOption) (*ReturnPoliciesList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnPoliciesList)
+	err := c.cc.Invoke(ctx, OrdersService_ListReturnPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPoint)
+	err := c.cc.Invoke(ctx, OrdersService_CreatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*PickupPointsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointsList)
+	err := c.cc.Invoke(ctx, OrdersService_ListPickupPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreatePackageType(ctx context.Context, in *CreatePackageTypeRequest, opts ...grpc.CallOption) (*PackageTypeDefinition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackageTypeDefinition)
+	err := c.cc.Invoke(ctx, OrdersService_CreatePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) UpdatePackageType(ctx context.Context, in *UpdatePackageTypeRequest, opts ...grpc.CallOption) (*PackageTypeDefinition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackageTypeDefinition)
+	err := c.cc.Invoke(ctx, OrdersService_UpdatePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) DeletePackageType(ctx context.Context, in *DeletePackageTypeRequest, opts ...grpc.CallOption) (*DeletePackageTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePackageTypeResponse)
+	err := c.cc.Invoke(ctx, OrdersService_DeletePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListPackageTypes(ctx context.Context, in *ListPackageTypesRequest, opts ...grpc.CallOption) (*PackageTypesList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackageTypesList)
+	err := c.cc.Invoke(ctx, OrdersService_ListPackageTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrdersServiceServer is the server API for OrdersService service.
+// All implementations must embed UnimplementedOrdersServiceServer
+// for forward compatibility.
+type OrdersServiceServer interface {
+	AcceptOrder(context.Context, *AcceptOrderRequest) (*OrderResponse, error)
+	ReturnOrder(context.Context, *OrderIdRequest) (*OrderResponse, error)
+	ProcessOrders(context.Context, *ProcessOrdersRequest) (*ProcessResult, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*OrdersList, error)
+	ListReturns(context.Context, *ListReturnsRequest) (*ReturnsList, error)
+	GetHistory(context.Context, *GetHistoryRequest) (*OrderHistoryList, error)
+	ExportOrders(*ExportOrdersRequest, grpc.ServerStreamingServer[Order]) error
+	ImportOrders(context.Context, *ImportOrdersRequest) (*ImportResult, error)
+	ImportOrdersStream(grpc.BidiStreamingServer[ImportOrdersStreamRequest, ImportRowResult]) error
+	StartImport(context.Context, *StartImportRequest) (*ImportJob, error)
+	GetImportJob(context.Context, *ImportJobRequest) (*ImportJob, error)
+	CancelImportJob(context.Context, *ImportJobRequest) (*ImportJob, error)
+	GetOrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error)
+	GetAllowedActions(context.Context, *GetAllowedActionsRequest) (*AllowedActionsResponse, error)
+	ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error)
+	MoveOrder(context.Context, *MoveOrderRequest) (*Order, error)
+	ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*Order, error)
+	AnnounceOrders(context.Context, *AnnounceOrdersRequest) (*AnnounceOrdersResponse, error)
+	ConfirmArrival(context.Context, *ConfirmArrivalRequest) (*ArrivalReport, error)
+	GetDiscrepancyReport(context.Context, *GetDiscrepancyReportRequest) (*DiscrepancyReport, error)
+	SweepExpiredOrders(context.Context, *SweepExpiredOrdersRequest) (*ReturnManifest, error)
+	ListReturnManifests(context.Context, *ListReturnManifestsRequest) (*ReturnManifestsList, error)
+	GetReturnManifest(context.Context, *ReturnManifestRequest) (*ReturnManifest, error)
+	ExportReturnManifest(context.Context, *ExportReturnManifestRequest) (*ExportReturnManifestResponse, error)
+	HandOverReturnManifest(context.Context, *ReturnManifestRequest) (*ReturnManifest, error)
+	UpsertReceiver(context.Context, *UpsertReceiverRequest) (*Receiver, error)
+	SearchReceivers(context.Context, *SearchReceiversRequest) (*ReceiversList, error)
+	SearchAuditLog(context.Context, *SearchAuditLogRequest) (*AuditLog, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	CreateStorageCell(context.Context, *CreateStorageCellRequest) (*StorageCell, error)
+	ListStorageCells(context.Context, *ListStorageCellsRequest) (*StorageCellsList, error)
+	SetReturnPolicy(context.Context, *SetReturnPolicyRequest) (*ReturnPolicy, error)
+	ListReturnPolicies(context.Context, *ListReturnPoliciesRequest) (*ReturnPoliciesList, error)
+	CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*PickupPoint, error)
+	ListPickupPoints(context.Context, *ListPickupPointsRequest) (*PickupPointsList, error)
+	CreatePackageType(context.Context, *CreatePackageTypeRequest) (*PackageTypeDefinition, error)
+	UpdatePackageType(context.Context, *UpdatePackageTypeRequest) (*PackageTypeDefinition, error)
+	DeletePackageType(context.Context, *DeletePackageTypeRequest) (*DeletePackageTypeResponse, error)
+	ListPackageTypes(context.Context, *ListPackageTypesRequest) (*PackageTypesList, error)
+	mustEmbedUnimplementedOrdersServiceServer()
+}
+
+// UnimplementedOrdersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrdersServiceServer struct{}
+
+func (UnimplementedOrdersServiceServer) AcceptOrder(context.Context, *AcceptOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) ReturnOrder(context.Context, *OrderIdRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) ProcessOrders(context.Context, *ProcessOrdersRequest) (*ProcessResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*OrdersList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListReturns(context.Context, *ListReturnsRequest) (*ReturnsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturns not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*OrderHistoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedOrdersServiceServer) ExportOrders(*ExportOrdersRequest, grpc.ServerStreamingServer[Order]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) ImportOrders(context.Context, *ImportOrdersRequest) (*ImportResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) ImportOrdersStream(grpc.BidiStreamingServer[ImportOrdersStreamRequest, ImportRowResult]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportOrdersStream not implemented")
+}
+func (UnimplementedOrdersServiceServer) StartImport(context.Context, *StartImportRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartImport not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetImportJob(context.Context, *ImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedOrdersServiceServer) CancelImportJob(context.Context, *ImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImportJob not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetOrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetAllowedActions(context.Context, *GetAllowedActionsRequest) (*AllowedActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedActions not implemented")
+}
+func (UnimplementedOrdersServiceServer) ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendStorage not implemented")
+}
+func (UnimplementedOrdersServiceServer) MoveOrder(context.Context, *MoveOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPayment not implemented")
+}
+func (UnimplementedOrdersServiceServer) AnnounceOrders(context.Context, *AnnounceOrdersRequest) (*AnnounceOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) ConfirmArrival(context.Context, *ConfirmArrivalRequest) (*ArrivalReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmArrival not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetDiscrepancyReport(context.Context, *GetDiscrepancyReportRequest) (*DiscrepancyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscrepancyReport not implemented")
+}
+func (UnimplementedOrdersServiceServer) SweepExpiredOrders(context.Context, *SweepExpiredOrdersRequest) (*ReturnManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepExpiredOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListReturnManifests(context.Context, *ListReturnManifestsRequest) (*ReturnManifestsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturnManifests not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetReturnManifest(context.Context, *ReturnManifestRequest) (*ReturnManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturnManifest not implemented")
+}
+func (UnimplementedOrdersServiceServer) ExportReturnManifest(context.Context, *ExportReturnManifestRequest) (*ExportReturnManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReturnManifest not implemented")
+}
+func (UnimplementedOrdersServiceServer) HandOverReturnManifest(context.Context, *ReturnManifestRequest) (*ReturnManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandOverReturnManifest not implemented")
+}
+func (UnimplementedOrdersServiceServer) UpsertReceiver(context.Context, *UpsertReceiverRequest) (*Receiver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertReceiver not implemented")
+}
+func (UnimplementedOrdersServiceServer) SearchReceivers(context.Context, *SearchReceiversRequest) (*ReceiversList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReceivers not implemented")
+}
+func (UnimplementedOrdersServiceServer) SearchAuditLog(context.Context, *SearchAuditLogRequest) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLog not implemented")
+}
+func (UnimplementedOrdersServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreateStorageCell(context.Context, *CreateStorageCellRequest) (*StorageCell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStorageCell not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListStorageCells(context.Context, *ListStorageCellsRequest) (*StorageCellsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageCells not implemented")
+}
+func (UnimplementedOrdersServiceServer) SetReturnPolicy(context.Context, *SetReturnPolicyRequest) (*ReturnPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReturnPolicy not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListReturnPolicies(context.Context, *ListReturnPoliciesRequest) (*ReturnPoliciesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturnPolicies not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*PickupPoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePickupPoint not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListPickupPoints(context.Context, *ListPickupPointsRequest) (*PickupPointsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPickupPoints not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreatePackageType(context.Context, *CreatePackageTypeRequest) (*PackageTypeDefinition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePackageType not implemented")
+}
+func (UnimplementedOrdersServiceServer) UpdatePackageType(context.Context, *UpdatePackageTypeRequest) (*PackageTypeDefinition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePackageType not implemented")
+}
+func (UnimplementedOrdersServiceServer) DeletePackageType(context.Context, *DeletePackageTypeRequest) (*DeletePackageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePackageType not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListPackageTypes(context.Context, *ListPackageTypesRequest) (*PackageTypesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPackageTypes not implemented")
+}
+func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
+func (UnimplementedOrdersServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeOrdersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrdersServiceServer will
+// result in compilation errors.
+type UnsafeOrdersServiceServer interface {
+	mustEmbedUnimplementedOrdersServiceServer()
+}
+
+func RegisterOrdersServiceServer(s grpc.ServiceRegistrar, srv OrdersServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOrdersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrdersService_ServiceDesc, srv)
+}
+
+func _OrdersService_AcceptOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).AcceptOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_AcceptOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).AcceptOrder(ctx, req.(*AcceptOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ReturnOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ReturnOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ReturnOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ReturnOrder(ctx, req.(*OrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ProcessOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ProcessOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ProcessOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ProcessOrders(ctx, req.(*ProcessOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListReturns(ctx, req.(*ListReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ExportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdersServiceServer).ExportOrders(m, &grpc.GenericServerStream[ExportOrdersRequest, Order]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ExportOrdersServer = grpc.ServerStreamingServer[Order]
+
+func _OrdersService_ImportOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ImportOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ImportOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ImportOrders(ctx, req.(*ImportOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ImportOrdersStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrdersServiceServer).ImportOrdersStream(&grpc.GenericServerStream[ImportOrdersStreamRequest, ImportRowResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ImportOrdersStreamServer = grpc.BidiStreamingServer[ImportOrdersStreamRequest, ImportRowResult]
+
+func _OrdersService_StartImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).StartImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_StartImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).StartImport(ctx, req.(*StartImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetImportJob(ctx, req.(*ImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CancelImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CancelImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CancelImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CancelImportJob(ctx, req.(*ImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, req.(*OrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetAllowedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowedActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetAllowedActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetAllowedActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetAllowedActions(ctx, req.(*GetAllowedActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ExtendStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ExtendStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ExtendStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ExtendStorage(ctx, req.(*ExtendStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_MoveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).MoveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_MoveOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).MoveOrder(ctx, req.(*MoveOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ConfirmPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ConfirmPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ConfirmPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ConfirmPayment(ctx, req.(*ConfirmPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_AnnounceOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).AnnounceOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_AnnounceOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).AnnounceOrders(ctx, req.(*AnnounceOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ConfirmArrival_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmArrivalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ConfirmArrival(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ConfirmArrival_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ConfirmArrival(ctx, req.(*ConfirmArrivalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetDiscrepancyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscrepancyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetDiscrepancyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetDiscrepancyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetDiscrepancyReport(ctx, req.(*GetDiscrepancyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_SweepExpiredOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepExpiredOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).SweepExpiredOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_SweepExpiredOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).SweepExpiredOrders(ctx, req.(*SweepExpiredOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListReturnManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListReturnManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListReturnManifests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListReturnManifests(ctx, req.(*ListReturnManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetReturnManifest(ctx, req.(*ReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ExportReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ExportReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ExportReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ExportReturnManifest(ctx, req.(*ExportReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_HandOverReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).HandOverReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_HandOverReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).HandOverReturnManifest(ctx, req.(*ReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_UpsertReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).UpsertReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_UpsertReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).UpsertReceiver(ctx, req.(*UpsertReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_SearchReceivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReceiversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).SearchReceivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_SearchReceivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).SearchReceivers(ctx, req.(*SearchReceiversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_SearchAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).SearchAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_SearchAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).SearchAuditLog(ctx, req.(*SearchAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreateStorageCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStorageCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateStorageCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateStorageCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateStorageCell(ctx, req.(*CreateStorageCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListStorageCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListStorageCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListStorageCells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListStorageCells(ctx, req.(*ListStorageCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_SetReturnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReturnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).SetReturnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_SetReturnPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).SetReturnPolicy(ctx, req.(*SetReturnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListReturnPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListReturnPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListReturnPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListReturnPolicies(ctx, req.(*ListReturnPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreatePickupPoint(ctx, req.(*CreatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListPickupPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPickupPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListPickupPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListPickupPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListPickupPoints(ctx, req.(*ListPickupPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreatePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreatePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreatePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreatePackageType(ctx, req.(*CreatePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_UpdatePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).UpdatePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_UpdatePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).UpdatePackageType(ctx, req.(*UpdatePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_DeletePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).DeletePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_DeletePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).DeletePackageType(ctx, req.(*DeletePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListPackageTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPackageTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListPackageTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListPackageTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListPackageTypes(ctx, req.(*ListPackageTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrdersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "orders.v2.OrdersService",
+	HandlerType: (*OrdersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcceptOrder",
+			Handler:    _OrdersService_AcceptOrder_Handler,
+		},
+		{
+			MethodName: "ReturnOrder",
+			Handler:    _OrdersService_ReturnOrder_Handler,
+		},
+		{
+			MethodName: "ProcessOrders",
+			Handler:    _OrdersService_ProcessOrders_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _OrdersService_ListOrders_Handler,
+		},
+		{
+			MethodName: "ListReturns",
+			Handler:    _OrdersService_ListReturns_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _OrdersService_GetHistory_Handler,
+		},
+		{
+			MethodName: "ImportOrders",
+			Handler:    _OrdersService_ImportOrders_Handler,
+		},
+		{
+			MethodName: "StartImport",
+			Handler:    _OrdersService_StartImport_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _OrdersService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "CancelImportJob",
+			Handler:    _OrdersService_CancelImportJob_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrdersService_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "GetAllowedActions",
+			Handler:    _OrdersService_GetAllowedActions_Handler,
+		},
+		{
+			MethodName: "ExtendStorage",
+			Handler:    _OrdersService_ExtendStorage_Handler,
+		},
+		{
+			MethodName: "MoveOrder",
+			Handler:    _OrdersService_MoveOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmPayment",
+			Handler:    _OrdersService_ConfirmPayment_Handler,
+		},
+		{
+			MethodName: "AnnounceOrders",
+			Handler:    _OrdersService_AnnounceOrders_Handler,
+		},
+		{
+			MethodName: "ConfirmArrival",
+			Handler:    _OrdersService_ConfirmArrival_Handler,
+		},
+		{
+			MethodName: "GetDiscrepancyReport",
+			Handler:    _OrdersService_GetDiscrepancyReport_Handler,
+		},
+		{
+			MethodName: "SweepExpiredOrders",
+			Handler:    _OrdersService_SweepExpiredOrders_Handler,
+		},
+		{
+			MethodName: "ListReturnManifests",
+			Handler:    _OrdersService_ListReturnManifests_Handler,
+		},
+		{
+			MethodName: "GetReturnManifest",
+			Handler:    _OrdersService_GetReturnManifest_Handler,
+		},
+		{
+			MethodName: "ExportReturnManifest",
+			Handler:    _OrdersService_ExportReturnManifest_Handler,
+		},
+		{
+			MethodName: "HandOverReturnManifest",
+			Handler:    _OrdersService_HandOverReturnManifest_Handler,
+		},
+		{
+			MethodName: "UpsertReceiver",
+			Handler:    _OrdersService_UpsertReceiver_Handler,
+		},
+		{
+			MethodName: "SearchReceivers",
+			Handler:    _OrdersService_SearchReceivers_Handler,
+		},
+		{
+			MethodName: "SearchAuditLog",
+			Handler:    _OrdersService_SearchAuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _OrdersService_VerifyAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateStorageCell",
+			Handler:    _OrdersService_CreateStorageCell_Handler,
+		},
+		{
+			MethodName: "ListStorageCells",
+			Handler:    _OrdersService_ListStorageCells_Handler,
+		},
+		{
+			MethodName: "SetReturnPolicy",
+			Handler:    _OrdersService_SetReturnPolicy_Handler,
+		},
+		{
+			MethodName: "ListReturnPolicies",
+			Handler:    _OrdersService_ListReturnPolicies_Handler,
+		},
+		{
+			MethodName: "CreatePickupPoint",
+			Handler:    _OrdersService_CreatePickupPoint_Handler,
+		},
+		{
+			MethodName: "ListPickupPoints",
+			Handler:    _OrdersService_ListPickupPoints_Handler,
+		},
+		{
+			MethodName: "CreatePackageType",
+			Handler:    _OrdersService_CreatePackageType_Handler,
+		},
+		{
+			MethodName: "UpdatePackageType",
+			Handler:    _OrdersService_UpdatePackageType_Handler,
+		},
+		{
+			MethodName: "DeletePackageType",
+			Handler:    _OrdersService_DeletePackageType_Handler,
+		},
+		{
+			MethodName: "ListPackageTypes",
+			Handler:    _OrdersService_ListPackageTypes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOrders",
+			Handler:       _OrdersService_ExportOrders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportOrdersStream",
+			Handler:       _OrdersService_ImportOrdersStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "orders/v2/contract.proto",
+}
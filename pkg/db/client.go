@@ -143,16 +143,23 @@ func (c *Client) Query(ctx context.Context, query string, args ...interface{}) (
 }
 
 func (c *Client) QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return c.QueryRowMode(ctx, ModeRead, query, args...)
+}
+
+// QueryRowMode читает строку с выбранной базы; ModeWrite нужен, когда прочитанное сразу
+// идет в условное обновление и отставание реплики недопустимо
+func (c *Client) QueryRowMode(ctx context.Context, mode ClientMode, query string, args ...interface{}) *sql.Row {
 	ctx, span := c.tracer.Start(ctx, "db.query_row",
 		trace.WithAttributes(
 			attribute.String("db.system", "postgresql"),
 			attribute.String("db.operation", "query_row"),
+			attribute.String("db.mode", string(mode)),
 			attribute.String("db.statement", query),
 		),
 	)
 	defer span.End()
 
-	row := c.readDB.QueryRowContext(ctx, query, args...)
+	row := c.getDB(mode).QueryRowContext(ctx, query, args...)
 	span.SetStatus(codes.Ok, "")
 	return row
 }
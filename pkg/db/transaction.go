@@ -147,3 +147,34 @@ func (c *Client) WithTransaction(ctx context.Context, fn func(*Tx) error) error
 
 	return nil
 }
+
+// WithReadTransaction выполняет fn в транзакции только для чтения на реплике.
+// Нужна, например, чтобы читать большую выборку курсором: курсор живет до конца транзакции
+func (c *Client) WithReadTransaction(ctx context.Context, fn func(*Tx) error) error {
+	spanCtx, span := c.tracer.Start(ctx, "db.begin_read_tx",
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.operation", "begin"),
+			attribute.String("db.mode", string(ModeRead)),
+		),
+	)
+	sqlTx, err := c.readDB.BeginTx(spanCtx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		c.logger.Error("Error starting read transaction", "error", err)
+		return fmt.Errorf("begin read tx: %w", err)
+	}
+	span.SetStatus(codes.Ok, "")
+	span.End()
+
+	tx := &Tx{tx: sqlTx, logger: c.logger, tracer: c.tracer}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
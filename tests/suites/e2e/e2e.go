@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -27,7 +28,7 @@ import (
 	"gitlab.ozon.dev/safariproxd/homework/internal/app"
 	"gitlab.ozon.dev/safariproxd/homework/internal/metrics"
 	"gitlab.ozon.dev/safariproxd/homework/internal/repository/postgres"
-	"gitlab.ozon.dev/safariproxd/homework/pkg/api"
+	"gitlab.ozon.dev/safariproxd/homework/pkg/api/v2"
 	dbpkg "gitlab.ozon.dev/safariproxd/homework/pkg/db"
 )
 
@@ -62,6 +63,20 @@ func (e *testEnv) Close() {
 	}
 }
 
+// код выдачи доходит до получателя только через событие order_accepted в outbox
+func (e *testEnv) pickupCode(t *testing.T, orderID uint64) string {
+	t.Helper()
+
+	const query = `
+        SELECT payload->'order'->>'pickup_code' FROM outbox
+        WHERE payload->>'event_type' = 'order_accepted' AND payload->'order'->>'id' = $1`
+
+	var code string
+	err := e.dbClient.QueryRow(e.ctx, query, strconv.FormatUint(orderID, 10)).Scan(&code)
+	require.NoError(t, err)
+	return code
+}
+
 func setupEnv(t *testing.T) *testEnv {
 	t.Helper()
 
@@ -161,12 +176,12 @@ func (s *OrdersE2ESuite) TestAcceptAndListOrders() {
 	pkgType := api.PackageType_PACKAGE_TYPE_BOX
 
 	_, err := s.env.ordersClient.AcceptOrder(s.env.ctx, &api.AcceptOrderRequest{
-		OrderId:   orderID,
-		UserId:    userID,
-		ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
-		Package:   &pkgType,
-		Weight:    2.5,
-		Price:     100,
+		OrderId:      orderID,
+		UserId:       userID,
+		ExpiresAt:    timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Package:      &pkgType,
+		WeightGrams:  2500,
+		PriceKopecks: 10000,
 	})
 	s.Require().NoError(err)
 
@@ -190,19 +205,21 @@ func (s *OrdersE2ESuite) TestAcceptAndIssueOrders() {
 	pkgType := api.PackageType_PACKAGE_TYPE_BOX
 
 	_, err := s.env.ordersClient.AcceptOrder(s.env.ctx, &api.AcceptOrderRequest{
-		OrderId:   orderID,
-		UserId:    userID,
-		ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
-		Package:   &pkgType,
-		Weight:    1.1,
-		Price:     55.5,
+		OrderId:      orderID,
+		UserId:       userID,
+		ExpiresAt:    timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Package:      &pkgType,
+		WeightGrams:  1100,
+		PriceKopecks: 5550,
 	})
 	s.Require().NoError(err)
 
+	code := s.env.pickupCode(s.T(), orderID)
 	procResp, err := s.env.ordersClient.ProcessOrders(s.env.ctx, &api.ProcessOrdersRequest{
-		UserId:   userID,
-		Action:   api.ActionType_ACTION_TYPE_ISSUE,
-		OrderIds: []uint64{orderID},
+		UserId:     userID,
+		Action:     api.ActionType_ACTION_TYPE_ISSUE,
+		OrderIds:   []uint64{orderID},
+		PickupCode: &code,
 	})
 	s.Require().NoError(err)
 	s.ElementsMatch([]uint64{orderID}, procResp.Processed)
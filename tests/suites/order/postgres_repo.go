@@ -113,8 +113,8 @@ func makeTestOrder(id uint64) domain.Order {
 		AcceptTime:     now,
 		LastUpdateTime: now,
 		PackageType:    "box",
-		Weight:         1.23,
-		Price:          456.78,
+		Weight:         1230 * domain.Gram,
+		Price:          45678 * domain.Kopeck,
 	}
 }
 
@@ -150,7 +150,7 @@ func (s *OrderRepositorySuite) Test_Update() {
 	require.NoError(s.T(), s.orderRepo.Save(ctx, order))
 
 	order.Status = domain.StatusGivenToCourier
-	order.Price = 999.99
+	order.Price = 99999 * domain.Kopeck
 	order.LastUpdateTime = time.Now().UTC()
 
 	require.NoError(s.T(), s.orderRepo.Update(ctx, order))
@@ -158,7 +158,7 @@ func (s *OrderRepositorySuite) Test_Update() {
 	got, err := s.orderRepo.GetByID(ctx, order.OrderID)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), domain.StatusGivenToCourier, got.Status)
-	assert.EqualValues(s.T(), 999.99, got.Price)
+	assert.Equal(s.T(), 99999*domain.Kopeck, got.Price)
 }
 
 func (s *OrderRepositorySuite) Test_SaveHistory() {
@@ -178,3 +178,75 @@ func (s *OrderRepositorySuite) Test_SaveHistory() {
 	require.Len(s.T(), history, 1)
 	assert.Equal(s.T(), h.Status, history[0].Status)
 }
+
+func (s *OrderRepositorySuite) Test_CompleteIdempotencyKey_AfterTakeover() {
+	ctx := s.ctx
+	t0 := time.Now().UTC().Truncate(time.Microsecond)
+	t1 := t0.Add(time.Minute)
+	rec := domain.IdempotencyRecord{PVZID: domain.DefaultPVZID, Key: "takeover", Method: "Accept", RequestHash: "h", CreatedAt: t0}
+
+	_, reserved, err := s.orderRepo.ReserveIdempotencyKey(ctx, rec, t0.Add(-time.Hour), t0.Add(-time.Second))
+	require.NoError(s.T(), err)
+	require.True(s.T(), reserved)
+
+	// первый запрос завис, повтор забирает ключ после истечения блокировки
+	retry := rec
+	retry.CreatedAt = t1
+	_, reserved, err = s.orderRepo.ReserveIdempotencyKey(ctx, retry, t0.Add(-time.Hour), t1.Add(-time.Second))
+	require.NoError(s.T(), err)
+	require.True(s.T(), reserved)
+
+	late := rec
+	late.ResponseType, late.Response = "late", []byte{1}
+	stored, err := s.orderRepo.CompleteIdempotencyKey(ctx, late)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), stored)
+
+	retry.ResponseType, retry.Response = "retry", []byte{2}
+	stored, err = s.orderRepo.CompleteIdempotencyKey(ctx, retry)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), stored)
+}
+
+func (s *OrderRepositorySuite) Test_SaveReturnManifest_SkipsOrdersOfOpenManifest() {
+	ctx := s.ctx
+	now := time.Now().UTC().Truncate(time.Second)
+	item := func(id uint64) domain.ReturnManifestItem {
+		return domain.ReturnManifestItem{OrderID: id, ReceiverID: 99, Status: domain.StatusInStorage,
+			Weight: domain.Gram, StorageUntil: now}
+	}
+	m := domain.ReturnManifest{PVZID: 77, Status: domain.ManifestOpen, CreatedAt: now}
+
+	m.Items = []domain.ReturnManifestItem{item(501), item(502)}
+	first, err := s.orderRepo.SaveReturnManifest(ctx, m)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), first.Items, 2)
+
+	// вторая сборка успела прочитать те же заказы до коммита первой
+	m.Items = []domain.ReturnManifestItem{item(501), item(502), item(503)}
+	second, err := s.orderRepo.SaveReturnManifest(ctx, m)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), second.Items, 1)
+	assert.Equal(s.T(), uint64(503), second.Items[0].OrderID)
+
+	m.Items = []domain.ReturnManifestItem{item(501)}
+	third, err := s.orderRepo.SaveReturnManifest(ctx, m)
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), third.ID)
+	assert.Empty(s.T(), third.Items)
+}
+
+func (s *OrderRepositorySuite) Test_CountOrdersByStatus() {
+	ctx := s.ctx
+	const pvzID = 88
+	for i, st := range []domain.OrderStatus{domain.StatusInStorage, domain.StatusInStorage, domain.StatusGivenToClient} {
+		order := makeTestOrder(uint64(601 + i))
+		order.PVZID = pvzID
+		order.Status = st
+		require.NoError(s.T(), s.orderRepo.Save(ctx, order))
+	}
+
+	got, err := s.orderRepo.CountOrdersByStatus(ctx, pvzID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), map[domain.OrderStatus]uint64{domain.StatusInStorage: 2, domain.StatusGivenToClient: 1}, got)
+}